@@ -122,6 +122,7 @@ func (atk *ArtalkCmd) mountCommands() {
 	atk.addCommand(NewAdminCommand(atk))
 	atk.addCommand(NewExportCommand(atk))
 	atk.addCommand(NewImportCommand(atk))
+	atk.addCommand(NewSpamTrainCommand(atk))
 	atk.addCommand(NewConfigCommand())
 	atk.addCommand(NewGenCommand())
 	atk.addCommand(NewUpgradeCommand())
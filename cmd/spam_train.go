@@ -0,0 +1,38 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/artalkjs/artalk/v2/internal/core"
+	"github.com/artalkjs/artalk/v2/internal/i18n"
+	"github.com/artalkjs/artalk/v2/internal/log"
+	"github.com/spf13/cobra"
+)
+
+func NewSpamTrainCommand(app *ArtalkCmd) *cobra.Command {
+	spamTrainCmd := &cobra.Command{
+		Use:   "spam-train",
+		Short: "Train the Bayes spam classifier from the comment history",
+		Long:  "\n# Train the Bayes spam classifier\n\n  The pending comments are trained as spam, and the others are trained as ham.\n  The comments which have been trained are skipped (unless the classifier is reset).",
+		Args:  cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			reset, _ := cmd.Flags().GetBool("reset")
+
+			antiSpamService, err := core.AppService[*core.AntiSpamService](app.App)
+			if err != nil {
+				log.Fatal(err)
+			}
+
+			spam, ham, err := antiSpamService.TrainFromHistory(reset)
+			if err != nil {
+				log.Fatal(err)
+			}
+
+			log.Info(i18n.T("Training completed") + fmt.Sprintf(": spam=%d ham=%d", spam, ham))
+		},
+	}
+
+	flag(spamTrainCmd, "reset", false, "Clear the classifier before training")
+
+	return spamTrainCmd
+}
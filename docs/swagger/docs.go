@@ -384,25 +384,64 @@ const docTemplate = `{
                 }
             }
         },
-        "/cache/flush": {
-            "post": {
+        "/blocklist": {
+            "get": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Flush all cache on the server",
+                "description": "Get the items of the blocklist (latest first)",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Cache"
+                    "Blocklist"
+                ],
+                "summary": "Get Blocklist",
+                "operationId": "GetBlocklist",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "The limit for pagination",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "The offset for pagination",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Search the value and the reason",
+                        "name": "search",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "ip",
+                            "email",
+                            "email_domain",
+                            "user_id",
+                            "ua"
+                        ],
+                        "type": "string",
+                        "description": "Filter by type",
+                        "name": "type",
+                        "in": "query"
+                    }
                 ],
-                "summary": "Flush Cache",
-                "operationId": "FlushCache",
                 "responses": {
                     "200": {
                         "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ResponseBlocklistList"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
                         "schema": {
                             "allOf": [
                                 {
@@ -418,6 +457,44 @@ const docTemplate = `{
                                 }
                             ]
                         }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Add an item to the blocklist, the existing item with the same type and value is replaced",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Blocklist"
+                ],
+                "summary": "Create Blocklist Item",
+                "operationId": "CreateBlocklistItem",
+                "parameters": [
+                    {
+                        "description": "The item data",
+                        "name": "item",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.ParamsBlocklistCreate"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ResponseBlocklistCreate"
+                        }
                     },
                     "400": {
                         "description": "Bad Request",
@@ -454,29 +531,74 @@ const docTemplate = `{
                                 }
                             ]
                         }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/handler.Map"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "msg": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
                     }
                 }
             }
         },
-        "/cache/warm_up": {
-            "post": {
+        "/blocklist/{id}": {
+            "put": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Cache warming helps you to pre-load the cache to improve the performance of the first request",
+                "description": "Update an item of the blocklist",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Cache"
+                    "Blocklist"
+                ],
+                "summary": "Update Blocklist Item",
+                "operationId": "UpdateBlocklistItem",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "The item ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "The item data",
+                        "name": "item",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.ParamsBlocklistUpdate"
+                        }
+                    }
                 ],
-                "summary": "Warm-Up Cache",
-                "operationId": "WarmUpCache",
                 "responses": {
                     "200": {
                         "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ResponseBlocklistUpdate"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
                         "schema": {
                             "allOf": [
                                 {
@@ -493,8 +615,8 @@ const docTemplate = `{
                             ]
                         }
                     },
-                    "400": {
-                        "description": "Bad Request",
+                    "403": {
+                        "description": "Forbidden",
                         "schema": {
                             "allOf": [
                                 {
@@ -511,8 +633,8 @@ const docTemplate = `{
                             ]
                         }
                     },
-                    "403": {
-                        "description": "Forbidden",
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
                             "allOf": [
                                 {
@@ -528,27 +650,6 @@ const docTemplate = `{
                                 }
                             ]
                         }
-                    }
-                }
-            }
-        },
-        "/captcha": {
-            "get": {
-                "description": "Get a base64 encoded captcha image or a HTML page to verify for user",
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "Captcha"
-                ],
-                "summary": "Get Captcha",
-                "operationId": "GetCaptcha",
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/handler.ResponseCaptchaGet"
-                        }
                     },
                     "500": {
                         "description": "Internal Server Error",
@@ -569,49 +670,29 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/captcha/status": {
-            "get": {
-                "description": "Get the status of the user's captcha verification",
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "Captcha"
-                ],
-                "summary": "Get Captcha Status",
-                "operationId": "GetCaptchaStatus",
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/handler.ResponseCaptchaStatus"
-                        }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
                     }
-                }
-            }
-        },
-        "/captcha/verify": {
-            "post": {
-                "description": "Verify user enters correct captcha code",
+                ],
+                "description": "Remove an item from the blocklist",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Captcha"
+                    "Blocklist"
                 ],
-                "summary": "Verify Captcha",
-                "operationId": "VerifyCaptcha",
+                "summary": "Delete Blocklist Item",
+                "operationId": "DeleteBlocklistItem",
                 "parameters": [
                     {
-                        "description": "The data to check",
-                        "name": "data",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/handler.ParamsCaptchaVerify"
-                        }
+                        "type": "integer",
+                        "description": "The item ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
@@ -631,7 +712,43 @@ const docTemplate = `{
                                 {
                                     "type": "object",
                                     "properties": {
-                                        "img_data": {
+                                        "msg": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/handler.Map"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "msg": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/handler.Map"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "msg": {
                                             "type": "string"
                                         }
                                     }
@@ -642,48 +759,1939 @@ const docTemplate = `{
                 }
             }
         },
-        "/comments": {
-            "get": {
+        "/cache/flush": {
+            "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Get a list of comments by some conditions",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Flush all cache on the server",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Comment"
+                    "Cache"
                 ],
-                "summary": "Get Comment List",
-                "operationId": "GetComments",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "The user email",
-                        "name": "email",
-                        "in": "query"
-                    },
-                    {
-                        "type": "boolean",
-                        "description": "Enable flat_mode",
-                        "name": "flat_mode",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "The limit for pagination",
-                        "name": "limit",
+                "summary": "Flush Cache",
+                "operationId": "FlushCache",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/handler.Map"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "msg": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/handler.Map"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "msg": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/handler.Map"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "msg": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/cache/warm_up": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Cache warming helps you to pre-load the cache to improve the performance of the first request",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Cache"
+                ],
+                "summary": "Warm-Up Cache",
+                "operationId": "WarmUpCache",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/handler.Map"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "msg": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/handler.Map"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "msg": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/handler.Map"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "msg": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/captcha": {
+            "get": {
+                "description": "Get a base64 encoded captcha image or a HTML page to verify for user",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Captcha"
+                ],
+                "summary": "Get Captcha",
+                "operationId": "GetCaptcha",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ResponseCaptchaGet"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/handler.Map"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "msg": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/captcha/status": {
+            "get": {
+                "description": "Get the status of the user's captcha verification",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Captcha"
+                ],
+                "summary": "Get Captcha Status",
+                "operationId": "GetCaptchaStatus",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "The page key (to apply the page-level captcha settings)",
+                        "name": "page_key",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "The site name of the page (to apply the site config overlay)",
+                        "name": "site_name",
                         "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ResponseCaptchaStatus"
+                        }
+                    }
+                }
+            }
+        },
+        "/captcha/verify": {
+            "post": {
+                "description": "Verify user enters correct captcha code",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Captcha"
+                ],
+                "summary": "Verify Captcha",
+                "operationId": "VerifyCaptcha",
+                "parameters": [
+                    {
+                        "description": "The data to check",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.ParamsCaptchaVerify"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.Map"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/handler.Map"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "img_data": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/comments": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get a list of comments by some conditions",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Comment"
+                ],
+                "summary": "Get Comment List",
+                "operationId": "GetComments",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Only the comments created after the time (format: 2006-01-02 15:04:05)",
+                        "name": "date_from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only the comments created before the time (format: 2006-01-02 15:04:05)",
+                        "name": "date_to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "The user email",
+                        "name": "email",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Enable flat_mode",
+                        "name": "flat_mode",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "The limit for pagination",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "The username",
+                        "name": "name",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "The offset for pagination",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "The comment page_key",
+                        "name": "page_key",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "page",
+                            "user",
+                            "site"
+                        ],
+                        "type": "string",
+                        "description": "The scope of comments",
+                        "name": "scope",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Search keywords",
+                        "name": "search",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "The site name of your content scope",
+                        "name": "site_name",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "date_asc",
+                            "date_desc",
+                            "vote"
+                        ],
+                        "type": "string",
+                        "description": "Sort by condition",
+                        "name": "sort_by",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "all",
+                            "mentions",
+                            "mine",
+                            "pending"
+                        ],
+                        "type": "string",
+                        "description": "Message center show type",
+                        "name": "type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Only show comments by admin",
+                        "name": "view_only_admin",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ResponseCommentList"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/handler.Map"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "msg": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Create a new comment",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Comment"
+                ],
+                "summary": "Create Comment",
+                "operationId": "CreateComment",
+                "parameters": [
+                    {
+                        "description": "The comment data",
+                        "name": "comment",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.ParamsCommentCreate"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ResponseCommentCreate"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/handler.Map"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "msg": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/handler.Map"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "msg": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/handler.Map"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "msg": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/comments/batch": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Approve, set pending, collapse, pin or delete the comments in one transaction, the comments are specified by the ids or a filter. All the comments are failed if the transaction is rolled back",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Comment"
+                ],
+                "summary": "Batch Moderate Comments",
+                "operationId": "BatchComments",
+                "parameters": [
+                    {
+                        "description": "The options",
+                        "name": "options",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.ParamsCommentBatch"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ResponseCommentBatch"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/handler.Map"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "msg": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/handler.Map"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "msg": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/comments/{id}": {
+            "get": {
+                "description": "Get the detail of a comment by comment id",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Comment"
+                ],
+                "summary": "Get a comment",
+                "operationId": "GetComment",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "The comment ID you want to get",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ResponseCommentGet"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/handler.Map"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "msg": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/handler.Map"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "msg": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Update a specific comment",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Comment"
+                ],
+                "summary": "Update Comment",
+                "operationId": "UpdateComment",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "The comment ID you want to update",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "The comment data",
+                        "name": "comment",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.ParamsCommentUpdate"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ResponseCommentUpdate"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/handler.Map"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "msg": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/handler.Map"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "msg": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/handler.Map"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "msg": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/handler.Map"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "msg": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Delete a specific comment",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Comment"
+                ],
+                "summary": "Delete Comment",
+                "operationId": "DeleteComment",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "The comment ID you want to delete",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.Map"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/handler.Map"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "msg": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/handler.Map"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "msg": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/handler.Map"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "msg": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/comments/{id}/ban": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Add the author of the comment to the blocklist, the values (such as the IP and the user ID) are filled from the comment",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Blocklist"
+                ],
+                "summary": "Ban Comment Author",
+                "operationId": "BanCommentAuthor",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "The comment ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "The options",
+                        "name": "options",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.ParamsCommentBan"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ResponseCommentBan"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/handler.Map"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "msg": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/handler.Map"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "msg": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/handler.Map"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "msg": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/handler.Map"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "msg": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/comments/{id}/report": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Report an abusive comment to the moderators, each comment can be reported once by the same user or IP",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Report"
+                ],
+                "summary": "Report Comment",
+                "operationId": "ReportComment",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "The comment ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "The report data",
+                        "name": "report",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.ParamsCommentReport"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.Map"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/handler.Map"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "msg": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/handler.Map"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "msg": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/handler.Map"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "msg": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/handler.Map"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "msg": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/comments/{id}/reports/resolve": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Close the open reports of the comment by dismissing them or taking action on the comment",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Report"
+                ],
+                "summary": "Resolve Comment Reports",
+                "operationId": "ResolveReports",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "The comment ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "The options",
+                        "name": "options",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.ParamsReportResolve"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.Map"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/handler.Map"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "msg": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/handler.Map"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "msg": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/handler.Map"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "msg": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/handler.Map"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "msg": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/comments/{id}/revisions": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get the edit history of a comment (latest first)",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Comment"
+                ],
+                "summary": "Get Comment Revisions",
+                "operationId": "GetCommentRevisions",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "The comment ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ResponseCommentRevisionList"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/handler.Map"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "msg": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/handler.Map"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "msg": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/comments/{id}/revisions/{revision_id}/restore": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Restore the content of a comment to a specific revision (the current content will be kept as a new revision)",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Comment"
+                ],
+                "summary": "Restore Comment Revision",
+                "operationId": "RestoreCommentRevision",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "The comment ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "The revision ID to restore",
+                        "name": "revision_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ResponseCommentRevisionRestore"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/handler.Map"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "msg": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/handler.Map"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "msg": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/handler.Map"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "msg": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/conf": {
+            "get": {
+                "description": "Get System Configs for UI",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "System"
+                ],
+                "summary": "Get System Configs",
+                "operationId": "Conf",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "The site name (to apply the site config overlay)",
+                        "name": "site_name",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/common.ConfData"
+                        }
+                    }
+                }
+            }
+        },
+        "/conf/auth/providers": {
+            "get": {
+                "description": "Get social login providers",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "System"
+                ],
+                "summary": "Get Social Login Providers",
+                "operationId": "GetSocialLoginProviders",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ResponseConfAuthProviders"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/handler.Map"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "msg": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/conf/domain": {
+            "get": {
+                "description": "Get Domain Info",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "System"
+                ],
+                "summary": "Get Domain Info",
+                "operationId": "GetDomain",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Domain URL",
+                        "name": "url",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ResponseConfDomain"
+                        }
+                    }
+                }
+            }
+        },
+        "/email_tasks": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get the emails in the sending queue (latest first)",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "System"
+                ],
+                "summary": "Get Email Tasks",
+                "operationId": "GetEmailTasks",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "The limit for pagination",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "The offset for pagination",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "pending",
+                            "sending",
+                            "sent",
+                            "failed"
+                        ],
+                        "type": "string",
+                        "x-enum-comments": {
+                            "EmailTaskStatusFailed": "All the attempts are failed (dead letter)",
+                            "EmailTaskStatusPending": "Waiting to be sent (including the retries)"
+                        },
+                        "x-enum-varnames": [
+                            "EmailTaskStatusPending",
+                            "EmailTaskStatusSending",
+                            "EmailTaskStatusSent",
+                            "EmailTaskStatusFailed"
+                        ],
+                        "description": "Filter by status",
+                        "name": "status",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ResponseEmailTaskList"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/handler.Map"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "msg": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/email_tasks/{id}/resend": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Put the email back into the sending queue (the attempts are reset), and return the updated task",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "System"
+                ],
+                "summary": "Resend Email",
+                "operationId": "ResendEmailTask",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "The email task ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ResponseEmailTaskResend"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/handler.Map"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "msg": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/handler.Map"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "msg": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/handler.Map"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "msg": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/handler.Map"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "msg": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/notifies": {
+            "get": {
+                "description": "Get a list of notifies for user",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Notify"
+                ],
+                "summary": "Get Notifies",
+                "operationId": "GetNotifies",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "The user name",
+                        "name": "name",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "The user email",
+                        "name": "email",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ResponseNotifyList"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/handler.Map"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "msg": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/handler.Map"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "msg": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/notifies/read": {
+            "post": {
+                "description": "Mark all notifies as read for user",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Notify"
+                ],
+                "summary": "Mark All Notifies as Read",
+                "operationId": "MarkAllNotifyRead",
+                "parameters": [
+                    {
+                        "description": "The options",
+                        "name": "options",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.ParamsNotifyReadAll"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.Map"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/handler.Map"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "msg": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/handler.Map"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "msg": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/notifies/{comment_id}/{notify_key}": {
+            "post": {
+                "description": "Mark specific notification as read for user",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Notify"
+                ],
+                "summary": "Mark Notify as Read",
+                "operationId": "MarkNotifyRead",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "The comment id of the notify you want to mark as read",
+                        "name": "comment_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "The key of the notify",
+                        "name": "notify_key",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.Map"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/handler.Map"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "msg": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/handler.Map"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "msg": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
                     },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/handler.Map"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "msg": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/pages": {
+            "get": {
+                "security": [
                     {
-                        "type": "string",
-                        "description": "The username",
-                        "name": "name",
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get a list of pages by some conditions",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Page"
+                ],
+                "summary": "Get Page List",
+                "operationId": "GetPages",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "The limit for pagination",
+                        "name": "limit",
                         "in": "query"
                     },
                     {
@@ -694,69 +2702,278 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "The comment page_key",
-                        "name": "page_key",
-                        "in": "query",
-                        "required": true
+                        "description": "Search keywords",
+                        "name": "search",
+                        "in": "query"
                     },
                     {
-                        "enum": [
-                            "page",
-                            "user",
-                            "site"
-                        ],
                         "type": "string",
-                        "description": "The scope of comments",
-                        "name": "scope",
+                        "description": "The site name of your content scope",
+                        "name": "site_name",
                         "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ResponsePageList"
+                        }
                     },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/handler.Map"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "msg": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/pages/fetch": {
+            "post": {
+                "security": [
                     {
-                        "type": "string",
-                        "description": "Search keywords",
-                        "name": "search",
-                        "in": "query"
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Fetch the data of all pages",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Page"
+                ],
+                "summary": "Fetch All Pages Data",
+                "operationId": "FetchAllPages",
+                "parameters": [
+                    {
+                        "description": "The options",
+                        "name": "options",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.ParamsPageFetchAll"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.Map"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/handler.Map"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "msg": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
                     },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/handler.Map"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "msg": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/pages/fetch/status": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get the status of the task of fetching all pages",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Page"
+                ],
+                "summary": "Get Pages Fetch Status",
+                "operationId": "GetPageFetchStatus",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ResponsePageFetchStatus"
+                        }
+                    }
+                }
+            }
+        },
+        "/pages/pv": {
+            "post": {
+                "description": "Increase and get the number of page views",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Page"
+                ],
+                "summary": "Increase Page Views (PV)",
+                "operationId": "LogPv",
+                "parameters": [
                     {
-                        "type": "string",
-                        "description": "The site name of your content scope",
-                        "name": "site_name",
-                        "in": "query"
-                    },
+                        "description": "The page to record pv",
+                        "name": "page",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.ParamsPagePV"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ResponsePagePV"
+                        }
+                    }
+                }
+            }
+        },
+        "/pages/{id}": {
+            "put": {
+                "security": [
                     {
-                        "enum": [
-                            "date_asc",
-                            "date_desc",
-                            "vote"
-                        ],
-                        "type": "string",
-                        "description": "Sort by condition",
-                        "name": "sort_by",
-                        "in": "query"
-                    },
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Update a specific page",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Page"
+                ],
+                "summary": "Update Page",
+                "operationId": "UpdatePage",
+                "parameters": [
                     {
-                        "enum": [
-                            "all",
-                            "mentions",
-                            "mine",
-                            "pending"
-                        ],
-                        "type": "string",
-                        "description": "Message center show type",
-                        "name": "type",
-                        "in": "query"
+                        "type": "integer",
+                        "description": "The page ID you want to update",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     },
                     {
-                        "type": "boolean",
-                        "description": "Only show comments by admin",
-                        "name": "view_only_admin",
-                        "in": "query"
+                        "description": "The page data",
+                        "name": "page",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.ParamsPageUpdate"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.ResponseCommentList"
+                            "$ref": "#/definitions/handler.ResponsePageUpdate"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/handler.Map"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "msg": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/handler.Map"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "msg": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/handler.Map"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "msg": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
                         }
                     },
                     "500": {
@@ -779,44 +2996,57 @@ const docTemplate = `{
                     }
                 }
             },
-            "post": {
+            "delete": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Create a new comment",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Delete a specific page",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Comment"
+                    "Page"
                 ],
-                "summary": "Create Comment",
-                "operationId": "CreateComment",
+                "summary": "Delete Page",
+                "operationId": "DeletePage",
                 "parameters": [
                     {
-                        "description": "The comment data",
-                        "name": "comment",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/handler.ParamsCommentCreate"
-                        }
+                        "type": "integer",
+                        "description": "The page ID you want to delete",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.ResponseCommentCreate"
+                            "$ref": "#/definitions/handler.Map"
                         }
                     },
-                    "400": {
-                        "description": "Bad Request",
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/handler.Map"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "msg": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
                             "allOf": [
                                 {
@@ -854,9 +3084,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/comments/{id}": {
-            "get": {
-                "description": "Get the detail of a comment by comment id",
+        "/pages/{id}/fetch": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Fetch the data of a specific page",
                 "consumes": [
                     "application/json"
                 ],
@@ -864,14 +3099,14 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Comment"
+                    "Page"
                 ],
-                "summary": "Get a comment",
-                "operationId": "GetComment",
+                "summary": "Fetch Page Data",
+                "operationId": "FetchPage",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "The comment ID you want to get",
+                        "description": "The page ID you want to fetch",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -881,7 +3116,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.ResponseCommentGet"
+                            "$ref": "#/definitions/handler.ResponsePageFetch"
                         }
                     },
                     "404": {
@@ -921,14 +3156,69 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "put": {
-                "security": [
+            }
+        },
+        "/reactions/{target_name}/{target_id}": {
+            "get": {
+                "description": "Get the emoji reactions of a specific comment",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Vote"
+                ],
+                "summary": "Get Reactions",
+                "operationId": "GetReactions",
+                "parameters": [
                     {
-                        "ApiKeyAuth": []
+                        "enum": [
+                            "comment"
+                        ],
+                        "type": "string",
+                        "description": "The name of reaction target",
+                        "name": "target_name",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "The target comment ID",
+                        "name": "target_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ResponseReaction"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/handler.Map"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "msg": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
                     }
-                ],
-                "description": "Update a specific comment",
+                }
+            }
+        },
+        "/reactions/{target_name}/{target_id}/{reaction}": {
+            "post": {
+                "description": "React to a specific comment with an emoji (react again to cancel)",
                 "consumes": [
                     "application/json"
                 ],
@@ -936,25 +3226,42 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Comment"
+                    "Vote"
                 ],
-                "summary": "Update Comment",
-                "operationId": "UpdateComment",
+                "summary": "Create Reaction",
+                "operationId": "CreateReaction",
                 "parameters": [
+                    {
+                        "enum": [
+                            "comment"
+                        ],
+                        "type": "string",
+                        "description": "The name of reaction target",
+                        "name": "target_name",
+                        "in": "path",
+                        "required": true
+                    },
                     {
                         "type": "integer",
-                        "description": "The comment ID you want to update",
-                        "name": "id",
+                        "description": "The target comment ID",
+                        "name": "target_id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "The comment data",
-                        "name": "comment",
+                        "type": "string",
+                        "description": "The emoji reaction (URL encoded)",
+                        "name": "reaction",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "The reaction data",
+                        "name": "data",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/handler.ParamsCommentUpdate"
+                            "$ref": "#/definitions/handler.ParamsReactionCreate"
                         }
                     }
                 ],
@@ -962,7 +3269,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.ResponseCommentUpdate"
+                            "$ref": "#/definitions/handler.ResponseReaction"
                         }
                     },
                     "400": {
@@ -1038,76 +3345,74 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
+            }
+        },
+        "/reports": {
+            "get": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Delete a specific comment",
+                "description": "Get the reported comments with their reports (the latest reported first), only the sites which the user manages are included",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Comment"
+                    "Report"
                 ],
-                "summary": "Delete Comment",
-                "operationId": "DeleteComment",
+                "summary": "Get Report Queue",
+                "operationId": "GetReports",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "The comment ID you want to delete",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "The limit for pagination",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "The offset for pagination",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by site name",
+                        "name": "site_name",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "open",
+                            "dismissed",
+                            "resolved"
+                        ],
+                        "type": "string",
+                        "x-enum-comments": {
+                            "CommentReportStatusDismissed": "The moderators consider the comment fine",
+                            "CommentReportStatusOpen": "Waiting for the moderators",
+                            "CommentReportStatusResolved": "The moderators took action on the comment"
+                        },
+                        "x-enum-varnames": [
+                            "CommentReportStatusOpen",
+                            "CommentReportStatusDismissed",
+                            "CommentReportStatusResolved"
+                        ],
+                        "description": "Filter by status (default: open)",
+                        "name": "status",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.Map"
-                        }
-                    },
-                    "403": {
-                        "description": "Forbidden",
-                        "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/handler.Map"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "msg": {
-                                            "type": "string"
-                                        }
-                                    }
-                                }
-                            ]
-                        }
-                    },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/handler.Map"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "msg": {
-                                            "type": "string"
-                                        }
-                                    }
-                                }
-                            ]
+                            "$ref": "#/definitions/handler.ResponseReportList"
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
+                    "400": {
+                        "description": "Bad Request",
                         "schema": {
                             "allOf": [
                                 {
@@ -1123,51 +3428,9 @@ const docTemplate = `{
                                 }
                             ]
                         }
-                    }
-                }
-            }
-        },
-        "/conf": {
-            "get": {
-                "description": "Get System Configs for UI",
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "System"
-                ],
-                "summary": "Get System Configs",
-                "operationId": "Conf",
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/common.ConfData"
-                        }
-                    }
-                }
-            }
-        },
-        "/conf/auth/providers": {
-            "get": {
-                "description": "Get social login providers",
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "System"
-                ],
-                "summary": "Get Social Login Providers",
-                "operationId": "GetSocialLoginProviders",
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/handler.ResponseConfAuthProviders"
-                        }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "403": {
+                        "description": "Forbidden",
                         "schema": {
                             "allOf": [
                                 {
@@ -1187,38 +3450,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/conf/domain": {
-            "get": {
-                "description": "Get Domain Info",
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "System"
-                ],
-                "summary": "Get Domain Info",
-                "operationId": "GetDomain",
-                "parameters": [
+        "/send_email": {
+            "post": {
+                "security": [
                     {
-                        "type": "string",
-                        "description": "Domain URL",
-                        "name": "url",
-                        "in": "query"
+                        "ApiKeyAuth": []
                     }
                 ],
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/handler.ResponseConfDomain"
-                        }
-                    }
-                }
-            }
-        },
-        "/notifies": {
-            "get": {
-                "description": "Get a list of notifies for user",
+                "description": "Send an email to test the email sender",
                 "consumes": [
                     "application/json"
                 ],
@@ -1226,35 +3465,30 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Notify"
+                    "System"
                 ],
-                "summary": "Get Notifies",
-                "operationId": "GetNotifies",
+                "summary": "Send Email",
+                "operationId": "SendEmail",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "The user name",
-                        "name": "name",
-                        "in": "query",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "The user email",
+                        "description": "The email data",
                         "name": "email",
-                        "in": "query",
-                        "required": true
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.ParamsEmailSend"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.ResponseNotifyList"
+                            "$ref": "#/definitions/handler.Map"
                         }
                     },
-                    "400": {
-                        "description": "Bad Request",
+                    "403": {
+                        "description": "Forbidden",
                         "schema": {
                             "allOf": [
                                 {
@@ -1274,58 +3508,37 @@ const docTemplate = `{
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/handler.Map"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "msg": {
-                                            "type": "string"
-                                        }
-                                    }
-                                }
-                            ]
+                            "$ref": "#/definitions/handler.Map"
                         }
                     }
                 }
             }
         },
-        "/notifies/read": {
-            "post": {
-                "description": "Mark all notifies as read for user",
-                "consumes": [
-                    "application/json"
+        "/settings": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
                 ],
+                "description": "Get settings from app config file",
                 "produces": [
                     "application/json"
                 ],
-                "tags": [
-                    "Notify"
-                ],
-                "summary": "Mark All Notifies as Read",
-                "operationId": "MarkAllNotifyRead",
-                "parameters": [
-                    {
-                        "description": "The options",
-                        "name": "options",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/handler.ParamsNotifyReadAll"
-                        }
-                    }
+                "tags": [
+                    "System"
                 ],
+                "summary": "Get Settings",
+                "operationId": "GetSettings",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.Map"
+                            "$ref": "#/definitions/handler.ResponseSettingGet"
                         }
                     },
-                    "400": {
-                        "description": "Bad Request",
+                    "403": {
+                        "description": "Forbidden",
                         "schema": {
                             "allOf": [
                                 {
@@ -1361,33 +3574,34 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/notifies/{comment_id}/{notify_key}": {
-            "post": {
-                "description": "Mark specific notification as read for user",
+            },
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Apply settings and restart the server",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Notify"
+                    "System"
                 ],
-                "summary": "Mark Notify as Read",
-                "operationId": "MarkNotifyRead",
+                "summary": "Save and apply Settings",
+                "operationId": "ApplySettings",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "The comment id of the notify you want to mark as read",
-                        "name": "comment_id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "The key of the notify",
-                        "name": "notify_key",
-                        "in": "path",
-                        "required": true
+                        "description": "The settings",
+                        "name": "settings",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.ParamsSettingApply"
+                        }
                     }
                 ],
                 "responses": {
@@ -1397,26 +3611,8 @@ const docTemplate = `{
                             "$ref": "#/definitions/handler.Map"
                         }
                     },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/handler.Map"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "msg": {
-                                            "type": "string"
-                                        }
-                                    }
-                                }
-                            ]
-                        }
-                    },
-                    "404": {
-                        "description": "Not Found",
+                    "403": {
+                        "description": "Forbidden",
                         "schema": {
                             "allOf": [
                                 {
@@ -1454,87 +3650,73 @@ const docTemplate = `{
                 }
             }
         },
-        "/pages": {
+        "/settings/template/{locale}": {
             "get": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Get a list of pages by some conditions",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Get config templates in different languages for rendering the settings page in the frontend",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Page"
+                    "System"
                 ],
-                "summary": "Get Page List",
-                "operationId": "GetPages",
+                "summary": "Get Settings Template",
+                "operationId": "GetSettingsTemplate",
                 "parameters": [
-                    {
-                        "type": "integer",
-                        "description": "The limit for pagination",
-                        "name": "limit",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "The offset for pagination",
-                        "name": "offset",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Search keywords",
-                        "name": "search",
-                        "in": "query"
-                    },
                     {
                         "type": "string",
-                        "description": "The site name of your content scope",
-                        "name": "site_name",
-                        "in": "query"
+                        "description": "The locale of the settings template you want to get",
+                        "name": "locale",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.ResponsePageList"
-                        }
-                    },
-                    "403": {
-                        "description": "Forbidden",
-                        "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/handler.Map"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "msg": {
-                                            "type": "string"
-                                        }
-                                    }
-                                }
-                            ]
+                            "$ref": "#/definitions/handler.ResponseSettingTemplate"
                         }
                     }
                 }
             }
         },
-        "/pages/fetch": {
+        "/sites": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get a list of sites by some conditions",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Site"
+                ],
+                "summary": "Get Site List",
+                "operationId": "GetSites",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ResponseSiteList"
+                        }
+                    }
+                }
+            },
             "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Fetch the data of all pages",
+                "description": "Create a new site",
                 "consumes": [
                     "application/json"
                 ],
@@ -1542,18 +3724,18 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Page"
+                    "Site"
                 ],
-                "summary": "Fetch All Pages Data",
-                "operationId": "FetchAllPages",
+                "summary": "Create Site",
+                "operationId": "CreateSite",
                 "parameters": [
                     {
-                        "description": "The options",
-                        "name": "options",
+                        "description": "The site data",
+                        "name": "site",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/handler.ParamsPageFetchAll"
+                            "$ref": "#/definitions/handler.ParamsSiteCreate"
                         }
                     }
                 ],
@@ -1561,7 +3743,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.Map"
+                            "$ref": "#/definitions/handler.ResponseSiteCreate"
                         }
                     },
                     "400": {
@@ -1582,8 +3764,8 @@ const docTemplate = `{
                             ]
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
+                    "403": {
+                        "description": "Forbidden",
                         "schema": {
                             "allOf": [
                                 {
@@ -1599,79 +3781,36 @@ const docTemplate = `{
                                 }
                             ]
                         }
-                    }
-                }
-            }
-        },
-        "/pages/fetch/status": {
-            "get": {
-                "security": [
-                    {
-                        "ApiKeyAuth": []
-                    }
-                ],
-                "description": "Get the status of the task of fetching all pages",
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "Page"
-                ],
-                "summary": "Get Pages Fetch Status",
-                "operationId": "GetPageFetchStatus",
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/handler.ResponsePageFetchStatus"
-                        }
-                    }
-                }
-            }
-        },
-        "/pages/pv": {
-            "post": {
-                "description": "Increase and get the number of page views",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "Page"
-                ],
-                "summary": "Increase Page Views (PV)",
-                "operationId": "LogPv",
-                "parameters": [
-                    {
-                        "description": "The page to record pv",
-                        "name": "page",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/handler.ParamsPagePV"
-                        }
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "OK",
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
                         "schema": {
-                            "$ref": "#/definitions/handler.ResponsePagePV"
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/handler.Map"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "msg": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
                         }
                     }
                 }
             }
         },
-        "/pages/{id}": {
+        "/sites/{id}": {
             "put": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Update a specific page",
+                "description": "Update a specific site",
                 "consumes": [
                     "application/json"
                 ],
@@ -1679,25 +3818,25 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Page"
+                    "Site"
                 ],
-                "summary": "Update Page",
-                "operationId": "UpdatePage",
+                "summary": "Update Site",
+                "operationId": "UpdateSite",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "The page ID you want to update",
+                        "description": "The site ID you want to update",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "The page data",
-                        "name": "page",
+                        "description": "The site data",
+                        "name": "site",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/handler.ParamsPageUpdate"
+                            "$ref": "#/definitions/handler.ParamsSiteUpdate"
                         }
                     }
                 ],
@@ -1705,7 +3844,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.ResponsePageUpdate"
+                            "$ref": "#/definitions/handler.ResponseSiteUpdate"
                         }
                     },
                     "400": {
@@ -1725,6 +3864,39 @@ const docTemplate = `{
                                 }
                             ]
                         }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Delete a specific site",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Site"
+                ],
+                "summary": "Site Delete",
+                "operationId": "deleteSite",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "The site ID you want to delete",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.Map"
+                        }
                     },
                     "403": {
                         "description": "Forbidden",
@@ -1781,36 +3953,84 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
-                "security": [
-                    {
-                        "ApiKeyAuth": []
-                    }
+            }
+        },
+        "/stats/{type}": {
+            "get": {
+                "description": "Get the statistics of various data analysis",
+                "consumes": [
+                    "application/json"
                 ],
-                "description": "Delete a specific page",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Page"
+                    "Statistic"
                 ],
-                "summary": "Delete Page",
-                "operationId": "DeletePage",
+                "summary": "Statistic",
+                "operationId": "GetStats",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "The page ID you want to delete",
-                        "name": "id",
+                        "enum": [
+                            "latest_comments",
+                            "latest_pages",
+                            "pv_most_pages",
+                            "comment_most_pages",
+                            "page_pv",
+                            "site_pv",
+                            "page_comment",
+                            "site_comment",
+                            "rand_comments",
+                            "rand_pages"
+                        ],
+                        "type": "string",
+                        "description": "The type of statistics",
+                        "name": "type",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "The limit for pagination",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "multiple page keys separated by commas",
+                        "name": "page_keys",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "The site name of your content scope",
+                        "name": "site_name",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.Map"
+                            "$ref": "#/definitions/common.JSONResult"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/handler.Map"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "msg": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
                         }
                     },
                     "403": {
@@ -1870,57 +4090,27 @@ const docTemplate = `{
                 }
             }
         },
-        "/pages/{id}/fetch": {
-            "post": {
+        "/transfer/export": {
+            "get": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Fetch the data of a specific page",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Export data from Artalk",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Page"
-                ],
-                "summary": "Fetch Page Data",
-                "operationId": "FetchPage",
-                "parameters": [
-                    {
-                        "type": "integer",
-                        "description": "The page ID you want to fetch",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    }
+                    "Transfer"
                 ],
+                "summary": "Export Artrans",
+                "operationId": "ExportArtrans",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.ResponsePageFetch"
-                        }
-                    },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/handler.Map"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "msg": {
-                                            "type": "string"
-                                        }
-                                    }
-                                }
-                            ]
+                            "$ref": "#/definitions/handler.ResponseTransferExport"
                         }
                     },
                     "500": {
@@ -1944,33 +4134,33 @@ const docTemplate = `{
                 }
             }
         },
-        "/send_email": {
+        "/transfer/import": {
             "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Send an email to test the email sender",
+                "description": "Import data to Artalk",
                 "consumes": [
                     "application/json"
                 ],
                 "produces": [
-                    "application/json"
+                    "text/html"
                 ],
                 "tags": [
-                    "System"
+                    "Transfer"
                 ],
-                "summary": "Send Email",
-                "operationId": "SendEmail",
+                "summary": "Import Artrans",
+                "operationId": "ImportArtrans",
                 "parameters": [
                     {
-                        "description": "The email data",
-                        "name": "email",
+                        "description": "The data to import",
+                        "name": "data",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/handler.ParamsEmailSend"
+                            "$ref": "#/definitions/handler.ParamsTransferImport"
                         }
                     }
                 ],
@@ -1978,70 +4168,52 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.Map"
-                        }
-                    },
-                    "403": {
-                        "description": "Forbidden",
-                        "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/handler.Map"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "msg": {
-                                            "type": "string"
-                                        }
-                                    }
-                                }
-                            ]
-                        }
-                    },
-                    "500": {
-                        "description": "Internal Server Error",
-                        "schema": {
-                            "$ref": "#/definitions/handler.Map"
+                            "type": "string"
                         }
                     }
                 }
             }
         },
-        "/settings": {
-            "get": {
+        "/transfer/upload": {
+            "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Get settings from app config file",
+                "description": "Upload a file to prepare to import",
+                "consumes": [
+                    "multipart/form-data"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "System"
+                    "Transfer"
+                ],
+                "summary": "Upload Artrans",
+                "operationId": "UploadArtrans",
+                "parameters": [
+                    {
+                        "type": "file",
+                        "description": "Upload file in preparation for import task",
+                        "name": "file",
+                        "in": "formData",
+                        "required": true
+                    }
                 ],
-                "summary": "Get Settings",
-                "operationId": "GetSettings",
                 "responses": {
                     "200": {
                         "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/handler.ResponseSettingGet"
-                        }
-                    },
-                    "403": {
-                        "description": "Forbidden",
                         "schema": {
                             "allOf": [
                                 {
-                                    "$ref": "#/definitions/handler.Map"
+                                    "$ref": "#/definitions/handler.ResponseTransferUpload"
                                 },
                                 {
                                     "type": "object",
                                     "properties": {
-                                        "msg": {
+                                        "filename": {
                                             "type": "string"
                                         }
                                     }
@@ -2068,41 +4240,62 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "put": {
+            }
+        },
+        "/trash/{type}": {
+            "get": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Apply settings and restart the server",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Get the deleted items in the trash (latest deleted first)",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "System"
+                    "Trash"
                 ],
-                "summary": "Save and apply Settings",
-                "operationId": "ApplySettings",
+                "summary": "Get Trash",
+                "operationId": "GetTrash",
                 "parameters": [
                     {
-                        "description": "The settings",
-                        "name": "settings",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/handler.ParamsSettingApply"
-                        }
+                        "enum": [
+                            "comments",
+                            "pages",
+                            "sites",
+                            "users"
+                        ],
+                        "type": "string",
+                        "description": "The type of the items",
+                        "name": "type",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "The limit for pagination",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "The offset for pagination",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by site name (for comments and pages)",
+                        "name": "site_name",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.Map"
+                            "$ref": "#/definitions/handler.ResponseTrashList"
                         }
                     },
                     "403": {
@@ -2123,8 +4316,8 @@ const docTemplate = `{
                             ]
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
                             "allOf": [
                                 {
@@ -2144,104 +4337,53 @@ const docTemplate = `{
                 }
             }
         },
-        "/settings/template/{locale}": {
-            "get": {
+        "/trash/{type}/{id}": {
+            "delete": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Get config templates in different languages for rendering the settings page in the frontend",
+                "description": "Permanently delete an item in the trash, and the related items which are deleted together with it",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "System"
+                    "Trash"
                 ],
-                "summary": "Get Settings Template",
-                "operationId": "GetSettingsTemplate",
+                "summary": "Purge from Trash",
+                "operationId": "PurgeTrash",
                 "parameters": [
                     {
+                        "enum": [
+                            "comments",
+                            "pages",
+                            "sites",
+                            "users"
+                        ],
                         "type": "string",
-                        "description": "The locale of the settings template you want to get",
-                        "name": "locale",
+                        "description": "The type of the item",
+                        "name": "type",
                         "in": "path",
                         "required": true
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/handler.ResponseSettingTemplate"
-                        }
-                    }
-                }
-            }
-        },
-        "/sites": {
-            "get": {
-                "security": [
-                    {
-                        "ApiKeyAuth": []
-                    }
-                ],
-                "description": "Get a list of sites by some conditions",
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "Site"
-                ],
-                "summary": "Get Site List",
-                "operationId": "GetSites",
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/handler.ResponseSiteList"
-                        }
-                    }
-                }
-            },
-            "post": {
-                "security": [
-                    {
-                        "ApiKeyAuth": []
-                    }
-                ],
-                "description": "Create a new site",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "Site"
-                ],
-                "summary": "Create Site",
-                "operationId": "CreateSite",
-                "parameters": [
+                    },
                     {
-                        "description": "The site data",
-                        "name": "site",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/handler.ParamsSiteCreate"
-                        }
+                        "type": "integer",
+                        "description": "The item ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.ResponseSiteCreate"
+                            "$ref": "#/definitions/handler.Map"
                         }
                     },
-                    "400": {
-                        "description": "Bad Request",
+                    "403": {
+                        "description": "Forbidden",
                         "schema": {
                             "allOf": [
                                 {
@@ -2258,8 +4400,8 @@ const docTemplate = `{
                             ]
                         }
                     },
-                    "403": {
-                        "description": "Forbidden",
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
                             "allOf": [
                                 {
@@ -2297,71 +4439,39 @@ const docTemplate = `{
                 }
             }
         },
-        "/sites/{id}": {
-            "put": {
+        "/trash/{type}/{id}/restore": {
+            "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Update a specific site",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Restore a deleted item, and the related items which are deleted together with it",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Site"
+                    "Trash"
                 ],
-                "summary": "Update Site",
-                "operationId": "UpdateSite",
+                "summary": "Restore from Trash",
+                "operationId": "RestoreTrash",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "The site ID you want to update",
-                        "name": "id",
+                        "enum": [
+                            "comments",
+                            "pages",
+                            "sites",
+                            "users"
+                        ],
+                        "type": "string",
+                        "description": "The type of the item",
+                        "name": "type",
                         "in": "path",
                         "required": true
                     },
-                    {
-                        "description": "The site data",
-                        "name": "site",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/handler.ParamsSiteUpdate"
-                        }
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/handler.ResponseSiteUpdate"
-                        }
-                    }
-                }
-            },
-            "delete": {
-                "security": [
-                    {
-                        "ApiKeyAuth": []
-                    }
-                ],
-                "description": "Delete a specific site",
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "Site"
-                ],
-                "summary": "Site Delete",
-                "operationId": "deleteSite",
-                "parameters": [
                     {
                         "type": "integer",
-                        "description": "The site ID you want to delete",
+                        "description": "The item ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -2374,6 +4484,24 @@ const docTemplate = `{
                             "$ref": "#/definitions/handler.Map"
                         }
                     },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/handler.Map"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "msg": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
                     "403": {
                         "description": "Forbidden",
                         "schema": {
@@ -2410,6 +4538,24 @@ const docTemplate = `{
                             ]
                         }
                     },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/handler.Map"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "msg": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -2431,55 +4577,36 @@ const docTemplate = `{
                 }
             }
         },
-        "/stats/{type}": {
-            "get": {
-                "description": "Get the statistics of various data analysis",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "Statistic"
-                ],
-                "summary": "Statistic",
-                "operationId": "GetStats",
-                "parameters": [
-                    {
-                        "enum": [
-                            "latest_comments",
-                            "latest_pages",
-                            "pv_most_pages",
-                            "comment_most_pages",
-                            "page_pv",
-                            "site_pv",
-                            "page_comment",
-                            "site_comment",
-                            "rand_comments",
-                            "rand_pages"
-                        ],
-                        "type": "string",
-                        "description": "The type of statistics",
-                        "name": "type",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "integer",
-                        "description": "The limit for pagination",
-                        "name": "limit",
-                        "in": "query"
-                    },
+        "/upload": {
+            "post": {
+                "security": [
                     {
-                        "type": "string",
-                        "description": "multiple page keys separated by commas",
-                        "name": "page_keys",
-                        "in": "query"
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Upload file from this endpoint",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Upload"
+                ],
+                "summary": "Upload",
+                "operationId": "Upload",
+                "parameters": [
+                    {
+                        "type": "file",
+                        "description": "Upload file",
+                        "name": "file",
+                        "in": "formData",
+                        "required": true
                     },
                     {
                         "type": "string",
-                        "description": "The site name of your content scope",
+                        "description": "The site name (to apply the site config overlay)",
                         "name": "site_name",
                         "in": "query"
                     }
@@ -2488,7 +4615,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/common.JSONResult"
+                            "$ref": "#/definitions/handler.ResponseUpload"
                         }
                     },
                     "400": {
@@ -2527,24 +4654,6 @@ const docTemplate = `{
                             ]
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/handler.Map"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "msg": {
-                                            "type": "string"
-                                        }
-                                    }
-                                }
-                            ]
-                        }
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -2566,31 +4675,45 @@ const docTemplate = `{
                 }
             }
         },
-        "/transfer/export": {
+        "/user": {
             "get": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Export data from Artalk",
+                "description": "Get user info to prepare for login or check current user status",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Transfer"
+                    "Auth"
+                ],
+                "summary": "Get User Info",
+                "operationId": "GetUser",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "The user email",
+                        "name": "email",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "The username",
+                        "name": "name",
+                        "in": "query"
+                    }
                 ],
-                "summary": "Export Artrans",
-                "operationId": "ExportArtrans",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.ResponseTransferExport"
+                            "$ref": "#/definitions/handler.ResponseUserInfo"
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
+                    "400": {
+                        "description": "Bad Request",
                         "schema": {
                             "allOf": [
                                 {
@@ -2608,35 +4731,33 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/transfer/import": {
+            },
             "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Import data to Artalk",
+                "description": "Update user profile when user is logged in",
                 "consumes": [
                     "application/json"
                 ],
                 "produces": [
-                    "text/html"
+                    "application/json"
                 ],
                 "tags": [
-                    "Transfer"
+                    "Auth"
                 ],
-                "summary": "Import Artrans",
-                "operationId": "ImportArtrans",
+                "summary": "Update user profile",
+                "operationId": "UpdateProfile",
                 "parameters": [
                     {
-                        "description": "The data to import",
+                        "description": "The profile data to update",
                         "name": "data",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/handler.ParamsTransferImport"
+                            "$ref": "#/definitions/handler.RequestUserInfoUpdate"
                         }
                     }
                 ],
@@ -2644,52 +4765,20 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/handler.ResponseUserInfoUpdate"
                         }
-                    }
-                }
-            }
-        },
-        "/transfer/upload": {
-            "post": {
-                "security": [
-                    {
-                        "ApiKeyAuth": []
-                    }
-                ],
-                "description": "Upload a file to prepare to import",
-                "consumes": [
-                    "multipart/form-data"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "Transfer"
-                ],
-                "summary": "Upload Artrans",
-                "operationId": "UploadArtrans",
-                "parameters": [
-                    {
-                        "type": "file",
-                        "description": "Upload file in preparation for import task",
-                        "name": "file",
-                        "in": "formData",
-                        "required": true
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "OK",
+                    },
+                    "400": {
+                        "description": "Bad Request",
                         "schema": {
                             "allOf": [
                                 {
-                                    "$ref": "#/definitions/handler.ResponseTransferUpload"
+                                    "$ref": "#/definitions/handler.Map"
                                 },
                                 {
                                     "type": "object",
                                     "properties": {
-                                        "filename": {
+                                        "msg": {
                                             "type": "string"
                                         }
                                     }
@@ -2718,43 +4807,40 @@ const docTemplate = `{
                 }
             }
         },
-        "/upload": {
+        "/user/access_token": {
             "post": {
-                "security": [
-                    {
-                        "ApiKeyAuth": []
-                    }
-                ],
-                "description": "Upload file from this endpoint",
+                "description": "Login user by name or email",
                 "consumes": [
-                    "multipart/form-data"
+                    "application/json"
                 ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Upload"
+                    "Auth"
                 ],
-                "summary": "Upload",
-                "operationId": "Upload",
+                "summary": "Get Access Token",
+                "operationId": "Login",
                 "parameters": [
                     {
-                        "type": "file",
-                        "description": "Upload file",
-                        "name": "file",
-                        "in": "formData",
-                        "required": true
+                        "description": "The user login data",
+                        "name": "user",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.ParamsUserLogin"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.ResponseUpload"
+                            "$ref": "#/definitions/handler.ResponseUserLogin"
                         }
                     },
                     "400": {
-                        "description": "Bad Request",
+                        "description": "Multiple users with the same email address are matched",
                         "schema": {
                             "allOf": [
                                 {
@@ -2763,6 +4849,17 @@ const docTemplate = `{
                                 {
                                     "type": "object",
                                     "properties": {
+                                        "data": {
+                                            "type": "object",
+                                            "properties": {
+                                                "need_name_select": {
+                                                    "type": "array",
+                                                    "items": {
+                                                        "type": "string"
+                                                    }
+                                                }
+                                            }
+                                        },
                                         "msg": {
                                             "type": "string"
                                         }
@@ -2771,8 +4868,8 @@ const docTemplate = `{
                             ]
                         }
                     },
-                    "403": {
-                        "description": "Forbidden",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "allOf": [
                                 {
@@ -2810,41 +4907,48 @@ const docTemplate = `{
                 }
             }
         },
-        "/user": {
-            "get": {
+        "/user/comments/{id}": {
+            "put": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Get user info to prepare for login or check current user status",
+                "description": "Edit the content of a comment posted by the current logged-in user",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Auth"
+                    "Comment"
                 ],
-                "summary": "Get User Info",
-                "operationId": "GetUser",
+                "summary": "Edit own comment",
+                "operationId": "EditComment",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "The user email",
-                        "name": "email",
-                        "in": "query"
+                        "type": "integer",
+                        "description": "The comment ID you want to edit",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     },
                     {
-                        "type": "string",
-                        "description": "The username",
-                        "name": "name",
-                        "in": "query"
+                        "description": "The new comment content",
+                        "name": "comment",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.ParamsCommentEdit"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.ResponseUserInfo"
+                            "$ref": "#/definitions/handler.ResponseCommentEdit"
                         }
                     },
                     "400": {
@@ -2864,47 +4968,45 @@ const docTemplate = `{
                                 }
                             ]
                         }
-                    }
-                }
-            },
-            "post": {
-                "security": [
-                    {
-                        "ApiKeyAuth": []
-                    }
-                ],
-                "description": "Update user profile when user is logged in",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "Auth"
-                ],
-                "summary": "Update user profile",
-                "operationId": "UpdateProfile",
-                "parameters": [
-                    {
-                        "description": "The profile data to update",
-                        "name": "data",
-                        "in": "body",
-                        "required": true,
+                    },
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
-                            "$ref": "#/definitions/handler.RequestUserInfoUpdate"
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/handler.Map"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "msg": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
                         }
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "OK",
+                    },
+                    "403": {
+                        "description": "Forbidden",
                         "schema": {
-                            "$ref": "#/definitions/handler.ResponseUserInfoUpdate"
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/handler.Map"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "msg": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
                         }
                     },
-                    "400": {
-                        "description": "Bad Request",
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
                             "allOf": [
                                 {
@@ -2940,42 +5042,40 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/user/access_token": {
-            "post": {
-                "description": "Login user by name or email",
-                "consumes": [
-                    "application/json"
+            },
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
                 ],
+                "description": "Delete a comment posted by the current logged-in user (the comment with replies will be replaced by a tombstone)",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Auth"
+                    "Comment"
                 ],
-                "summary": "Get Access Token",
-                "operationId": "Login",
+                "summary": "Delete own comment",
+                "operationId": "WithdrawComment",
                 "parameters": [
                     {
-                        "description": "The user login data",
-                        "name": "user",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/handler.ParamsUserLogin"
-                        }
+                        "type": "integer",
+                        "description": "The comment ID you want to delete",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.ResponseUserLogin"
+                            "$ref": "#/definitions/handler.ResponseCommentWithdraw"
                         }
                     },
-                    "400": {
-                        "description": "Multiple users with the same email address are matched",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "allOf": [
                                 {
@@ -2984,17 +5084,6 @@ const docTemplate = `{
                                 {
                                     "type": "object",
                                     "properties": {
-                                        "data": {
-                                            "type": "object",
-                                            "properties": {
-                                                "need_name_select": {
-                                                    "type": "array",
-                                                    "items": {
-                                                        "type": "string"
-                                                    }
-                                                }
-                                            }
-                                        },
                                         "msg": {
                                             "type": "string"
                                         }
@@ -3003,8 +5092,26 @@ const docTemplate = `{
                             ]
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/handler.Map"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "msg": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
                             "allOf": [
                                 {
@@ -3740,6 +5847,197 @@ const docTemplate = `{
                     }
                 }
             }
+        },
+        "/webhook_deliveries": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get the delivery log of the webhooks (latest first)",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Webhook"
+                ],
+                "summary": "Get Webhook Deliveries",
+                "operationId": "GetWebhookDeliveries",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Filter by event",
+                        "name": "event",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "The limit for pagination",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "The offset for pagination",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "pending",
+                            "success",
+                            "failed"
+                        ],
+                        "type": "string",
+                        "x-enum-comments": {
+                            "WebhookDeliveryStatusFailed": "All the attempts are failed",
+                            "WebhookDeliveryStatusPending": "Being delivered (including the retries)"
+                        },
+                        "x-enum-varnames": [
+                            "WebhookDeliveryStatusPending",
+                            "WebhookDeliveryStatusSuccess",
+                            "WebhookDeliveryStatusFailed"
+                        ],
+                        "description": "Filter by status",
+                        "name": "status",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ResponseWebhookDeliveryList"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/handler.Map"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "msg": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/webhook_deliveries/{id}/redeliver": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Send the payload of the delivery again in the background (without retries), and return the delivery marked as pending. The pending delivery is refused unless it is interrupted (e.g. by a restart)",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Webhook"
+                ],
+                "summary": "Redeliver Webhook",
+                "operationId": "RedeliverWebhook",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "The delivery ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ResponseWebhookRedeliver"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/handler.Map"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "msg": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/handler.Map"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "msg": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/handler.Map"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "msg": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/handler.Map"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "msg": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
         }
     },
     "definitions": {
@@ -3819,26 +6117,147 @@ const docTemplate = `{
             "type": "object",
             "additionalProperties": true
         },
+        "entity.BlocklistType": {
+            "type": "string",
+            "enum": [
+                "ip",
+                "email",
+                "email_domain",
+                "user_id",
+                "ua"
+            ],
+            "x-enum-comments": {
+                "BlocklistTypeEmail": "The email address",
+                "BlocklistTypeEmailDomain": "The domain of the email address (including the subdomains)",
+                "BlocklistTypeIP": "The IP address or the CIDR range",
+                "BlocklistTypeUA": "The regular expression of the user agent",
+                "BlocklistTypeUserID": "The user ID"
+            },
+            "x-enum-varnames": [
+                "BlocklistTypeIP",
+                "BlocklistTypeEmail",
+                "BlocklistTypeEmailDomain",
+                "BlocklistTypeUserID",
+                "BlocklistTypeUA"
+            ]
+        },
+        "entity.CommentReportStatus": {
+            "type": "string",
+            "enum": [
+                "open",
+                "dismissed",
+                "resolved"
+            ],
+            "x-enum-comments": {
+                "CommentReportStatusDismissed": "The moderators consider the comment fine",
+                "CommentReportStatusOpen": "Waiting for the moderators",
+                "CommentReportStatusResolved": "The moderators took action on the comment"
+            },
+            "x-enum-varnames": [
+                "CommentReportStatusOpen",
+                "CommentReportStatusDismissed",
+                "CommentReportStatusResolved"
+            ]
+        },
+        "entity.CookedAntiSpamVerdict": {
+            "type": "object",
+            "required": [
+                "checker",
+                "date",
+                "matched",
+                "pass",
+                "reason",
+                "response",
+                "score"
+            ],
+            "properties": {
+                "checker": {
+                    "type": "string"
+                },
+                "date": {
+                    "type": "string"
+                },
+                "matched": {
+                    "type": "string"
+                },
+                "pass": {
+                    "type": "boolean"
+                },
+                "reason": {
+                    "type": "string"
+                },
+                "response": {
+                    "type": "string"
+                },
+                "score": {
+                    "type": "number"
+                }
+            }
+        },
+        "entity.CookedBlocklistItem": {
+            "type": "object",
+            "required": [
+                "date",
+                "expires_at",
+                "id",
+                "is_expired",
+                "reason",
+                "type",
+                "value"
+            ],
+            "properties": {
+                "date": {
+                    "type": "string"
+                },
+                "expires_at": {
+                    "description": "Empty if never expires",
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "is_expired": {
+                    "type": "boolean"
+                },
+                "reason": {
+                    "type": "string"
+                },
+                "type": {
+                    "$ref": "#/definitions/entity.BlocklistType"
+                },
+                "value": {
+                    "type": "string"
+                }
+            }
+        },
         "entity.CookedComment": {
             "type": "object",
             "required": [
+                "anti_spam_verdict",
                 "badge_color",
                 "badge_name",
                 "content",
                 "content_marked",
                 "date",
+                "deleted_at",
+                "edited_at",
                 "email_encrypted",
                 "id",
                 "ip_region",
                 "is_allow_reply",
                 "is_collapsed",
+                "is_edited",
                 "is_pending",
                 "is_pinned",
+                "is_shadow_banned",
+                "is_tombstone",
                 "is_verified",
                 "link",
+                "my_reactions",
                 "nick",
                 "page_key",
                 "page_url",
+                "reactions",
                 "rid",
                 "site_name",
                 "ua",
@@ -3848,6 +6267,14 @@ const docTemplate = `{
                 "vote_up"
             ],
             "properties": {
+                "anti_spam_verdict": {
+                    "description": "The anti-spam verdict (only for admins)",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.CookedAntiSpamVerdict"
+                        }
+                    ]
+                },
                 "badge_color": {
                     "type": "string"
                 },
@@ -3863,6 +6290,13 @@ const docTemplate = `{
                 "date": {
                     "type": "string"
                 },
+                "deleted_at": {
+                    "description": "The time moved into the trash",
+                    "type": "string"
+                },
+                "edited_at": {
+                    "type": "string"
+                },
                 "email_encrypted": {
                     "type": "string"
                 },
@@ -3878,18 +6312,35 @@ const docTemplate = `{
                 "is_collapsed": {
                     "type": "boolean"
                 },
+                "is_edited": {
+                    "type": "boolean"
+                },
                 "is_pending": {
                     "type": "boolean"
                 },
                 "is_pinned": {
                     "type": "boolean"
                 },
+                "is_shadow_banned": {
+                    "description": "The author is shadow-banned (only for admins)",
+                    "type": "boolean"
+                },
+                "is_tombstone": {
+                    "type": "boolean"
+                },
                 "is_verified": {
                     "type": "boolean"
                 },
                 "link": {
                     "type": "string"
                 },
+                "my_reactions": {
+                    "description": "The reactions made by the current visitor",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
                 "nick": {
                     "type": "string"
                 },
@@ -3899,6 +6350,13 @@ const docTemplate = `{
                 "page_url": {
                     "type": "string"
                 },
+                "reactions": {
+                    "description": "The count of each emoji reaction",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "integer"
+                    }
+                },
                 "rid": {
                     "type": "integer"
                 },
@@ -3922,12 +6380,173 @@ const docTemplate = `{
                 }
             }
         },
+        "entity.CookedCommentReport": {
+            "type": "object",
+            "required": [
+                "comment_id",
+                "date",
+                "id",
+                "ip",
+                "reason",
+                "status",
+                "ua",
+                "user_id",
+                "user_name"
+            ],
+            "properties": {
+                "comment_id": {
+                    "type": "integer"
+                },
+                "date": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "ip": {
+                    "type": "string"
+                },
+                "reason": {
+                    "type": "string"
+                },
+                "status": {
+                    "enum": [
+                        "open",
+                        "dismissed",
+                        "resolved"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.CommentReportStatus"
+                        }
+                    ]
+                },
+                "ua": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "integer"
+                },
+                "user_name": {
+                    "type": "string"
+                }
+            }
+        },
+        "entity.CookedCommentRevision": {
+            "type": "object",
+            "required": [
+                "comment_id",
+                "content",
+                "content_marked",
+                "date",
+                "id",
+                "nick",
+                "user_id"
+            ],
+            "properties": {
+                "comment_id": {
+                    "type": "integer"
+                },
+                "content": {
+                    "type": "string"
+                },
+                "content_marked": {
+                    "type": "string"
+                },
+                "date": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "nick": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "entity.CookedEmailTask": {
+            "type": "object",
+            "required": [
+                "attempts",
+                "body",
+                "date",
+                "error",
+                "from_addr",
+                "from_name",
+                "id",
+                "next_attempt_at",
+                "notify_id",
+                "sent_at",
+                "status",
+                "subject",
+                "to_addr",
+                "updated_at"
+            ],
+            "properties": {
+                "attempts": {
+                    "type": "integer"
+                },
+                "body": {
+                    "type": "string"
+                },
+                "date": {
+                    "type": "string"
+                },
+                "error": {
+                    "type": "string"
+                },
+                "from_addr": {
+                    "type": "string"
+                },
+                "from_name": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "next_attempt_at": {
+                    "type": "string"
+                },
+                "notify_id": {
+                    "type": "integer"
+                },
+                "sent_at": {
+                    "type": "string"
+                },
+                "status": {
+                    "enum": [
+                        "pending",
+                        "sending",
+                        "sent",
+                        "failed"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.EmailTaskStatus"
+                        }
+                    ]
+                },
+                "subject": {
+                    "type": "string"
+                },
+                "to_addr": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
         "entity.CookedNotify": {
             "type": "object",
             "required": [
                 "comment_id",
                 "id",
                 "is_emailed",
+                "is_mention",
                 "is_read",
                 "read_link",
                 "user_id"
@@ -3942,6 +6561,9 @@ const docTemplate = `{
                 "is_emailed": {
                     "type": "boolean"
                 },
+                "is_mention": {
+                    "type": "boolean"
+                },
                 "is_read": {
                     "type": "boolean"
                 },
@@ -3957,10 +6579,16 @@ const docTemplate = `{
             "type": "object",
             "required": [
                 "admin_only",
+                "close_after_days",
+                "close_at",
                 "date",
+                "deleted_at",
                 "id",
+                "is_closed",
+                "is_locked",
                 "key",
                 "pv",
+                "settings",
                 "site_name",
                 "title",
                 "url",
@@ -3971,18 +6599,46 @@ const docTemplate = `{
                 "admin_only": {
                     "type": "boolean"
                 },
+                "close_after_days": {
+                    "description": "Comments are closed automatically after N days since the page created (0 means never)",
+                    "type": "integer"
+                },
+                "close_at": {
+                    "description": "The time when the comments are closed automatically",
+                    "type": "string"
+                },
                 "date": {
                     "type": "string"
                 },
+                "deleted_at": {
+                    "description": "The time moved into the trash",
+                    "type": "string"
+                },
                 "id": {
                     "type": "integer"
                 },
+                "is_closed": {
+                    "description": "Whether the comments are closed now (no more comments or replies)",
+                    "type": "boolean"
+                },
+                "is_locked": {
+                    "description": "Comments are closed manually",
+                    "type": "boolean"
+                },
                 "key": {
                     "type": "string"
                 },
                 "pv": {
                     "type": "integer"
                 },
+                "settings": {
+                    "description": "The page-level settings to override the global config",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.PageSettings"
+                        }
+                    ]
+                },
                 "site_name": {
                     "type": "string"
                 },
@@ -3995,21 +6651,76 @@ const docTemplate = `{
                 "vote_down": {
                     "type": "integer"
                 },
-                "vote_up": {
-                    "type": "integer"
+                "vote_up": {
+                    "type": "integer"
+                }
+            }
+        },
+        "entity.CookedSite": {
+            "type": "object",
+            "required": [
+                "deleted_at",
+                "first_url",
+                "id",
+                "name",
+                "reaction_types",
+                "urls",
+                "urls_raw"
+            ],
+            "properties": {
+                "deleted_at": {
+                    "description": "The time moved into the trash",
+                    "type": "string"
+                },
+                "first_url": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "reaction_types": {
+                    "description": "The available emoji reactions of the site (empty means using the default of config)",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "urls": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "urls_raw": {
+                    "type": "string"
                 }
             }
         },
-        "entity.CookedSite": {
+        "entity.CookedSiteForAdmin": {
             "type": "object",
             "required": [
+                "config_overlay",
+                "deleted_at",
                 "first_url",
                 "id",
                 "name",
+                "reaction_types",
                 "urls",
                 "urls_raw"
             ],
             "properties": {
+                "config_overlay": {
+                    "description": "The site config overlay merged over the global config",
+                    "type": "object",
+                    "additionalProperties": {}
+                },
+                "deleted_at": {
+                    "description": "The time moved into the trash",
+                    "type": "string"
+                },
                 "first_url": {
                     "type": "string"
                 },
@@ -4019,6 +6730,13 @@ const docTemplate = `{
                 "name": {
                     "type": "string"
                 },
+                "reaction_types": {
+                    "description": "The available emoji reactions of the site (empty means using the default of config)",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
                 "urls": {
                     "type": "array",
                     "items": {
@@ -4036,11 +6754,13 @@ const docTemplate = `{
                 "badge_color",
                 "badge_name",
                 "email",
+                "email_digest",
                 "id",
                 "is_admin",
                 "link",
                 "name",
-                "receive_email"
+                "receive_email",
+                "site_roles"
             ],
             "properties": {
                 "badge_color": {
@@ -4052,6 +6772,10 @@ const docTemplate = `{
                 "email": {
                     "type": "string"
                 },
+                "email_digest": {
+                    "description": "The frequency of the notification emails (empty if the config default is used)",
+                    "type": "string"
+                },
                 "id": {
                     "type": "integer"
                 },
@@ -4066,6 +6790,13 @@ const docTemplate = `{
                 },
                 "receive_email": {
                     "type": "boolean"
+                },
+                "site_roles": {
+                    "description": "The roles on the sites (site name =\u003e role)",
+                    "type": "object",
+                    "additionalProperties": {
+                        "$ref": "#/definitions/entity.SiteRole"
+                    }
                 }
             }
         },
@@ -4075,15 +6806,20 @@ const docTemplate = `{
                 "badge_color",
                 "badge_name",
                 "comment_count",
+                "deleted_at",
                 "email",
+                "email_digest",
                 "id",
                 "is_admin",
                 "is_in_conf",
+                "is_shadow_banned",
                 "last_ip",
                 "last_ua",
                 "link",
                 "name",
-                "receive_email"
+                "receive_email",
+                "site_roles",
+                "trust_level"
             ],
             "properties": {
                 "badge_color": {
@@ -4095,9 +6831,17 @@ const docTemplate = `{
                 "comment_count": {
                     "type": "integer"
                 },
+                "deleted_at": {
+                    "description": "The time moved into the trash",
+                    "type": "string"
+                },
                 "email": {
                     "type": "string"
                 },
+                "email_digest": {
+                    "description": "The frequency of the notification emails (empty if the config default is used)",
+                    "type": "string"
+                },
                 "id": {
                     "type": "integer"
                 },
@@ -4107,6 +6851,9 @@ const docTemplate = `{
                 "is_in_conf": {
                     "type": "boolean"
                 },
+                "is_shadow_banned": {
+                    "type": "boolean"
+                },
                 "last_ip": {
                     "type": "string"
                 },
@@ -4121,6 +6868,236 @@ const docTemplate = `{
                 },
                 "receive_email": {
                     "type": "boolean"
+                },
+                "site_roles": {
+                    "description": "The roles on the sites (site name =\u003e role)",
+                    "type": "object",
+                    "additionalProperties": {
+                        "$ref": "#/definitions/entity.SiteRole"
+                    }
+                },
+                "trust_level": {
+                    "type": "string",
+                    "enum": [
+                        "auto",
+                        "trusted",
+                        "untrusted"
+                    ]
+                }
+            }
+        },
+        "entity.CookedWebhookDelivery": {
+            "type": "object",
+            "required": [
+                "attempts",
+                "date",
+                "error",
+                "event",
+                "id",
+                "payload",
+                "response",
+                "site_name",
+                "status",
+                "status_code",
+                "updated_at",
+                "url"
+            ],
+            "properties": {
+                "attempts": {
+                    "type": "integer"
+                },
+                "date": {
+                    "type": "string"
+                },
+                "error": {
+                    "type": "string"
+                },
+                "event": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "payload": {
+                    "type": "string"
+                },
+                "response": {
+                    "type": "string"
+                },
+                "site_name": {
+                    "type": "string"
+                },
+                "status": {
+                    "enum": [
+                        "pending",
+                        "success",
+                        "failed"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.WebhookDeliveryStatus"
+                        }
+                    ]
+                },
+                "status_code": {
+                    "type": "integer"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "url": {
+                    "type": "string"
+                }
+            }
+        },
+        "entity.EmailDigestFrequency": {
+            "type": "string",
+            "enum": [
+                "immediate",
+                "daily",
+                "weekly"
+            ],
+            "x-enum-comments": {
+                "EmailDigestDaily": "Send a summary of the unread notifications every day",
+                "EmailDigestImmediate": "Send one email per notification",
+                "EmailDigestWeekly": "Send a summary of the unread notifications every week"
+            },
+            "x-enum-varnames": [
+                "EmailDigestImmediate",
+                "EmailDigestDaily",
+                "EmailDigestWeekly"
+            ]
+        },
+        "entity.EmailTaskStatus": {
+            "type": "string",
+            "enum": [
+                "pending",
+                "sending",
+                "sent",
+                "failed"
+            ],
+            "x-enum-comments": {
+                "EmailTaskStatusFailed": "All the attempts are failed (dead letter)",
+                "EmailTaskStatusPending": "Waiting to be sent (including the retries)"
+            },
+            "x-enum-varnames": [
+                "EmailTaskStatusPending",
+                "EmailTaskStatusSending",
+                "EmailTaskStatusSent",
+                "EmailTaskStatusFailed"
+            ]
+        },
+        "entity.PageSettings": {
+            "type": "object",
+            "required": [
+                "captcha_always",
+                "flat_mode",
+                "pending_default",
+                "sort_rule"
+            ],
+            "properties": {
+                "captcha_always": {
+                    "description": "Override ` + "`" + `captcha.always` + "`" + `",
+                    "type": "boolean"
+                },
+                "flat_mode": {
+                    "description": "The default flat mode of the comment list",
+                    "type": "boolean"
+                },
+                "pending_default": {
+                    "description": "Override ` + "`" + `moderator.pending_default` + "`" + `",
+                    "type": "boolean"
+                },
+                "sort_rule": {
+                    "description": "The default sort rule of the comment list (date_asc, date_desc, vote)",
+                    "type": "string"
+                }
+            }
+        },
+        "entity.SiteRole": {
+            "type": "string",
+            "enum": [
+                "admin",
+                "moderator"
+            ],
+            "x-enum-comments": {
+                "SiteRoleAdmin": "Manage the comments and pages of the site",
+                "SiteRoleModerator": "Approve, collapse and delete the comments of the site"
+            },
+            "x-enum-varnames": [
+                "SiteRoleAdmin",
+                "SiteRoleModerator"
+            ]
+        },
+        "entity.UserTrustLevel": {
+            "type": "string",
+            "enum": [
+                "auto",
+                "trusted",
+                "untrusted"
+            ],
+            "x-enum-comments": {
+                "UserTrustLevelAuto": "Trusted after enough approved comments with no rejected ones",
+                "UserTrustLevelTrusted": "Always trusted",
+                "UserTrustLevelUntrusted": "Never trusted"
+            },
+            "x-enum-varnames": [
+                "UserTrustLevelAuto",
+                "UserTrustLevelTrusted",
+                "UserTrustLevelUntrusted"
+            ]
+        },
+        "entity.WebhookDeliveryStatus": {
+            "type": "string",
+            "enum": [
+                "pending",
+                "success",
+                "failed"
+            ],
+            "x-enum-comments": {
+                "WebhookDeliveryStatusFailed": "All the attempts are failed",
+                "WebhookDeliveryStatusPending": "Being delivered (including the retries)"
+            },
+            "x-enum-varnames": [
+                "WebhookDeliveryStatusPending",
+                "WebhookDeliveryStatusSuccess",
+                "WebhookDeliveryStatusFailed"
+            ]
+        },
+        "handler.CommentBatchAction": {
+            "type": "string",
+            "enum": [
+                "approve",
+                "pending",
+                "collapse",
+                "pin",
+                "delete"
+            ],
+            "x-enum-varnames": [
+                "CommentBatchApprove",
+                "CommentBatchPending",
+                "CommentBatchCollapse",
+                "CommentBatchPin",
+                "CommentBatchDelete"
+            ]
+        },
+        "handler.CommentBatchResult": {
+            "type": "object",
+            "required": [
+                "error",
+                "id",
+                "success"
+            ],
+            "properties": {
+                "error": {
+                    "description": "The reason of the failure",
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "success": {
+                    "type": "boolean"
                 }
             }
         },
@@ -4128,6 +7105,78 @@ const docTemplate = `{
             "type": "object",
             "additionalProperties": true
         },
+        "handler.ParamsBlocklistCreate": {
+            "type": "object",
+            "required": [
+                "type",
+                "value"
+            ],
+            "properties": {
+                "expires_at": {
+                    "description": "The expiry time (format: 2006-01-02 15:04:05), never expires if empty",
+                    "type": "string"
+                },
+                "reason": {
+                    "description": "The reason for blocking",
+                    "type": "string"
+                },
+                "type": {
+                    "description": "The item type",
+                    "enum": [
+                        "ip",
+                        "email",
+                        "email_domain",
+                        "user_id",
+                        "ua"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.BlocklistType"
+                        }
+                    ]
+                },
+                "value": {
+                    "description": "The IP or CIDR, email, email domain, user ID or UA regular expression",
+                    "type": "string"
+                }
+            }
+        },
+        "handler.ParamsBlocklistUpdate": {
+            "type": "object",
+            "required": [
+                "type",
+                "value"
+            ],
+            "properties": {
+                "expires_at": {
+                    "description": "The expiry time (format: 2006-01-02 15:04:05), never expires if empty",
+                    "type": "string"
+                },
+                "reason": {
+                    "description": "The reason for blocking",
+                    "type": "string"
+                },
+                "type": {
+                    "description": "The item type",
+                    "enum": [
+                        "ip",
+                        "email",
+                        "email_domain",
+                        "user_id",
+                        "ua"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.BlocklistType"
+                        }
+                    ]
+                },
+                "value": {
+                    "description": "The IP or CIDR, email, email domain, user ID or UA regular expression",
+                    "type": "string"
+                }
+            }
+        },
         "handler.ParamsCaptchaVerify": {
             "type": "object",
             "required": [
@@ -4140,6 +7189,93 @@ const docTemplate = `{
                 }
             }
         },
+        "handler.ParamsCommentBan": {
+            "type": "object",
+            "properties": {
+                "expires_at": {
+                    "description": "The expiry time (format: 2006-01-02 15:04:05), never expires if empty",
+                    "type": "string"
+                },
+                "reason": {
+                    "description": "The reason for blocking",
+                    "type": "string"
+                },
+                "types": {
+                    "description": "The item types to block (default: ip and user_id)",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entity.BlocklistType"
+                    }
+                }
+            }
+        },
+        "handler.ParamsCommentBatch": {
+            "type": "object",
+            "required": [
+                "action"
+            ],
+            "properties": {
+                "action": {
+                    "description": "The action for the comments",
+                    "enum": [
+                        "approve",
+                        "pending",
+                        "collapse",
+                        "pin",
+                        "delete"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/handler.CommentBatchAction"
+                        }
+                    ]
+                },
+                "filter": {
+                    "description": "The filter of the comments (at most 1000 comments are matched, the earliest first)",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/handler.ParamsCommentBatchFilter"
+                        }
+                    ]
+                },
+                "ids": {
+                    "description": "The comment IDs (either the ids or the filter is required)",
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                }
+            }
+        },
+        "handler.ParamsCommentBatchFilter": {
+            "type": "object",
+            "properties": {
+                "date_from": {
+                    "description": "Only the comments created after the time (format: 2006-01-02 15:04:05)",
+                    "type": "string"
+                },
+                "date_to": {
+                    "description": "Only the comments created before the time (format: 2006-01-02 15:04:05)",
+                    "type": "string"
+                },
+                "search": {
+                    "description": "Search keywords",
+                    "type": "string"
+                },
+                "site_name": {
+                    "description": "Filter by site name",
+                    "type": "string"
+                },
+                "type": {
+                    "description": "Filter by the pending status",
+                    "type": "string",
+                    "enum": [
+                        "all",
+                        "pending"
+                    ]
+                }
+            }
+        },
         "handler.ParamsCommentCreate": {
             "type": "object",
             "required": [
@@ -4188,6 +7324,30 @@ const docTemplate = `{
                 }
             }
         },
+        "handler.ParamsCommentEdit": {
+            "type": "object",
+            "required": [
+                "content"
+            ],
+            "properties": {
+                "content": {
+                    "description": "The new comment content",
+                    "type": "string"
+                }
+            }
+        },
+        "handler.ParamsCommentReport": {
+            "type": "object",
+            "required": [
+                "reason"
+            ],
+            "properties": {
+                "reason": {
+                    "description": "The reason for reporting",
+                    "type": "string"
+                }
+            }
+        },
         "handler.ParamsCommentUpdate": {
             "type": "object",
             "required": [
@@ -4331,10 +7491,26 @@ const docTemplate = `{
                     "description": "Updated page admin_only option",
                     "type": "boolean"
                 },
+                "close_after_days": {
+                    "description": "Updated page close_after_days option (close the comments after N days since the page created, 0 means never)",
+                    "type": "integer"
+                },
+                "is_locked": {
+                    "description": "Updated page is_locked option (close the comments manually)",
+                    "type": "boolean"
+                },
                 "key": {
                     "description": "Updated page key",
                     "type": "string"
                 },
+                "settings": {
+                    "description": "Updated page-level settings to override the global config",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.PageSettings"
+                        }
+                    ]
+                },
                 "site_name": {
                     "description": "The site name of your content scope",
                     "type": "string"
@@ -4345,6 +7521,37 @@ const docTemplate = `{
                 }
             }
         },
+        "handler.ParamsReactionCreate": {
+            "type": "object",
+            "properties": {
+                "email": {
+                    "description": "The user email",
+                    "type": "string"
+                },
+                "name": {
+                    "description": "The username",
+                    "type": "string"
+                }
+            }
+        },
+        "handler.ParamsReportResolve": {
+            "type": "object",
+            "required": [
+                "action"
+            ],
+            "properties": {
+                "action": {
+                    "description": "Dismiss the reports (the comment is kept as is), or take action on the comment",
+                    "type": "string",
+                    "enum": [
+                        "dismiss",
+                        "collapse",
+                        "pending",
+                        "delete"
+                    ]
+                }
+            }
+        },
         "handler.ParamsSettingApply": {
             "type": "object",
             "required": [
@@ -4364,10 +7571,22 @@ const docTemplate = `{
                 "urls"
             ],
             "properties": {
+                "config_overlay": {
+                    "description": "The site config overlay merged over the global config (only moderator, captcha, email.mail_tpl, email.mail_subject, email.send_name, admin_notify, img_upload.enabled and img_upload.max_size)",
+                    "type": "object",
+                    "additionalProperties": {}
+                },
                 "name": {
                     "description": "The site name",
                     "type": "string"
                 },
+                "reaction_types": {
+                    "description": "The available emoji reactions (empty means using the default of config)",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
                 "urls": {
                     "description": "The site urls",
                     "type": "array",
@@ -4384,10 +7603,22 @@ const docTemplate = `{
                 "urls"
             ],
             "properties": {
+                "config_overlay": {
+                    "description": "Updated site config overlay (not changed if omitted, empty means no overlay)",
+                    "type": "object",
+                    "additionalProperties": {}
+                },
                 "name": {
                     "description": "Updated site name",
                     "type": "string"
                 },
+                "reaction_types": {
+                    "description": "Updated available emoji reactions (not changed if omitted, empty means using the default of config)",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
                 "urls": {
                     "description": "Updated site urls",
                     "type": "array",
@@ -4455,6 +7686,10 @@ const docTemplate = `{
                     "description": "The user is an admin",
                     "type": "boolean"
                 },
+                "is_shadow_banned": {
+                    "description": "The user is shadow-banned (the comments are only visible to the user and the admins)",
+                    "type": "boolean"
+                },
                 "link": {
                     "description": "The user link",
                     "type": "string"
@@ -4470,6 +7705,13 @@ const docTemplate = `{
                 "receive_email": {
                     "description": "The user receive email",
                     "type": "boolean"
+                },
+                "site_roles": {
+                    "description": "The roles on the sites (site name =\u003e ` + "`" + `admin` + "`" + ` or ` + "`" + `moderator` + "`" + `)",
+                    "type": "object",
+                    "additionalProperties": {
+                        "$ref": "#/definitions/entity.SiteRole"
+                    }
                 }
             }
         },
@@ -4515,10 +7757,27 @@ const docTemplate = `{
                     "description": "The user email",
                     "type": "string"
                 },
+                "email_digest": {
+                    "description": "The frequency of the notification emails (the config default is used if empty), unchanged if omitted",
+                    "enum": [
+                        "immediate",
+                        "daily",
+                        "weekly"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.EmailDigestFrequency"
+                        }
+                    ]
+                },
                 "is_admin": {
                     "description": "The user is an admin",
                     "type": "boolean"
                 },
+                "is_shadow_banned": {
+                    "description": "The user is shadow-banned (the comments are only visible to the user and the admins), unchanged if omitted",
+                    "type": "boolean"
+                },
                 "link": {
                     "description": "The user link",
                     "type": "string"
@@ -4534,6 +7793,26 @@ const docTemplate = `{
                 "receive_email": {
                     "description": "The user receive email",
                     "type": "boolean"
+                },
+                "site_roles": {
+                    "description": "The roles on the sites (site name =\u003e ` + "`" + `admin` + "`" + ` or ` + "`" + `moderator` + "`" + `), unchanged if omitted",
+                    "type": "object",
+                    "additionalProperties": {
+                        "$ref": "#/definitions/entity.SiteRole"
+                    }
+                },
+                "trust_level": {
+                    "description": "The trust level (the comments of the trusted user skip the default pending), unchanged if omitted",
+                    "enum": [
+                        "auto",
+                        "trusted",
+                        "untrusted"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.UserTrustLevel"
+                        }
+                    ]
                 }
             }
         },
@@ -4550,6 +7829,25 @@ const docTemplate = `{
                 }
             }
         },
+        "handler.ReportQueueItem": {
+            "type": "object",
+            "required": [
+                "comment",
+                "reports"
+            ],
+            "properties": {
+                "comment": {
+                    "$ref": "#/definitions/entity.CookedComment"
+                },
+                "reports": {
+                    "description": "The reports of the comment (latest first)",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entity.CookedCommentReport"
+                    }
+                }
+            }
+        },
         "handler.RequestAuthDataMergeApply": {
             "type": "object",
             "required": [
@@ -4627,6 +7925,19 @@ const docTemplate = `{
                 "email": {
                     "type": "string"
                 },
+                "email_digest": {
+                    "description": "The frequency of the notification emails (the config default is used if empty), unchanged if omitted",
+                    "enum": [
+                        "immediate",
+                        "daily",
+                        "weekly"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.EmailDigestFrequency"
+                        }
+                    ]
+                },
                 "link": {
                     "type": "string"
                 },
@@ -4675,72 +7986,367 @@ const docTemplate = `{
                 "update_votes_count": {
                     "type": "integer"
                 },
-                "user_token": {
-                    "description": "Empty if login user is target user no need to re-login",
-                    "type": "string"
-                }
-            }
-        },
-        "handler.ResponseAuthDataMergeCheck": {
-            "type": "object",
-            "required": [
-                "need_merge",
-                "user_names"
-            ],
-            "properties": {
-                "need_merge": {
+                "user_token": {
+                    "description": "Empty if login user is target user no need to re-login",
+                    "type": "string"
+                }
+            }
+        },
+        "handler.ResponseAuthDataMergeCheck": {
+            "type": "object",
+            "required": [
+                "need_merge",
+                "user_names"
+            ],
+            "properties": {
+                "need_merge": {
+                    "type": "boolean"
+                },
+                "user_names": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "handler.ResponseBlocklistCreate": {
+            "type": "object",
+            "required": [
+                "date",
+                "expires_at",
+                "id",
+                "is_expired",
+                "reason",
+                "type",
+                "value"
+            ],
+            "properties": {
+                "date": {
+                    "type": "string"
+                },
+                "expires_at": {
+                    "description": "Empty if never expires",
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "is_expired": {
+                    "type": "boolean"
+                },
+                "reason": {
+                    "type": "string"
+                },
+                "type": {
+                    "$ref": "#/definitions/entity.BlocklistType"
+                },
+                "value": {
+                    "type": "string"
+                }
+            }
+        },
+        "handler.ResponseBlocklistList": {
+            "type": "object",
+            "required": [
+                "count",
+                "items"
+            ],
+            "properties": {
+                "count": {
+                    "type": "integer"
+                },
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entity.CookedBlocklistItem"
+                    }
+                }
+            }
+        },
+        "handler.ResponseBlocklistUpdate": {
+            "type": "object",
+            "required": [
+                "date",
+                "expires_at",
+                "id",
+                "is_expired",
+                "reason",
+                "type",
+                "value"
+            ],
+            "properties": {
+                "date": {
+                    "type": "string"
+                },
+                "expires_at": {
+                    "description": "Empty if never expires",
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "is_expired": {
+                    "type": "boolean"
+                },
+                "reason": {
+                    "type": "string"
+                },
+                "type": {
+                    "$ref": "#/definitions/entity.BlocklistType"
+                },
+                "value": {
+                    "type": "string"
+                }
+            }
+        },
+        "handler.ResponseCaptchaGet": {
+            "type": "object",
+            "required": [
+                "img_data"
+            ],
+            "properties": {
+                "img_data": {
+                    "type": "string"
+                }
+            }
+        },
+        "handler.ResponseCaptchaStatus": {
+            "type": "object",
+            "required": [
+                "is_pass"
+            ],
+            "properties": {
+                "is_pass": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "handler.ResponseCommentBan": {
+            "type": "object",
+            "required": [
+                "items"
+            ],
+            "properties": {
+                "items": {
+                    "description": "The blocklist items of the author",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entity.CookedBlocklistItem"
+                    }
+                }
+            }
+        },
+        "handler.ResponseCommentBatch": {
+            "type": "object",
+            "required": [
+                "failed",
+                "results",
+                "succeeded",
+                "total",
+                "truncated"
+            ],
+            "properties": {
+                "failed": {
+                    "type": "integer"
+                },
+                "results": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handler.CommentBatchResult"
+                    }
+                },
+                "succeeded": {
+                    "type": "integer"
+                },
+                "total": {
+                    "description": "The number of the comments matched by the filter (may exceed the batch limit)",
+                    "type": "integer"
+                },
+                "truncated": {
+                    "description": "Whether the filter matches more comments than the batch limit (only the earliest ones are moderated)",
+                    "type": "boolean"
+                }
+            }
+        },
+        "handler.ResponseCommentCreate": {
+            "type": "object",
+            "required": [
+                "anti_spam_verdict",
+                "badge_color",
+                "badge_name",
+                "content",
+                "content_marked",
+                "date",
+                "deleted_at",
+                "edited_at",
+                "email_encrypted",
+                "id",
+                "ip_region",
+                "is_allow_reply",
+                "is_collapsed",
+                "is_edited",
+                "is_pending",
+                "is_pinned",
+                "is_shadow_banned",
+                "is_tombstone",
+                "is_verified",
+                "link",
+                "my_reactions",
+                "nick",
+                "page_key",
+                "page_url",
+                "reactions",
+                "rid",
+                "site_name",
+                "ua",
+                "user_id",
+                "visible",
+                "vote_down",
+                "vote_up"
+            ],
+            "properties": {
+                "anti_spam_verdict": {
+                    "description": "The anti-spam verdict (only for admins)",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.CookedAntiSpamVerdict"
+                        }
+                    ]
+                },
+                "badge_color": {
+                    "type": "string"
+                },
+                "badge_name": {
+                    "type": "string"
+                },
+                "content": {
+                    "type": "string"
+                },
+                "content_marked": {
+                    "type": "string"
+                },
+                "date": {
+                    "type": "string"
+                },
+                "deleted_at": {
+                    "description": "The time moved into the trash",
+                    "type": "string"
+                },
+                "edited_at": {
+                    "type": "string"
+                },
+                "email_encrypted": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "ip_region": {
+                    "type": "string"
+                },
+                "is_allow_reply": {
+                    "type": "boolean"
+                },
+                "is_collapsed": {
+                    "type": "boolean"
+                },
+                "is_edited": {
+                    "type": "boolean"
+                },
+                "is_pending": {
+                    "type": "boolean"
+                },
+                "is_pinned": {
+                    "type": "boolean"
+                },
+                "is_shadow_banned": {
+                    "description": "The author is shadow-banned (only for admins)",
+                    "type": "boolean"
+                },
+                "is_tombstone": {
+                    "type": "boolean"
+                },
+                "is_verified": {
                     "type": "boolean"
                 },
-                "user_names": {
+                "link": {
+                    "type": "string"
+                },
+                "my_reactions": {
+                    "description": "The reactions made by the current visitor",
                     "type": "array",
                     "items": {
                         "type": "string"
                     }
-                }
-            }
-        },
-        "handler.ResponseCaptchaGet": {
-            "type": "object",
-            "required": [
-                "img_data"
-            ],
-            "properties": {
-                "img_data": {
+                },
+                "nick": {
                     "type": "string"
-                }
-            }
-        },
-        "handler.ResponseCaptchaStatus": {
-            "type": "object",
-            "required": [
-                "is_pass"
-            ],
-            "properties": {
-                "is_pass": {
+                },
+                "page_key": {
+                    "type": "string"
+                },
+                "page_url": {
+                    "type": "string"
+                },
+                "reactions": {
+                    "description": "The count of each emoji reaction",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "integer"
+                    }
+                },
+                "rid": {
+                    "type": "integer"
+                },
+                "site_name": {
+                    "type": "string"
+                },
+                "ua": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "integer"
+                },
+                "visible": {
                     "type": "boolean"
+                },
+                "vote_down": {
+                    "type": "integer"
+                },
+                "vote_up": {
+                    "type": "integer"
                 }
             }
         },
-        "handler.ResponseCommentCreate": {
+        "handler.ResponseCommentEdit": {
             "type": "object",
             "required": [
+                "anti_spam_verdict",
                 "badge_color",
                 "badge_name",
                 "content",
                 "content_marked",
                 "date",
+                "deleted_at",
+                "edited_at",
                 "email_encrypted",
                 "id",
                 "ip_region",
                 "is_allow_reply",
                 "is_collapsed",
+                "is_edited",
                 "is_pending",
                 "is_pinned",
+                "is_shadow_banned",
+                "is_tombstone",
                 "is_verified",
                 "link",
+                "my_reactions",
                 "nick",
                 "page_key",
                 "page_url",
+                "reactions",
                 "rid",
                 "site_name",
                 "ua",
@@ -4750,6 +8356,14 @@ const docTemplate = `{
                 "vote_up"
             ],
             "properties": {
+                "anti_spam_verdict": {
+                    "description": "The anti-spam verdict (only for admins)",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.CookedAntiSpamVerdict"
+                        }
+                    ]
+                },
                 "badge_color": {
                     "type": "string"
                 },
@@ -4765,6 +8379,13 @@ const docTemplate = `{
                 "date": {
                     "type": "string"
                 },
+                "deleted_at": {
+                    "description": "The time moved into the trash",
+                    "type": "string"
+                },
+                "edited_at": {
+                    "type": "string"
+                },
                 "email_encrypted": {
                     "type": "string"
                 },
@@ -4780,18 +8401,35 @@ const docTemplate = `{
                 "is_collapsed": {
                     "type": "boolean"
                 },
+                "is_edited": {
+                    "type": "boolean"
+                },
                 "is_pending": {
                     "type": "boolean"
                 },
                 "is_pinned": {
                     "type": "boolean"
                 },
+                "is_shadow_banned": {
+                    "description": "The author is shadow-banned (only for admins)",
+                    "type": "boolean"
+                },
+                "is_tombstone": {
+                    "type": "boolean"
+                },
                 "is_verified": {
                     "type": "boolean"
                 },
                 "link": {
                     "type": "string"
                 },
+                "my_reactions": {
+                    "description": "The reactions made by the current visitor",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
                 "nick": {
                     "type": "string"
                 },
@@ -4801,6 +8439,13 @@ const docTemplate = `{
                 "page_url": {
                     "type": "string"
                 },
+                "reactions": {
+                    "description": "The count of each emoji reaction",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "integer"
+                    }
+                },
                 "rid": {
                     "type": "integer"
                 },
@@ -4855,6 +8500,7 @@ const docTemplate = `{
                 "comments",
                 "count",
                 "page",
+                "reaction_types",
                 "roots_count"
             ],
             "properties": {
@@ -4870,31 +8516,210 @@ const docTemplate = `{
                 "page": {
                     "$ref": "#/definitions/entity.CookedPage"
                 },
+                "reaction_types": {
+                    "description": "The available emoji reactions of the site",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
                 "roots_count": {
                     "type": "integer"
                 }
             }
         },
+        "handler.ResponseCommentRevisionList": {
+            "type": "object",
+            "required": [
+                "revisions"
+            ],
+            "properties": {
+                "revisions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entity.CookedCommentRevision"
+                    }
+                }
+            }
+        },
+        "handler.ResponseCommentRevisionRestore": {
+            "type": "object",
+            "required": [
+                "anti_spam_verdict",
+                "badge_color",
+                "badge_name",
+                "content",
+                "content_marked",
+                "date",
+                "deleted_at",
+                "edited_at",
+                "email_encrypted",
+                "id",
+                "ip_region",
+                "is_allow_reply",
+                "is_collapsed",
+                "is_edited",
+                "is_pending",
+                "is_pinned",
+                "is_shadow_banned",
+                "is_tombstone",
+                "is_verified",
+                "link",
+                "my_reactions",
+                "nick",
+                "page_key",
+                "page_url",
+                "reactions",
+                "rid",
+                "site_name",
+                "ua",
+                "user_id",
+                "visible",
+                "vote_down",
+                "vote_up"
+            ],
+            "properties": {
+                "anti_spam_verdict": {
+                    "description": "The anti-spam verdict (only for admins)",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.CookedAntiSpamVerdict"
+                        }
+                    ]
+                },
+                "badge_color": {
+                    "type": "string"
+                },
+                "badge_name": {
+                    "type": "string"
+                },
+                "content": {
+                    "type": "string"
+                },
+                "content_marked": {
+                    "type": "string"
+                },
+                "date": {
+                    "type": "string"
+                },
+                "deleted_at": {
+                    "description": "The time moved into the trash",
+                    "type": "string"
+                },
+                "edited_at": {
+                    "type": "string"
+                },
+                "email_encrypted": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "ip_region": {
+                    "type": "string"
+                },
+                "is_allow_reply": {
+                    "type": "boolean"
+                },
+                "is_collapsed": {
+                    "type": "boolean"
+                },
+                "is_edited": {
+                    "type": "boolean"
+                },
+                "is_pending": {
+                    "type": "boolean"
+                },
+                "is_pinned": {
+                    "type": "boolean"
+                },
+                "is_shadow_banned": {
+                    "description": "The author is shadow-banned (only for admins)",
+                    "type": "boolean"
+                },
+                "is_tombstone": {
+                    "type": "boolean"
+                },
+                "is_verified": {
+                    "type": "boolean"
+                },
+                "link": {
+                    "type": "string"
+                },
+                "my_reactions": {
+                    "description": "The reactions made by the current visitor",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "nick": {
+                    "type": "string"
+                },
+                "page_key": {
+                    "type": "string"
+                },
+                "page_url": {
+                    "type": "string"
+                },
+                "reactions": {
+                    "description": "The count of each emoji reaction",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "integer"
+                    }
+                },
+                "rid": {
+                    "type": "integer"
+                },
+                "site_name": {
+                    "type": "string"
+                },
+                "ua": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "integer"
+                },
+                "visible": {
+                    "type": "boolean"
+                },
+                "vote_down": {
+                    "type": "integer"
+                },
+                "vote_up": {
+                    "type": "integer"
+                }
+            }
+        },
         "handler.ResponseCommentUpdate": {
             "type": "object",
             "required": [
+                "anti_spam_verdict",
                 "badge_color",
                 "badge_name",
                 "content",
                 "content_marked",
                 "date",
+                "deleted_at",
+                "edited_at",
                 "email_encrypted",
                 "id",
                 "ip_region",
                 "is_allow_reply",
                 "is_collapsed",
+                "is_edited",
                 "is_pending",
                 "is_pinned",
+                "is_shadow_banned",
+                "is_tombstone",
                 "is_verified",
                 "link",
+                "my_reactions",
                 "nick",
                 "page_key",
                 "page_url",
+                "reactions",
                 "rid",
                 "site_name",
                 "ua",
@@ -4904,6 +8729,14 @@ const docTemplate = `{
                 "vote_up"
             ],
             "properties": {
+                "anti_spam_verdict": {
+                    "description": "The anti-spam verdict (only for admins)",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.CookedAntiSpamVerdict"
+                        }
+                    ]
+                },
                 "badge_color": {
                     "type": "string"
                 },
@@ -4919,6 +8752,13 @@ const docTemplate = `{
                 "date": {
                     "type": "string"
                 },
+                "deleted_at": {
+                    "description": "The time moved into the trash",
+                    "type": "string"
+                },
+                "edited_at": {
+                    "type": "string"
+                },
                 "email_encrypted": {
                     "type": "string"
                 },
@@ -4934,18 +8774,35 @@ const docTemplate = `{
                 "is_collapsed": {
                     "type": "boolean"
                 },
+                "is_edited": {
+                    "type": "boolean"
+                },
                 "is_pending": {
                     "type": "boolean"
                 },
                 "is_pinned": {
                     "type": "boolean"
                 },
+                "is_shadow_banned": {
+                    "description": "The author is shadow-banned (only for admins)",
+                    "type": "boolean"
+                },
+                "is_tombstone": {
+                    "type": "boolean"
+                },
                 "is_verified": {
                     "type": "boolean"
                 },
                 "link": {
                     "type": "string"
                 },
+                "my_reactions": {
+                    "description": "The reactions made by the current visitor",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
                 "nick": {
                     "type": "string"
                 },
@@ -4955,6 +8812,13 @@ const docTemplate = `{
                 "page_url": {
                     "type": "string"
                 },
+                "reactions": {
+                    "description": "The count of each emoji reaction",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "integer"
+                    }
+                },
                 "rid": {
                     "type": "integer"
                 },
@@ -4978,6 +8842,18 @@ const docTemplate = `{
                 }
             }
         },
+        "handler.ResponseCommentWithdraw": {
+            "type": "object",
+            "required": [
+                "is_tombstone"
+            ],
+            "properties": {
+                "is_tombstone": {
+                    "description": "The comment has replies, so it is replaced by a tombstone instead of being deleted",
+                    "type": "boolean"
+                }
+            }
+        },
         "handler.ResponseConfAuthProviders": {
             "type": "object",
             "required": [
@@ -5013,6 +8889,97 @@ const docTemplate = `{
                 }
             }
         },
+        "handler.ResponseEmailTaskList": {
+            "type": "object",
+            "required": [
+                "count",
+                "items"
+            ],
+            "properties": {
+                "count": {
+                    "type": "integer"
+                },
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entity.CookedEmailTask"
+                    }
+                }
+            }
+        },
+        "handler.ResponseEmailTaskResend": {
+            "type": "object",
+            "required": [
+                "attempts",
+                "body",
+                "date",
+                "error",
+                "from_addr",
+                "from_name",
+                "id",
+                "next_attempt_at",
+                "notify_id",
+                "sent_at",
+                "status",
+                "subject",
+                "to_addr",
+                "updated_at"
+            ],
+            "properties": {
+                "attempts": {
+                    "type": "integer"
+                },
+                "body": {
+                    "type": "string"
+                },
+                "date": {
+                    "type": "string"
+                },
+                "error": {
+                    "type": "string"
+                },
+                "from_addr": {
+                    "type": "string"
+                },
+                "from_name": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "next_attempt_at": {
+                    "type": "string"
+                },
+                "notify_id": {
+                    "type": "integer"
+                },
+                "sent_at": {
+                    "type": "string"
+                },
+                "status": {
+                    "enum": [
+                        "pending",
+                        "sending",
+                        "sent",
+                        "failed"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.EmailTaskStatus"
+                        }
+                    ]
+                },
+                "subject": {
+                    "type": "string"
+                },
+                "to_addr": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
         "handler.ResponseNotifyList": {
             "type": "object",
             "required": [
@@ -5035,10 +9002,16 @@ const docTemplate = `{
             "type": "object",
             "required": [
                 "admin_only",
+                "close_after_days",
+                "close_at",
                 "date",
+                "deleted_at",
                 "id",
+                "is_closed",
+                "is_locked",
                 "key",
                 "pv",
+                "settings",
                 "site_name",
                 "title",
                 "url",
@@ -5049,18 +9022,46 @@ const docTemplate = `{
                 "admin_only": {
                     "type": "boolean"
                 },
+                "close_after_days": {
+                    "description": "Comments are closed automatically after N days since the page created (0 means never)",
+                    "type": "integer"
+                },
+                "close_at": {
+                    "description": "The time when the comments are closed automatically",
+                    "type": "string"
+                },
                 "date": {
                     "type": "string"
                 },
+                "deleted_at": {
+                    "description": "The time moved into the trash",
+                    "type": "string"
+                },
                 "id": {
                     "type": "integer"
                 },
+                "is_closed": {
+                    "description": "Whether the comments are closed now (no more comments or replies)",
+                    "type": "boolean"
+                },
+                "is_locked": {
+                    "description": "Comments are closed manually",
+                    "type": "boolean"
+                },
                 "key": {
                     "type": "string"
                 },
                 "pv": {
                     "type": "integer"
                 },
+                "settings": {
+                    "description": "The page-level settings to override the global config",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.PageSettings"
+                        }
+                    ]
+                },
                 "site_name": {
                     "type": "string"
                 },
@@ -5138,10 +9139,16 @@ const docTemplate = `{
             "type": "object",
             "required": [
                 "admin_only",
+                "close_after_days",
+                "close_at",
                 "date",
+                "deleted_at",
                 "id",
+                "is_closed",
+                "is_locked",
                 "key",
                 "pv",
+                "settings",
                 "site_name",
                 "title",
                 "url",
@@ -5152,18 +9159,46 @@ const docTemplate = `{
                 "admin_only": {
                     "type": "boolean"
                 },
+                "close_after_days": {
+                    "description": "Comments are closed automatically after N days since the page created (0 means never)",
+                    "type": "integer"
+                },
+                "close_at": {
+                    "description": "The time when the comments are closed automatically",
+                    "type": "string"
+                },
                 "date": {
                     "type": "string"
                 },
+                "deleted_at": {
+                    "description": "The time moved into the trash",
+                    "type": "string"
+                },
                 "id": {
                     "type": "integer"
                 },
+                "is_closed": {
+                    "description": "Whether the comments are closed now (no more comments or replies)",
+                    "type": "boolean"
+                },
+                "is_locked": {
+                    "description": "Comments are closed manually",
+                    "type": "boolean"
+                },
                 "key": {
                     "type": "string"
                 },
                 "pv": {
                     "type": "integer"
                 },
+                "settings": {
+                    "description": "The page-level settings to override the global config",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.PageSettings"
+                        }
+                    ]
+                },
                 "site_name": {
                     "type": "string"
                 },
@@ -5181,6 +9216,56 @@ const docTemplate = `{
                 }
             }
         },
+        "handler.ResponseReaction": {
+            "type": "object",
+            "required": [
+                "my_reactions",
+                "reactions",
+                "types"
+            ],
+            "properties": {
+                "my_reactions": {
+                    "description": "The reactions made by the current visitor",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "reactions": {
+                    "description": "The count of each emoji reaction",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "integer"
+                    }
+                },
+                "types": {
+                    "description": "The available emoji reactions",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "handler.ResponseReportList": {
+            "type": "object",
+            "required": [
+                "count",
+                "items"
+            ],
+            "properties": {
+                "count": {
+                    "description": "The number of the reported comments",
+                    "type": "integer"
+                },
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handler.ReportQueueItem"
+                    }
+                }
+            }
+        },
         "handler.ResponseSettingGet": {
             "type": "object",
             "required": [
@@ -5213,13 +9298,25 @@ const docTemplate = `{
         "handler.ResponseSiteCreate": {
             "type": "object",
             "required": [
+                "config_overlay",
+                "deleted_at",
                 "first_url",
                 "id",
                 "name",
+                "reaction_types",
                 "urls",
                 "urls_raw"
             ],
             "properties": {
+                "config_overlay": {
+                    "description": "The site config overlay merged over the global config",
+                    "type": "object",
+                    "additionalProperties": {}
+                },
+                "deleted_at": {
+                    "description": "The time moved into the trash",
+                    "type": "string"
+                },
                 "first_url": {
                     "type": "string"
                 },
@@ -5229,6 +9326,13 @@ const docTemplate = `{
                 "name": {
                     "type": "string"
                 },
+                "reaction_types": {
+                    "description": "The available emoji reactions of the site (empty means using the default of config)",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
                 "urls": {
                     "type": "array",
                     "items": {
@@ -5253,7 +9357,7 @@ const docTemplate = `{
                 "sites": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/entity.CookedSite"
+                        "$ref": "#/definitions/entity.CookedSiteForAdmin"
                     }
                 }
             }
@@ -5261,13 +9365,25 @@ const docTemplate = `{
         "handler.ResponseSiteUpdate": {
             "type": "object",
             "required": [
+                "config_overlay",
+                "deleted_at",
                 "first_url",
                 "id",
                 "name",
+                "reaction_types",
                 "urls",
                 "urls_raw"
             ],
             "properties": {
+                "config_overlay": {
+                    "description": "The site config overlay merged over the global config",
+                    "type": "object",
+                    "additionalProperties": {}
+                },
+                "deleted_at": {
+                    "description": "The time moved into the trash",
+                    "type": "string"
+                },
                 "first_url": {
                     "type": "string"
                 },
@@ -5277,6 +9393,13 @@ const docTemplate = `{
                 "name": {
                     "type": "string"
                 },
+                "reaction_types": {
+                    "description": "The available emoji reactions of the site (empty means using the default of config)",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
                 "urls": {
                     "type": "array",
                     "items": {
@@ -5312,6 +9435,45 @@ const docTemplate = `{
                 }
             }
         },
+        "handler.ResponseTrashList": {
+            "type": "object",
+            "required": [
+                "comments",
+                "count",
+                "pages",
+                "sites",
+                "users"
+            ],
+            "properties": {
+                "comments": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entity.CookedComment"
+                    }
+                },
+                "count": {
+                    "type": "integer"
+                },
+                "pages": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entity.CookedPage"
+                    }
+                },
+                "sites": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entity.CookedSite"
+                    }
+                },
+                "users": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entity.CookedUserForAdmin"
+                    }
+                }
+            }
+        },
         "handler.ResponseUpload": {
             "type": "object",
             "required": [
@@ -5337,15 +9499,20 @@ const docTemplate = `{
                 "badge_color",
                 "badge_name",
                 "comment_count",
+                "deleted_at",
                 "email",
+                "email_digest",
                 "id",
                 "is_admin",
                 "is_in_conf",
+                "is_shadow_banned",
                 "last_ip",
                 "last_ua",
                 "link",
                 "name",
-                "receive_email"
+                "receive_email",
+                "site_roles",
+                "trust_level"
             ],
             "properties": {
                 "badge_color": {
@@ -5357,9 +9524,17 @@ const docTemplate = `{
                 "comment_count": {
                     "type": "integer"
                 },
+                "deleted_at": {
+                    "description": "The time moved into the trash",
+                    "type": "string"
+                },
                 "email": {
                     "type": "string"
                 },
+                "email_digest": {
+                    "description": "The frequency of the notification emails (empty if the config default is used)",
+                    "type": "string"
+                },
                 "id": {
                     "type": "integer"
                 },
@@ -5369,6 +9544,9 @@ const docTemplate = `{
                 "is_in_conf": {
                     "type": "boolean"
                 },
+                "is_shadow_banned": {
+                    "type": "boolean"
+                },
                 "last_ip": {
                     "type": "string"
                 },
@@ -5383,6 +9561,21 @@ const docTemplate = `{
                 },
                 "receive_email": {
                     "type": "boolean"
+                },
+                "site_roles": {
+                    "description": "The roles on the sites (site name =\u003e role)",
+                    "type": "object",
+                    "additionalProperties": {
+                        "$ref": "#/definitions/entity.SiteRole"
+                    }
+                },
+                "trust_level": {
+                    "type": "string",
+                    "enum": [
+                        "auto",
+                        "trusted",
+                        "untrusted"
+                    ]
                 }
             }
         },
@@ -5459,15 +9652,20 @@ const docTemplate = `{
                 "badge_color",
                 "badge_name",
                 "comment_count",
+                "deleted_at",
                 "email",
+                "email_digest",
                 "id",
                 "is_admin",
                 "is_in_conf",
+                "is_shadow_banned",
                 "last_ip",
                 "last_ua",
                 "link",
                 "name",
-                "receive_email"
+                "receive_email",
+                "site_roles",
+                "trust_level"
             ],
             "properties": {
                 "badge_color": {
@@ -5479,9 +9677,17 @@ const docTemplate = `{
                 "comment_count": {
                     "type": "integer"
                 },
+                "deleted_at": {
+                    "description": "The time moved into the trash",
+                    "type": "string"
+                },
                 "email": {
                     "type": "string"
                 },
+                "email_digest": {
+                    "description": "The frequency of the notification emails (empty if the config default is used)",
+                    "type": "string"
+                },
                 "id": {
                     "type": "integer"
                 },
@@ -5491,6 +9697,9 @@ const docTemplate = `{
                 "is_in_conf": {
                     "type": "boolean"
                 },
+                "is_shadow_banned": {
+                    "type": "boolean"
+                },
                 "last_ip": {
                     "type": "string"
                 },
@@ -5505,6 +9714,21 @@ const docTemplate = `{
                 },
                 "receive_email": {
                     "type": "boolean"
+                },
+                "site_roles": {
+                    "description": "The roles on the sites (site name =\u003e role)",
+                    "type": "object",
+                    "additionalProperties": {
+                        "$ref": "#/definitions/entity.SiteRole"
+                    }
+                },
+                "trust_level": {
+                    "type": "string",
+                    "enum": [
+                        "auto",
+                        "trusted",
+                        "untrusted"
+                    ]
                 }
             }
         },
@@ -5530,6 +9754,88 @@ const docTemplate = `{
                     "type": "integer"
                 }
             }
+        },
+        "handler.ResponseWebhookDeliveryList": {
+            "type": "object",
+            "required": [
+                "count",
+                "items"
+            ],
+            "properties": {
+                "count": {
+                    "type": "integer"
+                },
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entity.CookedWebhookDelivery"
+                    }
+                }
+            }
+        },
+        "handler.ResponseWebhookRedeliver": {
+            "type": "object",
+            "required": [
+                "attempts",
+                "date",
+                "error",
+                "event",
+                "id",
+                "payload",
+                "response",
+                "site_name",
+                "status",
+                "status_code",
+                "updated_at",
+                "url"
+            ],
+            "properties": {
+                "attempts": {
+                    "type": "integer"
+                },
+                "date": {
+                    "type": "string"
+                },
+                "error": {
+                    "type": "string"
+                },
+                "event": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "payload": {
+                    "type": "string"
+                },
+                "response": {
+                    "type": "string"
+                },
+                "site_name": {
+                    "type": "string"
+                },
+                "status": {
+                    "enum": [
+                        "pending",
+                        "success",
+                        "failed"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.WebhookDeliveryStatus"
+                        }
+                    ]
+                },
+                "status_code": {
+                    "type": "integer"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "url": {
+                    "type": "string"
+                }
+            }
         }
     },
     "securityDefinitions": {
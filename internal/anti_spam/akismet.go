@@ -9,17 +9,23 @@ import (
 	"strings"
 
 	"github.com/artalkjs/artalk/v2/internal/log"
+	"github.com/samber/lo"
 )
 
-var _ Checker = (*AkismetChecker)(nil)
+var (
+	_ DetailChecker   = (*AkismetChecker)(nil)
+	_ FeedbackChecker = (*AkismetChecker)(nil)
+)
 
 type AkismetChecker struct {
-	key string
+	key     string
+	baseURL string
 }
 
 func NewAkismetChecker(key string) Checker {
 	return &AkismetChecker{
-		key: key,
+		key:     key,
+		baseURL: fmt.Sprintf("https://%s.rest.akismet.com/1.1", key),
 	}
 }
 
@@ -28,7 +34,65 @@ func (*AkismetChecker) Name() string {
 }
 
 func (c *AkismetChecker) Check(p *CheckerParams) (bool, error) {
+	result, err := c.CheckDetail(p)
+	if err != nil {
+		return false, err
+	}
+	return result.Pass, nil
+}
+
+func (c *AkismetChecker) CheckDetail(p *CheckerParams) (*CheckResult, error) {
 	// @link https://akismet.com/development/api/#comment-check
+	resp, respStr, err := c.request("comment-check", p)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Debug("akismet Spam Detection Response ", respStr)
+
+	// The summary of the response (the body and the debug headers)
+	summary := []string{"body=" + respStr}
+	for _, h := range []string{"X-akismet-pro-tip", "X-akismet-debug-help", "X-akismet-guid"} {
+		if v := resp.Header.Get(h); v != "" {
+			summary = append(summary, fmt.Sprintf("%s=%s", strings.ToLower(h), v))
+		}
+	}
+	result := &CheckResult{Response: strings.Join(summary, " ")}
+
+	switch respStr {
+	case "true":
+		// is a spam comment
+		result.Pass = false
+		result.Reason = lo.If(resp.Header.Get("X-akismet-pro-tip") == "discard", "blatant spam").Else("spam")
+		return result, nil
+	case "false":
+		// not a spam comment
+		result.Pass = true
+		return result, nil
+	}
+
+	return nil, fmt.Errorf("%s", result.Response)
+}
+
+func (c *AkismetChecker) Feedback(p *CheckerParams, isSpam bool) error {
+	// @link https://akismet.com/developers/detailed-docs/submit-spam-missed-spam/
+	// @link https://akismet.com/developers/detailed-docs/submit-ham-false-positives/
+	method := lo.If(isSpam, "submit-spam").Else("submit-ham")
+	_, respStr, err := c.request(method, p)
+	if err != nil {
+		return err
+	}
+
+	log.Debug("akismet Feedback Response ", respStr)
+
+	if respStr != "Thanks for making the web a better place." {
+		return fmt.Errorf("akismet %s failed: %s", method, respStr)
+	}
+	return nil
+}
+
+// Send the request with the comment data to the Akismet API
+func (c *AkismetChecker) request(method string, p *CheckerParams) (*http.Response, string, error) {
 	form := url.Values{}
 
 	reqParams := newAkismetReqParams(p)
@@ -43,39 +107,25 @@ func (c *AkismetChecker) Check(p *CheckerParams) (bool, error) {
 	client := &http.Client{}
 
 	reqBody := strings.NewReader(form.Encode())
-	api := fmt.Sprintf("https://%s.rest.akismet.com/1.1/comment-check", c.key)
-	req, err := http.NewRequest("POST", api, reqBody)
+	req, err := http.NewRequest("POST", c.baseURL+"/"+method, reqBody)
 	if err != nil {
-		return false, err
+		return nil, "", err
 	}
 
 	req.Header.Add("Content-Type", "application/x-www-form-urlencoded")
 
 	resp, err := client.Do(req)
 	if err != nil {
-		return false, err
+		return nil, "", err
 	}
 
 	defer resp.Body.Close()
 	respBody, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return false, err
+		return nil, "", err
 	}
 
-	respStr := string(respBody)
-
-	log.Debug("akismet Spam Detection Response ", respStr)
-
-	switch respStr {
-	case "true":
-		// is a spam comment
-		return false, nil
-	case "false":
-		// not a spam comment
-		return true, nil
-	}
-
-	return false, fmt.Errorf(respStr)
+	return resp, string(respBody), nil
 }
 
 type AkismetReqParams struct {
@@ -106,5 +156,7 @@ func newAkismetReqParams(params *CheckerParams) *AkismetReqParams {
 		CommentAuthor:      params.UserName,
 		CommentAuthorEmail: params.UserEmail,
 		CommentContent:     params.Content,
+
+		Referrer: params.Referrer,
 	}
 }
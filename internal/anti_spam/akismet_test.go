@@ -0,0 +1,89 @@
+package anti_spam
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAkismetChecker(t *testing.T) {
+	var (
+		reqPath string
+		reqForm url.Values
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseForm())
+		reqPath, reqForm = r.URL.Path, r.PostForm
+
+		switch r.URL.Path {
+		case "/comment-check":
+			if r.PostForm.Get("comment_content") == "spam" {
+				w.Header().Set("X-akismet-pro-tip", "discard")
+				w.Write([]byte("true"))
+			} else {
+				w.Write([]byte("false"))
+			}
+		case "/submit-spam", "/submit-ham":
+			w.Write([]byte("Thanks for making the web a better place."))
+		default:
+			w.Header().Set("X-akismet-debug-help", "Invalid method")
+			w.Write([]byte("invalid"))
+		}
+	}))
+	defer server.Close()
+
+	checker := NewAkismetChecker("test").(*AkismetChecker)
+	assert.Equal(t, "https://test.rest.akismet.com/1.1", checker.baseURL)
+	checker.baseURL = server.URL
+
+	params := &CheckerParams{
+		BlogURL:   "https://example.org",
+		Content:   "Hello",
+		UserName:  "user",
+		UserIP:    "127.0.0.1",
+		UserAgent: "test-agent",
+		Referrer:  "https://example.org/page",
+	}
+
+	t.Run("Check", func(t *testing.T) {
+		result, err := checker.CheckDetail(params)
+		require.NoError(t, err)
+		assert.True(t, result.Pass)
+		assert.Equal(t, "body=false", result.Response)
+		assert.Equal(t, "/comment-check", reqPath)
+		assert.Equal(t, "127.0.0.1", reqForm.Get("user_ip"))
+		assert.Equal(t, "test-agent", reqForm.Get("user_agent"))
+		assert.Equal(t, "https://example.org/page", reqForm.Get("referrer"))
+
+		spam := *params
+		spam.Content = "spam"
+		result, err = checker.CheckDetail(&spam)
+		require.NoError(t, err)
+		assert.False(t, result.Pass)
+		assert.Equal(t, "blatant spam", result.Reason)
+		assert.Equal(t, "body=true x-akismet-pro-tip=discard", result.Response)
+	})
+
+	t.Run("Feedback", func(t *testing.T) {
+		require.NoError(t, checker.Feedback(params, true))
+		assert.Equal(t, "/submit-spam", reqPath)
+		assert.Equal(t, "127.0.0.1", reqForm.Get("user_ip"))
+		assert.Equal(t, "https://example.org/page", reqForm.Get("referrer"))
+
+		require.NoError(t, checker.Feedback(params, false))
+		assert.Equal(t, "/submit-ham", reqPath)
+	})
+
+	t.Run("Invalid response", func(t *testing.T) {
+		checker := NewAkismetChecker("test").(*AkismetChecker)
+		checker.baseURL = server.URL + "/invalid"
+
+		_, err := checker.CheckDetail(params)
+		assert.ErrorContains(t, err, "Invalid method")
+		assert.Error(t, checker.Feedback(params, true))
+	})
+}
@@ -2,8 +2,10 @@ package anti_spam
 
 import (
 	"fmt"
+	"slices"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/artalkjs/artalk/v2/internal/config"
 	"github.com/artalkjs/artalk/v2/internal/log"
@@ -21,57 +23,154 @@ type AntiSpamConf struct {
 
 	OnBlockComment  func(commentID uint)
 	OnUpdateComment func(commentID uint, content string)
+	OnRejectComment func(commentID uint)
+
+	// Count the other comments with the same content sent by the same user or IP since the time
+	CountRepeatedComments func(p *CheckerParams, since time.Time) int64
+
+	// The model of the naive Bayes classifier
+	BayesModel BayesModel
+
+	// Receive the verdict of the check (the result of the blocking checker, or the merged results if passed)
+	OnVerdict func(p *CheckerParams, result *CheckResult)
 }
 
 type AntiSpam struct {
 	conf *AntiSpamConf
+
+	// The rules are compiled once for the config (nil if disabled)
+	rulesChecker *RulesChecker
 }
 
 // Create new AntiSpam instance
 func NewAntiSpam(conf *AntiSpamConf) *AntiSpam {
-	return &AntiSpam{
+	as := &AntiSpam{
 		conf: conf,
 	}
+
+	if conf.Rules.Enabled {
+		as.rulesChecker = NewRulesChecker(&RulesCheckerConf{
+			RulesAntispamConf:     conf.Rules,
+			OnUpdateComment:       conf.OnUpdateComment,
+			OnRejectComment:       conf.OnRejectComment,
+			CountRepeatedComments: conf.CountRepeatedComments,
+		})
+	}
+
+	return as
 }
 
 // Check and block comment if it is spam,
 // the function is exposed and can be called by other modules
 func (as AntiSpam) CheckAndBlock(params *CheckerParams) {
 	checkers := as.getEnabledCheckers()
+	results := []*CheckResult{}
 
 	// Execute check one by one
 	// Multiple checkers can be enabled at the same time
 	// If one of the checkers returns false, the comment will be blocked
 	for _, checker := range checkers {
-		pass := as.checkerTrigger(checker, params)
+		result := as.checkerTrigger(checker, params)
 
-		if !pass {
+		if !result.Pass {
+			as.onVerdict(params, result)
 			return // if blocked, stop checking
 		}
+
+		results = append(results, result)
+	}
+
+	if len(results) > 0 {
+		as.onVerdict(params, mergePassedResults(results))
+	}
+}
+
+// Report the moderation decision to the checkers whose result is wrong
+//
+// The verdict is the previous result of `CheckAndBlock`. Only the checkers which took part in
+// the verdict are reported: the blocking checker if the comment is approved (`isSpam` is false),
+// or the passed checkers if the comment is blocked (`isSpam` is true).
+func (as AntiSpam) Feedback(params *CheckerParams, verdict *CheckResult, isSpam bool) []string {
+	return as.feedback(as.getEnabledCheckers(), params, verdict, isSpam)
+}
+
+func (as AntiSpam) feedback(checkers []Checker, params *CheckerParams, verdict *CheckResult, isSpam bool) []string {
+	if verdict == nil || verdict.Pass != isSpam {
+		return nil // the verdict is right
+	}
+
+	reported := []string{}
+	for _, checker := range checkers {
+		fc, ok := checker.(FeedbackChecker)
+		if !ok || !slices.Contains(strings.Split(verdict.Checker, ","), checker.Name()) {
+			continue
+		}
+		if err := fc.Feedback(params, isSpam); err != nil {
+			log.Error(LOG_TAG, fmt.Sprintf("%s checker comment=%d feedback error:",
+				checker.Name(), params.CommentID), err)
+			continue
+		}
+		reported = append(reported, checker.Name())
+	}
+
+	return reported
+}
+
+func (as AntiSpam) onVerdict(params *CheckerParams, result *CheckResult) {
+	if as.conf.OnVerdict != nil {
+		as.conf.OnVerdict(params, result)
+	}
+}
+
+// Merge the results of the passed checkers into one verdict
+func mergePassedResults(results []*CheckResult) *CheckResult {
+	notEmpty := func(s string, _ int) bool { return s != "" }
+	return &CheckResult{
+		Checker:  strings.Join(lo.Map(results, func(r *CheckResult, _ int) string { return r.Checker }), ","),
+		Pass:     true,
+		Score:    lo.MaxBy(results, func(a, b *CheckResult) bool { return a.Score > b.Score }).Score,
+		Reason:   strings.Join(lo.Filter(lo.Map(results, func(r *CheckResult, _ int) string { return r.Reason }), notEmpty), "; "),
+		Matched:  strings.Join(lo.Filter(lo.Map(results, func(r *CheckResult, _ int) string { return r.Matched }), notEmpty), ","),
+		Response: strings.Join(lo.Filter(lo.Map(results, func(r *CheckResult, _ int) string { return r.Response }), notEmpty), "; "),
 	}
 }
 
 // Checker trigger function
-func (as AntiSpam) checkerTrigger(checker Checker, params *CheckerParams) bool {
-	pass, err := checker.Check(params)
+func (as AntiSpam) checkerTrigger(checker Checker, params *CheckerParams) *CheckResult {
+	var (
+		result *CheckResult
+		err    error
+	)
+	if dc, ok := checker.(DetailChecker); ok {
+		result, err = dc.CheckDetail(params)
+	} else {
+		var pass bool
+		pass, err = checker.Check(params)
+		result = &CheckResult{Pass: pass}
+	}
+	if result == nil {
+		result = &CheckResult{}
+	}
+	result.Checker = checker.Name()
 
 	if err != nil {
 		log.Error(LOG_TAG, fmt.Sprintf("%s checker comment=%d error:",
 			checker.Name(), params.CommentID), err)
 
-		pass = lo.If(as.conf.ApiFailBlock, false).Else(true) // block if api fail
+		result.Pass = lo.If(as.conf.ApiFailBlock, false).Else(true) // block if api fail
+		result.Reason = "error: " + err.Error()
 	}
 
-	if !pass {
+	if !result.Pass {
 		if as.conf.OnBlockComment != nil {
 			as.conf.OnBlockComment(params.CommentID)
 		}
 
-		log.Debug(LOG_TAG, fmt.Sprintf("[%s] Successful blocking of comments ID=%d CONT=%s",
-			checker.Name(), params.CommentID, strconv.Quote(params.Content)))
+		log.Debug(LOG_TAG, fmt.Sprintf("[%s] Successful blocking of comments ID=%d CONT=%s SCORE=%.4f REASON=%s",
+			checker.Name(), params.CommentID, strconv.Quote(params.Content), result.Score, strconv.Quote(result.Reason)))
 	}
 
-	return pass
+	return result
 }
 
 // Get enabled checkers by config
@@ -123,6 +222,34 @@ func (as AntiSpam) getEnabledCheckers() []Checker {
 
 	}
 
+	// Bayes Checker
+	if as.conf.Bayes.Enabled && as.conf.BayesModel != nil {
+		checkers = append(checkers, NewBayesChecker(&BayesCheckerConf{
+			Model:      as.conf.BayesModel,
+			Threshold:  as.conf.Bayes.Threshold,
+			MinTrained: as.conf.Bayes.MinTrained,
+		}))
+	}
+
+	// Rules Checker
+	if as.rulesChecker != nil {
+		checkers = append(checkers, as.rulesChecker)
+	}
+
+	// HTTP Checker
+	if as.conf.HTTP.Enabled {
+		checkers = append(checkers, NewHTTPChecker(&HTTPCheckerConf{
+			URL:     as.conf.HTTP.URL,
+			Secret:  as.conf.HTTP.Secret,
+			Timeout: time.Duration(as.conf.HTTP.Timeout) * time.Second,
+			OnUpdateComment: func(commentID uint, content string) {
+				if as.conf.OnUpdateComment != nil {
+					as.conf.OnUpdateComment(commentID, content)
+				}
+			},
+		}))
+	}
+
 	return checkers
 }
 
@@ -141,9 +268,37 @@ type CheckerParams struct {
 	UserID    uint
 	UserIP    string
 	UserAgent string
+	Referrer  string
+
+	UserLink             string
+	UserCreatedAt        time.Time
+	UserApprovedComments int64 // The number of the approved comments of the user (excluding the current one)
 }
 
 type Checker interface {
 	Name() string
 	Check(p *CheckerParams) (bool, error)
 }
+
+// The result of a checker with the details for diagnosing
+type CheckResult struct {
+	Checker  string  // The checker name
+	Pass     bool    // Whether the comment passes the check
+	Score    float64 // The spam score in the range of 0 ~ 1 (the higher, the more likely to be spam), 0 if not provided
+	Reason   string  // Why the comment is blocked or modified
+	Matched  string  // The matched keywords or rules
+	Response string  // The summary of the raw API response
+}
+
+// The checker which gives the details alongside the result
+type DetailChecker interface {
+	Checker
+	CheckDetail(p *CheckerParams) (*CheckResult, error)
+}
+
+// The checker which learns from the moderation decisions,
+// the wrong results are reported to the service
+type FeedbackChecker interface {
+	Checker
+	Feedback(p *CheckerParams, isSpam bool) error
+}
@@ -116,6 +116,102 @@ func TestAntiSpam(t *testing.T) {
 		})
 	})
 
+	t.Run("OnVerdict", func(t *testing.T) {
+		kwFile := fmt.Sprintf("%s/keywords.txt", t.TempDir())
+		_ = os.WriteFile(kwFile, []byte("关键词A\n关键词B"), 0644)
+
+		var verdict *CheckResult
+		antiSpam := NewAntiSpam(&AntiSpamConf{
+			ModeratorConf: config.ModeratorConf{
+				Keywords: config.KeyWordsAntispamConf{
+					Enabled: true,
+					Pending: true,
+					Files:   []string{kwFile},
+					FileSep: "\n",
+				},
+				Rules: config.RulesAntispamConf{
+					Enabled:   true,
+					MaxLength: config.RuleLimitConf{Max: 100},
+				},
+			},
+			OnBlockComment: func(commentID uint) {},
+			OnVerdict: func(p *CheckerParams, result *CheckResult) {
+				assert.Equal(t, uint(1000), p.CommentID)
+				verdict = result
+			},
+		})
+
+		// passed by all the checkers
+		antiSpam.CheckAndBlock(&CheckerParams{CommentID: 1000, Content: "Hello"})
+		if assert.NotNil(t, verdict) {
+			assert.True(t, verdict.Pass)
+			assert.Equal(t, "keywords,rules", verdict.Checker)
+		}
+
+		// blocked by the keywords checker
+		verdict = nil
+		antiSpam.CheckAndBlock(&CheckerParams{CommentID: 1000, Content: "---关键词A---"})
+		if assert.NotNil(t, verdict) {
+			assert.False(t, verdict.Pass)
+			assert.Equal(t, "keywords", verdict.Checker)
+			assert.Equal(t, "关键词A", verdict.Matched)
+			assert.Equal(t, "keywords matched", verdict.Reason)
+		}
+	})
+
+	t.Run("Feedback", func(t *testing.T) {
+		antiSpam := NewAntiSpam(&AntiSpamConf{})
+		checkers := []Checker{&mockFeedbackChecker{name: "a"}, &mockFeedbackChecker{name: "b"}, &mockChecker{}}
+		reported := func() []string {
+			labels := []string{}
+			for _, c := range checkers {
+				if fc, ok := c.(*mockFeedbackChecker); ok && fc.reported != nil {
+					labels = append(labels, fc.name+"="+lo.If(*fc.reported, "spam").Else("ham"))
+				}
+			}
+			return labels
+		}
+		reset := func() {
+			for _, c := range checkers {
+				if fc, ok := c.(*mockFeedbackChecker); ok {
+					fc.reported = nil
+				}
+			}
+		}
+
+		// the verdict is right
+		assert.Empty(t, antiSpam.feedback(checkers, &CheckerParams{}, &CheckResult{Checker: "a", Pass: false}, true))
+		assert.Empty(t, antiSpam.feedback(checkers, &CheckerParams{}, &CheckResult{Checker: "a,b", Pass: true}, false))
+		assert.Empty(t, reported())
+
+		// blocked by a, but approved
+		assert.Equal(t, []string{"a"}, antiSpam.feedback(checkers, &CheckerParams{}, &CheckResult{Checker: "a", Pass: false}, false))
+		assert.Equal(t, []string{"a=ham"}, reported())
+
+		// passed by a, b and test, but blocked
+		reset()
+		assert.Equal(t, []string{"a", "b"}, antiSpam.feedback(checkers, &CheckerParams{}, &CheckResult{Checker: "a,b,test", Pass: true}, true))
+		assert.Equal(t, []string{"a=spam", "b=spam"}, reported())
+
+		// blocked by the checker which does not support feedback
+		reset()
+		assert.Empty(t, antiSpam.feedback(checkers, &CheckerParams{}, &CheckResult{Checker: "test", Pass: false}, false))
+		assert.Empty(t, reported())
+	})
+
+	t.Run("Rules checker compiled once", func(t *testing.T) {
+		antiSpam := NewAntiSpam(&AntiSpamConf{
+			ModeratorConf: config.ModeratorConf{
+				Rules: config.RulesAntispamConf{Enabled: true, Patterns: config.RuleListConf{List: []string{`spam`}}},
+			},
+		})
+
+		checkers := antiSpam.getEnabledCheckers()
+		if assert.Len(t, checkers, 1) {
+			assert.Same(t, checkers[0], antiSpam.getEnabledCheckers()[0], "the rules checker should be reused")
+		}
+	})
+
 	t.Run("MockChecker Error Return", func(t *testing.T) {
 		t.Run("ApiFailBlock=true", func(t *testing.T) {
 			checker := &mockChecker{}
@@ -126,8 +222,10 @@ func TestAntiSpam(t *testing.T) {
 			})
 
 			mockCheckerErr = true // pretend api fail
-			pass := antiSpam.checkerTrigger(checker, &CheckerParams{})
-			assert.False(t, pass, "should be blocked when api fail")
+			result := antiSpam.checkerTrigger(checker, &CheckerParams{})
+			assert.False(t, result.Pass, "should be blocked when api fail")
+			assert.Equal(t, "test", result.Checker)
+			assert.Contains(t, result.Reason, "error")
 		})
 
 		t.Run("ApiFailBlock=false", func(t *testing.T) {
@@ -139,8 +237,8 @@ func TestAntiSpam(t *testing.T) {
 			})
 
 			mockCheckerErr = true // pretend api fail
-			pass := antiSpam.checkerTrigger(checker, &CheckerParams{})
-			assert.True(t, pass, "should not be blocked when api fail")
+			result := antiSpam.checkerTrigger(checker, &CheckerParams{})
+			assert.True(t, result.Pass, "should not be blocked when api fail")
 		})
 	})
 }
@@ -167,3 +265,23 @@ func (c *mockChecker) Check(params *CheckerParams) (bool, error) {
 
 	return true, nil
 }
+
+var _ FeedbackChecker = (*mockFeedbackChecker)(nil)
+
+type mockFeedbackChecker struct {
+	name     string
+	reported *bool // the reported label (true if spam), nil if not reported
+}
+
+func (c *mockFeedbackChecker) Name() string {
+	return c.name
+}
+
+func (c *mockFeedbackChecker) Check(params *CheckerParams) (bool, error) {
+	return true, nil
+}
+
+func (c *mockFeedbackChecker) Feedback(params *CheckerParams, isSpam bool) error {
+	c.reported = &isSpam
+	return nil
+}
@@ -0,0 +1,166 @@
+package anti_spam
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/artalkjs/artalk/v2/internal/log"
+)
+
+var _ DetailChecker = (*BayesChecker)(nil)
+
+const DefaultBayesThreshold = 0.9
+
+// The trained counts of a token
+// (the numbers of the spam and ham documents containing the token)
+type BayesCount struct {
+	Spam int64
+	Ham  int64
+}
+
+// The model of the naive Bayes classifier
+type BayesModel interface {
+	// Get the counts of the tokens, the unknown tokens can be omitted
+	GetCounts(tokens []string) map[string]BayesCount
+
+	// Get the total counts of the trained documents
+	GetTotal() BayesCount
+}
+
+type BayesCheckerConf struct {
+	Model      BayesModel
+	Threshold  float64 // The comment is considered as spam if the score is greater than or equal to the threshold
+	MinTrained int     // The minimum number of the trained spam and ham documents (each) to enable the classifier
+}
+
+// The naive Bayes spam classifier trained from the moderation decisions
+type BayesChecker struct {
+	conf *BayesCheckerConf
+}
+
+func NewBayesChecker(conf *BayesCheckerConf) *BayesChecker {
+	return &BayesChecker{
+		conf: conf,
+	}
+}
+
+func (*BayesChecker) Name() string {
+	return "bayes"
+}
+
+func (c *BayesChecker) Check(p *CheckerParams) (bool, error) {
+	result, err := c.CheckDetail(p)
+	if err != nil {
+		return false, err
+	}
+	return result.Pass, nil
+}
+
+func (c *BayesChecker) CheckDetail(p *CheckerParams) (*CheckResult, error) {
+	if c.conf.Model == nil {
+		return nil, fmt.Errorf("bayes model is not provided")
+	}
+
+	score, ok := BayesScore(c.conf.Model, p.Content, c.conf.MinTrained)
+	if !ok {
+		log.Debug(LOG_TAG, "bayes classifier is not trained enough, skip")
+		return &CheckResult{Pass: true, Reason: "not trained enough"}, nil
+	}
+
+	threshold := c.conf.Threshold
+	if threshold <= 0 || threshold > 1 {
+		threshold = DefaultBayesThreshold
+	}
+
+	result := &CheckResult{Pass: score < threshold, Score: score}
+	if !result.Pass {
+		result.Reason = fmt.Sprintf("spam probability %.4f >= threshold %.2f", score, threshold)
+	}
+	return result, nil
+}
+
+// Get the spam probability (0 ~ 1) of the content
+//
+// `ok` is false if the model is not trained enough (less than `minTrained` spam or ham documents).
+func BayesScore(model BayesModel, content string, minTrained int) (score float64, ok bool) {
+	total := model.GetTotal()
+	if total.Spam < int64(max(minTrained, 1)) || total.Ham < int64(max(minTrained, 1)) {
+		return 0, false
+	}
+
+	// The log probabilities of the classes with Laplace smoothing
+	// (the tokens never seen in training are ignored)
+	logSpam := math.Log(float64(total.Spam) / float64(total.Spam+total.Ham))
+	logHam := math.Log(float64(total.Ham) / float64(total.Spam+total.Ham))
+
+	tokens := BayesTokenize(content)
+	counts := model.GetCounts(tokens)
+	for _, token := range tokens {
+		cnt, ok := counts[token]
+		if !ok || cnt.Spam+cnt.Ham == 0 {
+			continue
+		}
+		logSpam += math.Log(float64(cnt.Spam+1) / float64(total.Spam+2))
+		logHam += math.Log(float64(cnt.Ham+1) / float64(total.Ham+2))
+	}
+
+	return 1 / (1 + math.Exp(logHam-logSpam)), true
+}
+
+// Split the content into unique tokens
+//
+// The words are lowercased, and the CJK text is split into character bigrams.
+func BayesTokenize(content string) []string {
+	var (
+		tokens = []string{}
+		seen   = map[string]bool{}
+	)
+	add := func(token string) {
+		if token == "" || seen[token] || len(token) > 64 {
+			return
+		}
+		seen[token] = true
+		tokens = append(tokens, token)
+	}
+
+	var (
+		word    strings.Builder
+		prevCJK rune
+	)
+	flushWord := func() {
+		if utf8.RuneCountInString(word.String()) >= 2 {
+			add(word.String())
+		}
+		word.Reset()
+	}
+
+	for _, r := range strings.ToLower(content) {
+		switch {
+		case isCJK(r):
+			flushWord()
+			if prevCJK != 0 {
+				add(string([]rune{prevCJK, r}))
+			} else {
+				add(string(r))
+			}
+			prevCJK = r
+			continue
+		case unicode.IsLetter(r) || unicode.IsDigit(r) || r == '\'' || r == '$':
+			word.WriteRune(r)
+		default:
+			flushWord()
+		}
+		prevCJK = 0
+	}
+	flushWord()
+
+	return tokens
+}
+
+func isCJK(r rune) bool {
+	return unicode.Is(unicode.Han, r) || unicode.Is(unicode.Hiragana, r) ||
+		unicode.Is(unicode.Katakana, r) || unicode.Is(unicode.Hangul, r)
+}
@@ -0,0 +1,102 @@
+package anti_spam
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// The in-memory model for testing
+type memBayesModel struct {
+	counts map[string]BayesCount
+	total  BayesCount
+}
+
+func (m *memBayesModel) train(content string, isSpam bool) {
+	if m.counts == nil {
+		m.counts = map[string]BayesCount{}
+	}
+	for _, token := range BayesTokenize(content) {
+		cnt := m.counts[token]
+		if isSpam {
+			cnt.Spam++
+		} else {
+			cnt.Ham++
+		}
+		m.counts[token] = cnt
+	}
+	if isSpam {
+		m.total.Spam++
+	} else {
+		m.total.Ham++
+	}
+}
+
+func (m *memBayesModel) GetCounts(tokens []string) map[string]BayesCount {
+	return m.counts
+}
+
+func (m *memBayesModel) GetTotal() BayesCount {
+	return m.total
+}
+
+func TestBayesTokenize(t *testing.T) {
+	assert.Equal(t, []string{"hello", "world", "it's", "$100"}, BayesTokenize("Hello, WORLD! hello a it's $100"))
+	assert.Equal(t, []string{"免", "免费", "费领", "领取", "ok", "优", "优惠"}, BayesTokenize("免费领取 ok 优惠"))
+	assert.Empty(t, BayesTokenize("  a . b "))
+}
+
+func TestBayesChecker(t *testing.T) {
+	model := &memBayesModel{}
+	for _, s := range []string{
+		"cheap pills buy now free money",
+		"free money click the link to win",
+		"buy cheap watches now",
+	} {
+		model.train(s, true)
+	}
+	for _, s := range []string{
+		"great article, thanks for sharing",
+		"I think the second example is wrong",
+		"thanks, the article helps me a lot",
+	} {
+		model.train(s, false)
+	}
+
+	assert.Equal(t, "bayes", NewBayesChecker(&BayesCheckerConf{}).Name())
+
+	t.Run("Not trained enough", func(t *testing.T) {
+		checker := NewBayesChecker(&BayesCheckerConf{Model: model, MinTrained: 10})
+		result, err := checker.CheckDetail(&CheckerParams{Content: "buy cheap pills now"})
+		require.NoError(t, err)
+		assert.True(t, result.Pass)
+		assert.Zero(t, result.Score)
+	})
+
+	checker := NewBayesChecker(&BayesCheckerConf{Model: model, MinTrained: 3, Threshold: 0.8})
+
+	t.Run("Spam", func(t *testing.T) {
+		result, err := checker.CheckDetail(&CheckerParams{Content: "Buy cheap pills now, free money!"})
+		require.NoError(t, err)
+		assert.False(t, result.Pass)
+		assert.Greater(t, result.Score, 0.8)
+		assert.Contains(t, result.Reason, "threshold 0.80")
+	})
+
+	t.Run("Ham", func(t *testing.T) {
+		result, err := checker.CheckDetail(&CheckerParams{Content: "Thanks for the great article"})
+		require.NoError(t, err)
+		assert.True(t, result.Pass)
+		assert.Less(t, result.Score, 0.5)
+
+		pass, err := checker.Check(&CheckerParams{Content: "Thanks for the great article"})
+		require.NoError(t, err)
+		assert.True(t, pass)
+	})
+
+	t.Run("No model", func(t *testing.T) {
+		_, err := NewBayesChecker(&BayesCheckerConf{}).Check(&CheckerParams{Content: "test"})
+		assert.Error(t, err)
+	})
+}
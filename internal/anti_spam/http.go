@@ -0,0 +1,168 @@
+package anti_spam
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/artalkjs/artalk/v2/internal/log"
+	"github.com/artalkjs/artalk/v2/internal/utils"
+)
+
+var _ DetailChecker = (*HTTPChecker)(nil)
+
+const DefaultHTTPCheckerTimeout = 5 * time.Second
+
+type HTTPCheckerConf struct {
+	URL     string
+	Secret  string        // The secret for signing the requests, no signature if empty
+	Timeout time.Duration // The request timeout, `DefaultHTTPCheckerTimeout` if not set
+
+	OnUpdateComment func(commentID uint, content string)
+}
+
+// The checker which requests a self-hosted moderation service
+//
+// The comment is POSTed to the URL as JSON (`HTTPCheckerRequest`),
+// and the service responds with `HTTPCheckerResponse`.
+//
+// If the secret is set, the request is signed with the headers:
+//
+//	X-Artalk-Timestamp: <unix timestamp>
+//	X-Artalk-Signature: sha256=<hex of HMAC-SHA256(secret, timestamp + "." + body)>
+type HTTPChecker struct {
+	conf   *HTTPCheckerConf
+	client *http.Client
+}
+
+type HTTPCheckerRequest struct {
+	CommentID uint   `json:"comment_id"`
+	Content   string `json:"content"`
+	BlogURL   string `json:"blog_url"`
+
+	UserID    uint   `json:"user_id"`
+	UserName  string `json:"user_name"`
+	UserEmail string `json:"user_email"`
+	UserLink  string `json:"user_link"`
+	UserIP    string `json:"user_ip"`
+	UserAgent string `json:"user_agent"`
+	Referrer  string `json:"referrer"`
+
+	UserCreatedAt        string `json:"user_created_at"` // RFC 3339, empty if unknown
+	UserApprovedComments int64  `json:"user_approved_comments"`
+}
+
+type HTTPCheckerResponse struct {
+	Pass           bool   `json:"pass"`
+	Reason         string `json:"reason"`
+	ReplaceContent string `json:"replace_content"` // The new content of the comment, empty if not replaced
+}
+
+func NewHTTPChecker(conf *HTTPCheckerConf) *HTTPChecker {
+	timeout := conf.Timeout
+	if timeout <= 0 {
+		timeout = DefaultHTTPCheckerTimeout
+	}
+
+	return &HTTPChecker{
+		conf:   conf,
+		client: &http.Client{Timeout: timeout},
+	}
+}
+
+func (*HTTPChecker) Name() string {
+	return "http"
+}
+
+func (c *HTTPChecker) Check(p *CheckerParams) (bool, error) {
+	result, err := c.CheckDetail(p)
+	if err != nil {
+		return false, err
+	}
+	return result.Pass, nil
+}
+
+func (c *HTTPChecker) CheckDetail(p *CheckerParams) (*CheckResult, error) {
+	if c.conf.URL == "" {
+		return nil, fmt.Errorf("http checker url is not provided")
+	}
+
+	reqBody, err := json.Marshal(newHTTPCheckerRequest(p))
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", c.conf.URL, bytes.NewReader(reqBody))
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "Artalk")
+	if c.conf.Secret != "" {
+		timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+		req.Header.Set("X-Artalk-Timestamp", timestamp)
+		req.Header.Set("X-Artalk-Signature", "sha256="+utils.GetHmacSha256(timestamp+"."+string(reqBody), c.conf.Secret))
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return nil, err
+	}
+
+	log.Debug(LOG_TAG, "http checker response ", string(respBody))
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return nil, fmt.Errorf("unexpected status code %d: %s", resp.StatusCode, utils.TruncateString(string(respBody), 200))
+	}
+
+	var data HTTPCheckerResponse
+	if err := json.Unmarshal(respBody, &data); err != nil {
+		return nil, fmt.Errorf("invalid response: %w", err)
+	}
+
+	// 更新评论
+	if data.ReplaceContent != "" && data.ReplaceContent != p.Content && c.conf.OnUpdateComment != nil {
+		c.conf.OnUpdateComment(p.CommentID, data.ReplaceContent)
+	}
+
+	return &CheckResult{
+		Pass:     data.Pass,
+		Reason:   data.Reason,
+		Response: utils.TruncateString(string(respBody), 1000),
+	}, nil
+}
+
+func newHTTPCheckerRequest(p *CheckerParams) *HTTPCheckerRequest {
+	createdAt := ""
+	if !p.UserCreatedAt.IsZero() {
+		createdAt = p.UserCreatedAt.Format(time.RFC3339)
+	}
+
+	return &HTTPCheckerRequest{
+		CommentID: p.CommentID,
+		Content:   p.Content,
+		BlogURL:   p.BlogURL,
+
+		UserID:    p.UserID,
+		UserName:  p.UserName,
+		UserEmail: p.UserEmail,
+		UserLink:  p.UserLink,
+		UserIP:    p.UserIP,
+		UserAgent: p.UserAgent,
+		Referrer:  p.Referrer,
+
+		UserCreatedAt:        createdAt,
+		UserApprovedComments: p.UserApprovedComments,
+	}
+}
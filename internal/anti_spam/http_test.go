@@ -0,0 +1,136 @@
+package anti_spam
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/artalkjs/artalk/v2/internal/config"
+	"github.com/artalkjs/artalk/v2/internal/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHTTPChecker(t *testing.T) {
+	var (
+		reqHeader http.Header
+		reqData   HTTPCheckerRequest
+		reqBody   []byte
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		reqHeader = r.Header
+		reqBody, _ = io.ReadAll(r.Body)
+		require.NoError(t, json.Unmarshal(reqBody, &reqData))
+
+		switch reqData.Content {
+		case "spam":
+			w.Write([]byte(`{"pass": false, "reason": "looks like spam"}`))
+		case "bad word":
+			w.Write([]byte(`{"pass": true, "replace_content": "*** word"}`))
+		case "slow":
+			time.Sleep(200 * time.Millisecond)
+			w.Write([]byte(`{"pass": true}`))
+		case "error":
+			w.WriteHeader(500)
+			w.Write([]byte("internal error"))
+		case "invalid":
+			w.Write([]byte("not json"))
+		default:
+			w.Write([]byte(`{"pass": true}`))
+		}
+	}))
+	defer server.Close()
+
+	var updated string
+	checker := NewHTTPChecker(&HTTPCheckerConf{
+		URL:     server.URL,
+		Secret:  "secret",
+		Timeout: 100 * time.Millisecond,
+		OnUpdateComment: func(commentID uint, content string) {
+			assert.Equal(t, uint(1000), commentID)
+			updated = content
+		},
+	})
+	assert.Equal(t, "http", checker.Name())
+
+	check := func(content string) (*CheckResult, error) {
+		updated = ""
+		return checker.CheckDetail(&CheckerParams{
+			CommentID: 1000,
+			Content:   content,
+			UserName:  "user",
+			UserIP:    "127.0.0.1",
+			Referrer:  "https://example.org/page",
+		})
+	}
+
+	t.Run("Pass", func(t *testing.T) {
+		result, err := check("Hello")
+		require.NoError(t, err)
+		assert.True(t, result.Pass)
+		assert.Equal(t, `{"pass": true}`, result.Response)
+		assert.Empty(t, updated)
+
+		assert.Equal(t, uint(1000), reqData.CommentID)
+		assert.Equal(t, "user", reqData.UserName)
+		assert.Equal(t, "127.0.0.1", reqData.UserIP)
+		assert.Equal(t, "https://example.org/page", reqData.Referrer)
+		assert.Equal(t, "application/json", reqHeader.Get("Content-Type"))
+	})
+
+	t.Run("Signature", func(t *testing.T) {
+		_, err := check("Hello")
+		require.NoError(t, err)
+
+		timestamp := reqHeader.Get("X-Artalk-Timestamp")
+		assert.NotEmpty(t, timestamp)
+		assert.Equal(t, "sha256="+utils.GetHmacSha256(timestamp+"."+string(reqBody), "secret"), reqHeader.Get("X-Artalk-Signature"))
+
+		// no signature without secret
+		_, err = NewHTTPChecker(&HTTPCheckerConf{URL: server.URL}).Check(&CheckerParams{Content: "Hello"})
+		require.NoError(t, err)
+		assert.Empty(t, reqHeader.Get("X-Artalk-Signature"))
+	})
+
+	t.Run("Block", func(t *testing.T) {
+		result, err := check("spam")
+		require.NoError(t, err)
+		assert.False(t, result.Pass)
+		assert.Equal(t, "looks like spam", result.Reason)
+	})
+
+	t.Run("Replace content", func(t *testing.T) {
+		result, err := check("bad word")
+		require.NoError(t, err)
+		assert.True(t, result.Pass)
+		assert.Equal(t, "*** word", updated)
+	})
+
+	t.Run("Errors", func(t *testing.T) {
+		for _, content := range []string{"slow", "error", "invalid"} {
+			_, err := check(content)
+			assert.Error(t, err, content)
+		}
+
+		_, err := NewHTTPChecker(&HTTPCheckerConf{}).Check(&CheckerParams{})
+		assert.Error(t, err, "url is not provided")
+	})
+
+	t.Run("ApiFailBlock", func(t *testing.T) {
+		for _, apiFailBlock := range []bool{true, false} {
+			blocked := false
+			antiSpam := NewAntiSpam(&AntiSpamConf{
+				ModeratorConf: config.ModeratorConf{
+					ApiFailBlock: apiFailBlock,
+					HTTP:         config.HTTPAntispamConf{Enabled: true, URL: server.URL},
+				},
+				OnBlockComment: func(commentID uint) { blocked = true },
+			})
+			antiSpam.CheckAndBlock(&CheckerParams{CommentID: 1000, Content: "error"})
+			assert.Equal(t, apiFailBlock, blocked)
+		}
+	})
+}
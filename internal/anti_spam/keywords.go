@@ -11,13 +11,14 @@ import (
 	"github.com/artalkjs/artalk/v2/internal/utils"
 )
 
-var _ Checker = (*KeywordsChecker)(nil)
+var _ DetailChecker = (*KeywordsChecker)(nil)
 
 type KwCheckerMode int
 
 const (
 	KwCheckerModeBlock   KwCheckerMode = iota // 仅拦截
 	KwCheckerModeReplace                      // 仅替换关键词
+	KwCheckerModeReject                       // 拦截并移入回收站
 )
 
 type KeywordsCheckerConf struct {
@@ -45,16 +46,24 @@ func (*KeywordsChecker) Name() string {
 }
 
 func (c *KeywordsChecker) Check(p *CheckerParams) (bool, error) {
-	if err := c.loadKeywords(); err != nil {
+	result, err := c.CheckDetail(p)
+	if err != nil {
 		return false, err
 	}
+	return result.Pass, nil
+}
+
+func (c *KeywordsChecker) CheckDetail(p *CheckerParams) (*CheckResult, error) {
+	if err := c.loadKeywords(); err != nil {
+		return nil, err
+	}
 
-	isContains := false
+	matched := []string{}
 	content := p.Content
 
 	for _, keyword := range *c.keywords {
 		if strings.Contains(p.Content, keyword) {
-			isContains = true
+			matched = append(matched, keyword)
 
 			if c.conf.Mode == KwCheckerModeReplace {
 				content = strings.Replace(content, keyword,
@@ -63,6 +72,9 @@ func (c *KeywordsChecker) Check(p *CheckerParams) (bool, error) {
 		}
 	}
 
+	isContains := len(matched) > 0
+	result := &CheckResult{Matched: strings.Join(matched, ",")}
+
 	switch c.conf.Mode {
 	case KwCheckerModeReplace:
 		if isContains {
@@ -73,15 +85,21 @@ func (c *KeywordsChecker) Check(p *CheckerParams) (bool, error) {
 			if c.conf.OnUpdateComment != nil {
 				c.conf.OnUpdateComment(p.CommentID, content)
 			}
+			result.Reason = "keywords replaced"
 		}
 
-		return true, nil
+		result.Pass = true
+		return result, nil
 
 	case KwCheckerModeBlock:
-		return !isContains, nil
+		result.Pass = !isContains
+		if isContains {
+			result.Reason = "keywords matched"
+		}
+		return result, nil
 	}
 
-	return false, fmt.Errorf("unknown mode: %d", c.conf.Mode)
+	return nil, fmt.Errorf("unknown mode: %d", c.conf.Mode)
 }
 
 func (c *KeywordsChecker) loadKeywords() error {
@@ -0,0 +1,302 @@
+package anti_spam
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/artalkjs/artalk/v2/internal/config"
+	"github.com/artalkjs/artalk/v2/internal/log"
+	"github.com/samber/lo"
+)
+
+var _ DetailChecker = (*RulesChecker)(nil)
+
+var linkRegexp = regexp.MustCompile(`(?i)https?://[^\s<>"'()\[\]]+`)
+
+type RulesCheckerConf struct {
+	config.RulesAntispamConf
+
+	OnUpdateComment       func(commentID uint, content string)
+	OnRejectComment       func(commentID uint)
+	CountRepeatedComments func(p *CheckerParams, since time.Time) int64
+}
+
+// The local rules checker which needs no external service
+type RulesChecker struct {
+	conf     *RulesCheckerConf
+	rules    []ruleItem
+	patterns []*regexp.Regexp
+	loadErr  error
+	once     sync.Once
+}
+
+type ruleItem struct {
+	rule rule
+	mode KwCheckerMode
+}
+
+type rule interface {
+	name() string
+
+	// Check the comment matches the rule (the comment is considered as spam)
+	match(p *CheckerParams) bool
+
+	// Replace the matched parts of the content,
+	// returns false if the rule can not replace the content
+	replace(content string, replaceTo string) (string, bool)
+}
+
+func NewRulesChecker(conf *RulesCheckerConf) *RulesChecker {
+	return &RulesChecker{
+		conf: conf,
+	}
+}
+
+func (*RulesChecker) Name() string {
+	return "rules"
+}
+
+func (c *RulesChecker) Check(p *CheckerParams) (bool, error) {
+	result, err := c.CheckDetail(p)
+	if err != nil {
+		return false, err
+	}
+	return result.Pass, nil
+}
+
+func (c *RulesChecker) CheckDetail(p *CheckerParams) (*CheckResult, error) {
+	c.once.Do(c.loadRules)
+	if c.loadErr != nil {
+		return nil, c.loadErr
+	}
+
+	var (
+		pass     = true
+		reject   = false
+		content  = p.Content
+		matched  = []string{}
+		replaced = []string{}
+	)
+
+	for _, r := range c.rules {
+		params := *p
+		params.Content = content // the content may be replaced by the previous rules
+		if !r.rule.match(&params) {
+			continue
+		}
+
+		log.Info(LOG_TAG, fmt.Sprintf("rule %s matched comment id=%d content=%s",
+			r.rule.name(), p.CommentID, strconv.Quote(content)))
+		matched = append(matched, r.rule.name())
+
+		mode := r.mode
+		if mode == KwCheckerModeReplace {
+			if newContent, ok := r.rule.replace(content, c.conf.ReplaceTo); ok {
+				content = newContent
+				replaced = append(replaced, r.rule.name())
+				continue
+			}
+			mode = KwCheckerModeBlock // fall back to pending if the rule can not replace
+		}
+
+		pass = false
+		if mode == KwCheckerModeReject {
+			reject = true
+		}
+	}
+
+	// 更新评论
+	if content != p.Content && c.conf.OnUpdateComment != nil {
+		c.conf.OnUpdateComment(p.CommentID, content)
+	}
+
+	// 移入回收站 (the comment is only set to pending if it can not be rejected)
+	reject = reject && c.conf.OnRejectComment != nil
+	if reject {
+		c.conf.OnRejectComment(p.CommentID)
+	}
+
+	result := &CheckResult{Pass: pass, Matched: strings.Join(matched, ",")}
+	if !pass {
+		result.Reason = lo.If(reject, "rules matched (reject)").Else("rules matched (pending)")
+	} else if len(replaced) > 0 {
+		result.Reason = "rules replaced: " + strings.Join(replaced, ",")
+	}
+	return result, nil
+}
+
+func (c *RulesChecker) loadRules() {
+	conf := c.conf.RulesAntispamConf
+
+	var patterns []*regexp.Regexp
+	for _, expr := range conf.Patterns.List {
+		re, err := regexp.Compile(expr)
+		if err != nil {
+			c.loadErr = fmt.Errorf("invalid rule pattern: %s, %w", strconv.Quote(expr), err)
+			return
+		}
+		patterns = append(patterns, re)
+	}
+
+	add := func(action config.RuleAction, enabled bool, r rule) {
+		if enabled {
+			c.rules = append(c.rules, ruleItem{rule: r, mode: getRuleMode(action)})
+		}
+	}
+
+	add(conf.Patterns.Action, len(patterns) > 0, &patternsRule{patterns: patterns})
+	add(conf.MaxLinks.Action, conf.MaxLinks.Max > 0, &maxLinksRule{max: conf.MaxLinks.Max})
+	add(conf.MaxLength.Action, conf.MaxLength.Max > 0, &maxLengthRule{max: conf.MaxLength.Max})
+	add(conf.NewUser.Action, conf.NewUser.MinAccountDays > 0 || conf.NewUser.MinApprovedComments > 0, &newUserRule{
+		minAccountDays:      conf.NewUser.MinAccountDays,
+		minApprovedComments: conf.NewUser.MinApprovedComments,
+	})
+	add(conf.Repeated.Action, conf.Repeated.WithinMinutes > 0 && c.conf.CountRepeatedComments != nil, &repeatedRule{
+		within: time.Duration(conf.Repeated.WithinMinutes) * time.Minute,
+		count:  c.conf.CountRepeatedComments,
+	})
+	add(conf.ForbiddenTLDs.Action, len(conf.ForbiddenTLDs.List) > 0, &forbiddenTLDsRule{tlds: conf.ForbiddenTLDs.List})
+}
+
+func getRuleMode(action config.RuleAction) KwCheckerMode {
+	switch action {
+	case config.RuleActionReject:
+		return KwCheckerModeReject
+	case config.RuleActionReplace:
+		return KwCheckerModeReplace
+	default:
+		return KwCheckerModeBlock
+	}
+}
+
+func maskString(s string, replaceTo string) string {
+	return strings.Repeat(replaceTo, len([]rune(s)))
+}
+
+// -------------------------------------------------------------------
+//  Rules
+// -------------------------------------------------------------------
+
+// Match the content by regular expressions
+type patternsRule struct {
+	patterns []*regexp.Regexp
+}
+
+func (*patternsRule) name() string { return "patterns" }
+
+func (r *patternsRule) match(p *CheckerParams) bool {
+	return slices.ContainsFunc(r.patterns, func(re *regexp.Regexp) bool {
+		return re.MatchString(p.Content)
+	})
+}
+
+func (r *patternsRule) replace(content string, replaceTo string) (string, bool) {
+	for _, re := range r.patterns {
+		content = re.ReplaceAllStringFunc(content, func(s string) string {
+			return maskString(s, replaceTo)
+		})
+	}
+	return content, true
+}
+
+// Limit the number of links in the content
+type maxLinksRule struct {
+	max int
+}
+
+func (*maxLinksRule) name() string { return "max_links" }
+
+func (r *maxLinksRule) match(p *CheckerParams) bool {
+	return len(linkRegexp.FindAllString(p.Content, -1)) > r.max
+}
+
+func (r *maxLinksRule) replace(content string, replaceTo string) (string, bool) {
+	// mask the links exceeding the limit
+	n := 0
+	return linkRegexp.ReplaceAllStringFunc(content, func(s string) string {
+		if n++; n <= r.max {
+			return s
+		}
+		return maskString(s, replaceTo)
+	}), true
+}
+
+// Limit the length of the content
+type maxLengthRule struct {
+	max int
+}
+
+func (*maxLengthRule) name() string { return "max_length" }
+
+func (r *maxLengthRule) match(p *CheckerParams) bool {
+	return len([]rune(p.Content)) > r.max
+}
+
+func (r *maxLengthRule) replace(content string, _ string) (string, bool) {
+	// truncate the content
+	return string([]rune(content)[:r.max]), true
+}
+
+// Restrict the new users
+//
+// The user passes if any of the conditions is met.
+type newUserRule struct {
+	minAccountDays      int
+	minApprovedComments int
+}
+
+func (*newUserRule) name() string { return "new_user" }
+
+func (r *newUserRule) match(p *CheckerParams) bool {
+	if r.minAccountDays > 0 && !p.UserCreatedAt.IsZero() &&
+		time.Since(p.UserCreatedAt) >= time.Duration(r.minAccountDays)*24*time.Hour {
+		return false
+	}
+	if r.minApprovedComments > 0 && p.UserApprovedComments >= int64(r.minApprovedComments) {
+		return false
+	}
+	return true
+}
+
+func (*newUserRule) replace(string, string) (string, bool) { return "", false }
+
+// Detect the same content sent repeatedly
+type repeatedRule struct {
+	within time.Duration
+	count  func(p *CheckerParams, since time.Time) int64
+}
+
+func (*repeatedRule) name() string { return "repeated" }
+
+func (r *repeatedRule) match(p *CheckerParams) bool {
+	return r.count(p, time.Now().Add(-r.within)) > 0
+}
+
+func (*repeatedRule) replace(string, string) (string, bool) { return "", false }
+
+// Forbid the top-level domains of the user link
+type forbiddenTLDsRule struct {
+	tlds []string
+}
+
+func (*forbiddenTLDsRule) name() string { return "forbidden_tlds" }
+
+func (r *forbiddenTLDsRule) match(p *CheckerParams) bool {
+	u, err := url.Parse(strings.TrimSpace(p.UserLink))
+	if err != nil || u.Hostname() == "" {
+		return false
+	}
+	host := strings.ToLower(strings.TrimSuffix(u.Hostname(), "."))
+	return slices.ContainsFunc(r.tlds, func(tld string) bool {
+		tld = strings.ToLower(strings.Trim(strings.TrimSpace(tld), "."))
+		return tld != "" && strings.HasSuffix(host, "."+tld)
+	})
+}
+
+func (*forbiddenTLDsRule) replace(string, string) (string, bool) { return "", false }
@@ -0,0 +1,154 @@
+package anti_spam
+
+import (
+	"testing"
+	"time"
+
+	"github.com/artalkjs/artalk/v2/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRulesChecker(t *testing.T) {
+	assert.Equal(t, "rules", NewRulesChecker(&RulesCheckerConf{}).Name())
+
+	type result struct {
+		pass     bool
+		content  string // the updated content (empty if not updated)
+		rejected bool
+	}
+
+	check := func(t *testing.T, conf config.RulesAntispamConf, p *CheckerParams) result {
+		var r result
+		checker := NewRulesChecker(&RulesCheckerConf{
+			RulesAntispamConf: conf,
+			OnUpdateComment: func(commentID uint, content string) {
+				assert.Equal(t, p.CommentID, commentID)
+				r.content = content
+			},
+			OnRejectComment: func(commentID uint) {
+				assert.Equal(t, p.CommentID, commentID)
+				r.rejected = true
+			},
+			CountRepeatedComments: func(p *CheckerParams, since time.Time) int64 {
+				if p.Content == "repeated" && time.Since(since) <= time.Hour {
+					return 1
+				}
+				return 0
+			},
+		})
+
+		pass, err := checker.Check(p)
+		assert.NoError(t, err)
+		r.pass = pass
+		return r
+	}
+
+	t.Run("Patterns", func(t *testing.T) {
+		conf := config.RulesAntispamConf{
+			ReplaceTo: "*",
+			Patterns:  config.RuleListConf{List: []string{`(?i)buy\s+now`, `\d{11}`}},
+		}
+
+		assert.Equal(t, result{pass: true}, check(t, conf, &CheckerParams{CommentID: 1, Content: "Hello"}))
+		assert.Equal(t, result{pass: false}, check(t, conf, &CheckerParams{CommentID: 1, Content: "BUY  Now!"}))
+
+		conf.Patterns.Action = config.RuleActionReplace
+		assert.Equal(t, result{pass: true, content: "Call *********** Buy"},
+			check(t, conf, &CheckerParams{CommentID: 1, Content: "Call 13800000000 Buy"}))
+
+		conf.Patterns.Action = config.RuleActionBlock
+		assert.Equal(t, result{pass: false}, check(t, conf, &CheckerParams{CommentID: 1, Content: "buy now"}), "block only sets the comment to pending")
+
+		conf.Patterns.Action = config.RuleActionReject
+		assert.Equal(t, result{pass: false, rejected: true}, check(t, conf, &CheckerParams{CommentID: 1, Content: "buy now"}))
+	})
+
+	t.Run("Reason of the applied action", func(t *testing.T) {
+		conf := config.RulesAntispamConf{Patterns: config.RuleListConf{List: []string{`spam`}, Action: config.RuleActionReject}}
+		p := &CheckerParams{CommentID: 1, Content: "spam"}
+
+		result, err := NewRulesChecker(&RulesCheckerConf{RulesAntispamConf: conf, OnRejectComment: func(uint) {}}).CheckDetail(p)
+		assert.NoError(t, err)
+		assert.Equal(t, "rules matched (reject)", result.Reason)
+
+		result, err = NewRulesChecker(&RulesCheckerConf{RulesAntispamConf: conf}).CheckDetail(p)
+		assert.NoError(t, err)
+		assert.Equal(t, "rules matched (pending)", result.Reason, "only set to pending if it can not be rejected")
+
+		conf.Patterns.Action = config.RuleActionBlock
+		result, err = NewRulesChecker(&RulesCheckerConf{RulesAntispamConf: conf, OnRejectComment: func(uint) {}}).CheckDetail(p)
+		assert.NoError(t, err)
+		assert.Equal(t, "rules matched (pending)", result.Reason)
+	})
+
+	t.Run("Invalid pattern", func(t *testing.T) {
+		checker := NewRulesChecker(&RulesCheckerConf{
+			RulesAntispamConf: config.RulesAntispamConf{Patterns: config.RuleListConf{List: []string{`(`}}},
+		})
+		_, err := checker.Check(&CheckerParams{Content: "test"})
+		assert.Error(t, err)
+	})
+
+	t.Run("Max links", func(t *testing.T) {
+		conf := config.RulesAntispamConf{ReplaceTo: "x", MaxLinks: config.RuleLimitConf{Max: 1}}
+		content := "see https://a.com and http://b.com/c"
+
+		assert.Equal(t, result{pass: true}, check(t, conf, &CheckerParams{CommentID: 1, Content: "see https://a.com"}))
+		assert.Equal(t, result{pass: false}, check(t, conf, &CheckerParams{CommentID: 1, Content: content}))
+
+		conf.MaxLinks.Action = config.RuleActionReplace
+		assert.Equal(t, result{pass: true, content: "see https://a.com and xxxxxxxxxxxxxx"},
+			check(t, conf, &CheckerParams{CommentID: 1, Content: content}))
+	})
+
+	t.Run("Max length", func(t *testing.T) {
+		conf := config.RulesAntispamConf{MaxLength: config.RuleLimitConf{Max: 5}}
+
+		assert.Equal(t, result{pass: true}, check(t, conf, &CheckerParams{CommentID: 1, Content: "你好世界！"}))
+		assert.Equal(t, result{pass: false}, check(t, conf, &CheckerParams{CommentID: 1, Content: "你好世界！！"}))
+
+		conf.MaxLength.Action = config.RuleActionReplace
+		assert.Equal(t, result{pass: true, content: "你好世界！"}, check(t, conf, &CheckerParams{CommentID: 1, Content: "你好世界！！"}))
+	})
+
+	t.Run("New user", func(t *testing.T) {
+		conf := config.RulesAntispamConf{NewUser: config.RuleNewUserConf{MinAccountDays: 7, MinApprovedComments: 3}}
+		newUser := &CheckerParams{CommentID: 1, Content: "Hi", UserCreatedAt: time.Now()}
+
+		assert.Equal(t, result{pass: false}, check(t, conf, newUser))
+		assert.Equal(t, result{pass: true}, check(t, conf, &CheckerParams{CommentID: 1, Content: "Hi", UserCreatedAt: time.Now().AddDate(0, 0, -8)}))
+		assert.Equal(t, result{pass: true}, check(t, conf, &CheckerParams{CommentID: 1, Content: "Hi", UserCreatedAt: time.Now(), UserApprovedComments: 3}))
+
+		// can not replace, fall back to pending
+		conf.NewUser.Action = config.RuleActionReplace
+		assert.Equal(t, result{pass: false}, check(t, conf, newUser))
+	})
+
+	t.Run("Repeated", func(t *testing.T) {
+		conf := config.RulesAntispamConf{Repeated: config.RuleRepeatConf{WithinMinutes: 10, Action: config.RuleActionReject}}
+
+		assert.Equal(t, result{pass: true}, check(t, conf, &CheckerParams{CommentID: 1, Content: "Hi"}))
+		assert.Equal(t, result{pass: false, rejected: true}, check(t, conf, &CheckerParams{CommentID: 1, Content: "repeated"}))
+	})
+
+	t.Run("Forbidden TLDs", func(t *testing.T) {
+		conf := config.RulesAntispamConf{ForbiddenTLDs: config.RuleListConf{List: []string{".XYZ", "top"}}}
+
+		assert.Equal(t, result{pass: true}, check(t, conf, &CheckerParams{CommentID: 1, UserLink: "https://example.org"}))
+		assert.Equal(t, result{pass: true}, check(t, conf, &CheckerParams{CommentID: 1, UserLink: "https://xyz.org"}))
+		assert.Equal(t, result{pass: false}, check(t, conf, &CheckerParams{CommentID: 1, UserLink: "https://spam.xyz/page"}))
+		assert.Equal(t, result{pass: false}, check(t, conf, &CheckerParams{CommentID: 1, UserLink: "http://a.b.TOP"}))
+	})
+
+	t.Run("Multiple rules", func(t *testing.T) {
+		conf := config.RulesAntispamConf{
+			ReplaceTo: "*",
+			Patterns:  config.RuleListConf{Action: config.RuleActionReplace, List: []string{"bad"}},
+			MaxLength: config.RuleLimitConf{Max: 10},
+		}
+
+		// the content is replaced, but also set to pending by the other rule
+		assert.Equal(t, result{pass: false, content: "*** words are too long"},
+			check(t, conf, &CheckerParams{CommentID: 1, Content: "bad words are too long"}))
+	})
+}
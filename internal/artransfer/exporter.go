@@ -70,6 +70,13 @@ func commentToArtran(db *gorm.DB, c *entity.Comment, cache *exportCache) entity.
 		cache.Sites[c.SiteName] = site
 	}
 
+	reactions := ""
+	if len(c.Reactions) > 0 {
+		if b, err := json.Marshal(c.Reactions); err == nil {
+			reactions = string(b)
+		}
+	}
+
 	return entity.Artran{
 		ID:            utils.ToString(c.ID),
 		Rid:           utils.ToString(c.Rid),
@@ -81,6 +88,7 @@ func commentToArtran(db *gorm.DB, c *entity.Comment, cache *exportCache) entity.
 		IsPinned:      utils.ToString(c.IsPinned),
 		VoteUp:        utils.ToString(c.VoteUp),
 		VoteDown:      utils.ToString(c.VoteDown),
+		Reactions:     reactions,
 		CreatedAt:     c.CreatedAt.Format("2006-01-02 15:04:05 -0700"),
 		UpdatedAt:     c.UpdatedAt.Format("2006-01-02 15:04:05 -0700"),
 		Nick:          user.Name,
@@ -2,6 +2,7 @@ package artransfer
 
 import (
 	"cmp"
+	"encoding/json"
 	"fmt"
 	"os"
 	"strconv"
@@ -166,6 +167,13 @@ func importArtrans(tx *gorm.DB, params *ImportParams, comments []*entity.Artran)
 		voteUp, _ := strconv.Atoi(c.VoteUp)
 		voteDown, _ := strconv.Atoi(c.VoteDown)
 
+		var reactions map[string]int
+		if c.Reactions != "" {
+			if err := json.Unmarshal([]byte(c.Reactions), &reactions); err != nil {
+				reactions = nil // ignore the invalid reactions
+			}
+		}
+
 		// ---------------------
 		//  Create new comment
 		// ---------------------
@@ -185,6 +193,8 @@ func importArtrans(tx *gorm.DB, params *ImportParams, comments []*entity.Artran)
 			VoteUp:   voteUp,
 			VoteDown: voteDown,
 
+			Reactions: reactions,
+
 			UserID:   user.ID,
 			PageKey:  page.Key,
 			SiteName: site.Name,
@@ -276,6 +286,17 @@ func importArtrans(tx *gorm.DB, params *ImportParams, comments []*entity.Artran)
 				return err
 			}
 		}
+		for reaction, count := range savedComment.Reactions {
+			for i := 0; i < count; i++ {
+				if vErr := dbSave(tx, &entity.Vote{
+					TargetID: savedComment.ID,
+					Type:     entity.VoteTypeCommentReaction,
+					Reaction: reaction,
+				}); vErr != nil {
+					return fmt.Errorf("failed to create reaction, %w", vErr)
+				}
+			}
+		}
 
 		// Output progress
 		if bar != nil {
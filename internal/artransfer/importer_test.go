@@ -173,6 +173,33 @@ func Test_importArtrans(t *testing.T) {
 		assert.Equal(t, int64(3), downCount, "VoteDown should be the same")
 	})
 
+	t.Run("Import with Reactions", func(t *testing.T) {
+		ddb, _ := db.NewTestDB()
+		defer db.CloseDB(ddb)
+		dao := dao.NewDao(ddb)
+
+		params := ImportParams{
+			Assumeyes: true,
+		}
+
+		// Perform import
+		err := importArtrans(dao.DB(), &params, []*entity.Artran{
+			{
+				Content:   "TestContent",
+				PageKey:   "/test_page_key.html",
+				SiteName:  "test_site",
+				Reactions: `{"👍":2,"🎉":1}`,
+			},
+		})
+		assert.Nil(t, err, "Import should be successful")
+
+		// Assert
+		var comment entity.Comment
+		dao.DB().First(&comment)
+		assert.Equal(t, map[string]int{"👍": 2, "🎉": 1}, comment.Reactions, "Reactions should be the same")
+		assert.Equal(t, map[string]int{"👍": 2, "🎉": 1}, dao.GetReactionNums(comment.ID), "Reaction votes should be created")
+	})
+
 	t.Run("Import with DB transaction Rollback", func(t *testing.T) {
 		ddb, _ := db.NewTestDB()
 		defer db.CloseDB(ddb)
@@ -107,6 +107,28 @@ func (conf *Config) normalPatch() {
 		}
 	}
 
+	// 邮件汇总默认关闭 (每条通知即时发送)
+	if conf.Email.Digest.Frequency == "" {
+		conf.Email.Digest.Frequency = "immediate"
+	}
+	if conf.AdminNotify.Email.Digest == "" {
+		conf.AdminNotify.Email.Digest = "immediate"
+	}
+	if conf.Email.Digest.MailSubject == "" {
+		conf.Email.Digest.MailSubject = "[{{site_name}}] You have {{count}} unread replies"
+	}
+	if conf.AdminNotify.Email.DigestMailSubject == "" {
+		conf.AdminNotify.Email.DigestMailSubject = "[{{site_name}}] You have {{count}} new comment notifications"
+	}
+	if conf.Email.Digest.Hour < 0 || conf.Email.Digest.Hour > 23 {
+		log.Warn("config `email.digest.hour` should be 0-23, now it is: 0")
+		conf.Email.Digest.Hour = 0
+	}
+	if conf.Email.Digest.Weekday < 0 || conf.Email.Digest.Weekday > 6 {
+		log.Warn("config `email.digest.weekday` should be 0-6, now it is: 1")
+		conf.Email.Digest.Weekday = 1
+	}
+
 	// 默认待审模式下开启管理员通知嘈杂模式，保证管理员能看到待审核文章
 	if conf.Moderator.PendingDefault {
 		conf.AdminNotify.NoiseMode = true
@@ -140,6 +162,11 @@ func (conf *Config) normalPatch() {
 		*conf.HTTP.ProxyHeader = strings.TrimSpace(*conf.HTTP.ProxyHeader)
 	}
 
+	// Webhook 请求超时默认值 (未设置时也用于判断投递是否中断)
+	if conf.Webhooks.Timeout <= 0 {
+		conf.Webhooks.Timeout = 5
+	}
+
 	// 社交登录配置
 	if conf.Auth.Enabled && strings.TrimSpace(conf.Auth.Callback) == "" {
 		callbackURL := "http://localhost:23366/api/v2/auth/:provider/callback"
@@ -34,6 +34,16 @@ func TestI18nPatch(t *testing.T) {
 	})
 }
 
+func TestNormalPatchWebhooks(t *testing.T) {
+	conf := &Config{AppKey: "test", TimeZone: "Local", SiteDefault: "Default Site", AdminNotify: AdminNotifyConf{Email: &AdminEmailConf{}}}
+	conf.normalPatch()
+	assert.Equal(t, 5, conf.Webhooks.Timeout, "the timeout should not be unbounded if not set")
+
+	conf.Webhooks.Timeout = 10
+	conf.normalPatch()
+	assert.Equal(t, 10, conf.Webhooks.Timeout)
+}
+
 func mockCheckFileExist(mockFiles map[string]bool) func(string) bool {
 	return func(filename string) bool {
 		return mockFiles[filename]
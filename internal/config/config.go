@@ -1,6 +1,7 @@
 package config
 
 import (
+	"slices"
 	"time"
 )
 
@@ -24,11 +25,14 @@ type Config struct {
 	AdminUsers     []AdminUserConf        `koanf:"admin_users" json:"admin_users"`         // 管理员账户
 	LoginTimeout   int                    `koanf:"login_timeout" json:"login_timeout"`     // 登录超时
 	Moderator      ModeratorConf          `koanf:"moderator" json:"moderator"`             // 评论审查
+	Comment        CommentConf            `koanf:"comment" json:"comment"`                 // 评论功能
+	Trash          TrashConf              `koanf:"trash" json:"trash"`                     // 回收站
 	Captcha        CaptchaConf            `koanf:"captcha" json:"captcha"`                 // 验证码
 	Email          EmailConf              `koanf:"email" json:"email"`                     // 邮箱提醒
 	IPRegion       IPRegionConf           `koanf:"ip_region" json:"ip_region"`             // IP 属地展示
 	ImgUpload      ImgUploadConf          `koanf:"img_upload" json:"img_upload"`           // 图片上传
 	AdminNotify    AdminNotifyConf        `koanf:"admin_notify" json:"admin_notify"`       // 其他通知方式
+	Webhooks       WebhooksConf           `koanf:"webhooks" json:"webhooks"`               // Webhook 订阅
 	Auth           AuthConf               `koanf:"auth" json:"auth"`                       // Social Login
 	Frontend       map[string]interface{} `koanf:"frontend" json:"frontend"`
 
@@ -119,11 +123,21 @@ type AdminUserConf struct {
 
 type ModeratorConf struct {
 	PendingDefault bool                 `koanf:"pending_default" json:"pending_default"`
+	Trust          TrustConf            `koanf:"trust" json:"trust"`                   // 信任老用户 (跳过默认待审)
 	ApiFailBlock   bool                 `koanf:"api_fail_block" json:"api_fail_block"` // API 请求错误仍然拦截
 	AkismetKey     string               `koanf:"akismet_key" json:"akismet_key"`
 	Tencent        TencentAntispamConf  `koanf:"tencent" json:"tencent"`
 	Aliyun         AliyunAntispamConf   `koanf:"aliyun" json:"aliyun"`
 	Keywords       KeyWordsAntispamConf `koanf:"keywords" json:"keywords"`
+	Rules          RulesAntispamConf    `koanf:"rules" json:"rules"`
+	Bayes          BayesAntispamConf    `koanf:"bayes" json:"bayes"`
+	HTTP           HTTPAntispamConf     `koanf:"http" json:"http"`
+}
+
+// 信任老用户
+type TrustConf struct {
+	Enabled     bool `koanf:"enabled" json:"enabled"`
+	MinApproved int  `koanf:"min_approved" json:"min_approved"` // 受信任所需的审核通过评论数 (且没有被拒绝的评论)
 }
 
 // 腾讯云反垃圾
@@ -151,6 +165,126 @@ type KeyWordsAntispamConf struct {
 	ReplaceTo string   `koanf:"replace_to" json:"replace_to"`
 }
 
+// 贝叶斯分类器 (从管理员审核记录中学习)
+type BayesAntispamConf struct {
+	Enabled    bool    `koanf:"enabled" json:"enabled"`
+	Threshold  float64 `koanf:"threshold" json:"threshold"`     // 垃圾评论概率阈值 (0 ~ 1)
+	MinTrained int     `koanf:"min_trained" json:"min_trained"` // 垃圾和正常评论的最少训练数量 (训练不足时不启用)
+}
+
+// 自定义 HTTP 反垃圾服务
+type HTTPAntispamConf struct {
+	Enabled bool   `koanf:"enabled" json:"enabled"`
+	URL     string `koanf:"url" json:"url"`         // 接收 POST 请求的地址
+	Secret  string `koanf:"secret" json:"secret"`   // 请求签名密钥 (HMAC-SHA256)
+	Timeout int    `koanf:"timeout" json:"timeout"` // 请求超时 (单位：秒)
+}
+
+// 本地规则过滤
+type RulesAntispamConf struct {
+	Enabled       bool            `koanf:"enabled" json:"enabled"`
+	ReplaceTo     string          `koanf:"replace_to" json:"replace_to"`         // 替换字符 (action 为 replace 时)
+	Patterns      RuleListConf    `koanf:"patterns" json:"patterns"`             // 正则表达式匹配评论内容
+	MaxLinks      RuleLimitConf   `koanf:"max_links" json:"max_links"`           // 评论内容中的链接数量上限
+	MaxLength     RuleLimitConf   `koanf:"max_length" json:"max_length"`         // 评论内容长度上限
+	NewUser       RuleNewUserConf `koanf:"new_user" json:"new_user"`             // 新用户限制
+	Repeated      RuleRepeatConf  `koanf:"repeated" json:"repeated"`             // 重复内容检测
+	ForbiddenTLDs RuleListConf    `koanf:"forbidden_tlds" json:"forbidden_tlds"` // 禁止的用户链接顶级域名
+}
+
+// The action of the anti-spam rule when the comment matches
+//
+// `pending` (default): set the comment to pending;
+// `block`: the same as `pending` (only intercept, as the keywords checker does);
+// `reject`: move the comment into the trash;
+// `replace`: replace the matched content (fall back to `pending` if the rule can not replace)
+type RuleAction string
+
+const (
+	RuleActionPending RuleAction = "pending"
+	RuleActionBlock   RuleAction = "block"
+	RuleActionReject  RuleAction = "reject"
+	RuleActionReplace RuleAction = "replace"
+)
+
+type RuleListConf struct {
+	Action RuleAction `koanf:"action" json:"action"`
+	List   []string   `koanf:"list" json:"list"`
+}
+
+type RuleLimitConf struct {
+	Action RuleAction `koanf:"action" json:"action"`
+	Max    int        `koanf:"max" json:"max"` // 0 为不限制
+}
+
+type RuleNewUserConf struct {
+	Action              RuleAction `koanf:"action" json:"action"`
+	MinAccountDays      int        `koanf:"min_account_days" json:"min_account_days"`           // 账号注册天数下限
+	MinApprovedComments int        `koanf:"min_approved_comments" json:"min_approved_comments"` // 已审核通过的评论数下限 (满足任意一项即可)
+}
+
+type RuleRepeatConf struct {
+	Action        RuleAction `koanf:"action" json:"action"`
+	WithinMinutes int        `koanf:"within_minutes" json:"within_minutes"` // 检测多少分钟内的相同内容 (0 为不检测)
+}
+
+// 评论功能
+type CommentConf struct {
+	Edit     CommentEditConf     `koanf:"edit" json:"edit"`         // 评论编辑
+	Delete   CommentDeleteConf   `koanf:"delete" json:"delete"`     // 评论删除
+	Reaction CommentReactionConf `koanf:"reaction" json:"reaction"` // 表情回应
+	Mention  CommentMentionConf  `koanf:"mention" json:"mention"`   // @ 提及
+	Report   CommentReportConf   `koanf:"report" json:"report"`     // 评论举报
+}
+
+type CommentEditConf struct {
+	Enabled bool `koanf:"enabled" json:"enabled"` // 允许用户编辑自己的评论
+	Timeout int  `koanf:"timeout" json:"timeout"` // 可编辑时限 (单位：分钟，0 为不限制)
+}
+
+type CommentDeleteConf struct {
+	Enabled bool `koanf:"enabled" json:"enabled"` // 允许用户删除自己的评论
+}
+
+// The default reaction types
+var DefaultReactionTypes = []string{"👍", "👎", "😄", "🎉", "😕", "❤️", "🚀", "👀"}
+
+type CommentReactionConf struct {
+	Enabled bool     `koanf:"enabled" json:"enabled"` // 启用表情回应
+	Types   []string `koanf:"types" json:"types"`     // 可用的表情 (站点未单独设置时使用)
+}
+
+type CommentMentionConf struct {
+	Enabled       bool `koanf:"enabled" json:"enabled"`                 // 启用 @ 提及通知
+	MaxPerComment int  `koanf:"max_per_comment" json:"max_per_comment"` // 单条评论最多提及的用户数 (超出部分忽略，0 为不限制)
+	MaxPerDay     int  `koanf:"max_per_day" json:"max_per_day"`         // 每个用户每天最多提及的次数 (0 为不限制)
+}
+
+type CommentReportAction string
+
+const (
+	CommentReportActionCollapse CommentReportAction = "collapse" // 折叠评论
+	CommentReportActionPending  CommentReportAction = "pending"  // 评论设为待审
+)
+
+type CommentReportConf struct {
+	Enabled   bool                `koanf:"enabled" json:"enabled"`     // 允许用户举报评论
+	Threshold int                 `koanf:"threshold" json:"threshold"` // 自动处理所需的举报数 (0 为不自动处理)
+	Action    CommentReportAction `koanf:"action" json:"action"`       // 达到举报数后的处理方式
+}
+
+func (c *CommentReactionConf) GetTypes() []string {
+	if len(c.Types) == 0 {
+		return DefaultReactionTypes
+	}
+	return c.Types
+}
+
+// 回收站
+type TrashConf struct {
+	RetentionDays int `koanf:"retention_days" json:"retention_days"` // 保留天数 (超过后自动彻底删除，0 为永久保留)
+}
+
 type CaptchaConf struct {
 	Enabled       bool          `koanf:"enabled" json:"enabled"`
 	Always        bool          `koanf:"always" json:"always"`
@@ -206,6 +340,7 @@ type EmailConf struct {
 	SMTP               SMTPConf        `koanf:"smtp" json:"smtp"`                 // SMTP 配置
 	AliDM              AliDMConf       `koanf:"ali_dm" json:"ali_dm"`             // 阿里云邮件配置
 	Queue              EmailQueueConf  `koanf:"queue" json:"queue"`               // 邮件发送队列配置
+	Digest             EmailDigestConf `koanf:"digest" json:"digest"`             // 邮件汇总配置
 }
 
 type SMTPConf struct {
@@ -224,7 +359,19 @@ type AliDMConf struct {
 }
 
 type EmailQueueConf struct {
-	BufferSize int `koanf:"buffer_size" json:"buffer_size"` // Channel buffer size (default is zero that not create buffer)
+	BufferSize    int `koanf:"buffer_size" json:"buffer_size"`       // Channel buffer size (default is zero that not create buffer)
+	MaxRetries    int `koanf:"max_retries" json:"max_retries"`       // 失败重试次数 (重试间隔按指数递增，超过后标记为发送失败，默认 3)
+	RetryDelay    int `koanf:"retry_delay" json:"retry_delay"`       // 首次重试的间隔 (单位：秒，默认 60)
+	RetentionDays int `koanf:"retention_days" json:"retention_days"` // 已发送和发送失败的邮件记录保留天数 (超过后自动删除，0 为永久保留)
+}
+
+type EmailDigestConf struct {
+	Frequency   string `koanf:"frequency" json:"frequency"`       // 用户默认的邮件通知频率 (immediate: 每条通知即时发送, daily: 每天汇总, weekly: 每周汇总)
+	Hour        int    `koanf:"hour" json:"hour"`                 // 汇总邮件的发送时间 (0-23 点)
+	Weekday     int    `koanf:"weekday" json:"weekday"`           // 每周汇总的发送日 (0: 周日, 1: 周一 ... 6: 周六)
+	TimeZone    string `koanf:"timezone" json:"timezone"`         // 发送时间所在的时区 (默认使用全局时区)
+	MailSubject string `koanf:"mail_subject" json:"mail_subject"` // 汇总邮件标题
+	MailTpl     string `koanf:"mail_tpl" json:"mail_tpl"`         // 汇总邮件模板
 }
 
 type DBType string
@@ -312,9 +459,12 @@ type AdminNotifyConf struct {
 }
 
 type AdminEmailConf struct {
-	Enabled     bool   `koanf:"enabled" json:"enabled"`           // 管理员总开关
-	MailSubject string `koanf:"mail_subject" json:"mail_subject"` // 管理员邮件标题
-	MailTpl     string `koanf:"mail_tpl" json:"mail_tpl"`         // 管理员专用邮件模板
+	Enabled           bool   `koanf:"enabled" json:"enabled"`                         // 管理员总开关
+	MailSubject       string `koanf:"mail_subject" json:"mail_subject"`               // 管理员邮件标题
+	MailTpl           string `koanf:"mail_tpl" json:"mail_tpl"`                       // 管理员专用邮件模板
+	Digest            string `koanf:"digest" json:"digest"`                           // 管理员 (含站点管理员和审核员) 默认的邮件通知频率 (immediate, daily, weekly)
+	DigestMailSubject string `koanf:"digest_mail_subject" json:"digest_mail_subject"` // 管理员汇总邮件标题
+	DigestMailTpl     string `koanf:"digest_mail_tpl" json:"digest_mail_tpl"`         // 管理员汇总邮件模板
 }
 
 type NotifyTelegramConf struct {
@@ -354,8 +504,33 @@ type NotifyLINEConf struct {
 }
 
 type NotifyWebHookConf struct {
-	Enabled bool   `koanf:"enabled" json:"enabled"`
-	URL     string `koanf:"url" json:"url"`
+	Enabled    bool   `koanf:"enabled" json:"enabled"`
+	URL        string `koanf:"url" json:"url"`
+	Secret     string `koanf:"secret" json:"secret"`           // 请求签名密钥 (HMAC-SHA256)
+	Timeout    int    `koanf:"timeout" json:"timeout"`         // 请求超时 (单位：秒)
+	MaxRetries int    `koanf:"max_retries" json:"max_retries"` // 失败重试次数 (重试间隔按指数递增)
+}
+
+// Webhook 订阅
+type WebhooksConf struct {
+	Timeout       int                       `koanf:"timeout" json:"timeout"`               // 请求超时 (单位：秒)
+	MaxRetries    int                       `koanf:"max_retries" json:"max_retries"`       // 失败重试次数 (重试间隔按指数递增)
+	RetentionDays int                       `koanf:"retention_days" json:"retention_days"` // 投递记录保留天数 (超过后自动删除，0 为永久保留)
+	Subscriptions []WebhookSubscriptionConf `koanf:"subscriptions" json:"subscriptions"`   // 订阅列表
+}
+
+type WebhookSubscriptionConf struct {
+	URL    string   `koanf:"url" json:"url"`       // 接收 POST 请求的地址
+	Secret string   `koanf:"secret" json:"secret"` // 请求签名密钥 (HMAC-SHA256)
+	Sites  []string `koanf:"sites" json:"sites"`   // 站点过滤 (为空则订阅全部站点，不属于站点的用户事件仅在为空时发送)
+	Events []string `koanf:"events" json:"events"` // 事件过滤 (为空则订阅全部事件)
+}
+
+// Check the subscription receives the event of the site
+func (s WebhookSubscriptionConf) IsSubscribed(event string, siteName string) bool {
+	return s.URL != "" &&
+		(len(s.Sites) == 0 || slices.Contains(s.Sites, siteName)) &&
+		(len(s.Events) == 0 || slices.Contains(s.Events, event))
 }
 
 type AuthEmailConf struct {
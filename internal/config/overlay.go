@@ -0,0 +1,165 @@
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+)
+
+// The config paths which can be overridden by the site config overlay
+//
+// A path allows all the options under it, e.g. `admin_notify` allows `admin_notify.telegram.api_token`.
+// The options which touch the host (e.g. `img_upload.path` and `img_upload.upgit.exec`) are global only.
+var SiteOverlayPaths = []string{
+	"moderator",
+	"captcha",
+	"email.mail_tpl",
+	"email.mail_subject",
+	"email.send_name",
+	"admin_notify",
+	"img_upload.enabled",
+	"img_upload.max_size",
+}
+
+// Parse the site config overlay (JSON object) and check it only contains the allowed paths
+func ParseOverlay(overlayJSON string) (map[string]any, error) {
+	overlay := map[string]any{}
+	if strings.TrimSpace(overlayJSON) == "" {
+		return overlay, nil
+	}
+	if err := json.Unmarshal([]byte(overlayJSON), &overlay); err != nil {
+		return nil, fmt.Errorf("invalid config overlay: %w", err)
+	}
+	if err := ValidateOverlay(overlay); err != nil {
+		return nil, err
+	}
+	return overlay, nil
+}
+
+// Check the site config overlay only contains the allowed paths
+func ValidateOverlay(overlay map[string]any) error {
+	var walk func(prefix string, m map[string]any) error
+	walk = func(prefix string, m map[string]any) error {
+		for k, v := range m {
+			path := strings.TrimPrefix(prefix+"."+k, ".")
+			if isOverlayPathAllowed(path) {
+				continue
+			}
+
+			// go deeper if some allowed paths are under it
+			sub, isMap := v.(map[string]any)
+			if !isMap || !slices.ContainsFunc(SiteOverlayPaths, func(p string) bool { return strings.HasPrefix(p, path+".") }) {
+				return fmt.Errorf("config `%s` cannot be overridden by site", path)
+			}
+			if err := walk(path, sub); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	return walk("", overlay)
+}
+
+func isOverlayPathAllowed(path string) bool {
+	return slices.ContainsFunc(SiteOverlayPaths, func(p string) bool {
+		return path == p || strings.HasPrefix(path, p+".")
+	})
+}
+
+// Merge the site config overlay (JSON object) over the config
+//
+// A new config is returned and the original one is not modified.
+func (conf *Config) MergeOverlay(overlayJSON string) (*Config, error) {
+	overlay, err := ParseOverlay(overlayJSON)
+	if err != nil {
+		return nil, err
+	}
+
+	merged := *conf
+	sections := map[string]any{
+		"moderator":    &merged.Moderator,
+		"captcha":      &merged.Captcha,
+		"email":        &merged.Email,
+		"admin_notify": &merged.AdminNotify,
+		"img_upload":   &merged.ImgUpload,
+	}
+
+	for key, val := range overlay {
+		section, ok := sections[key]
+		if !ok {
+			continue
+		}
+		sub, ok := val.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("config overlay `%s` should be an object", key)
+		}
+		if err := mergeSection(section, sub); err != nil {
+			return nil, fmt.Errorf("merge config overlay `%s` failed: %w", key, err)
+		}
+	}
+
+	return &merged, nil
+}
+
+// Merge the overlay into the section (a pointer to the config struct)
+//
+// The fields are matched by the `koanf` tags like the config file, so the fields hidden from JSON are kept.
+// The pointers are copied before modified to avoid modifying the original config.
+func mergeSection(section any, overlay map[string]any) error {
+	return mergeValue(reflect.ValueOf(section).Elem(), overlay)
+}
+
+func mergeValue(dst reflect.Value, src any) error {
+	switch {
+	case dst.Kind() == reflect.Pointer:
+		val := reflect.New(dst.Type().Elem())
+		if !dst.IsNil() {
+			val.Elem().Set(dst.Elem())
+		}
+		if err := mergeValue(val.Elem(), src); err != nil {
+			return err
+		}
+		dst.Set(val)
+		return nil
+
+	case dst.Kind() == reflect.Struct:
+		srcMap, ok := src.(map[string]any)
+		if !ok {
+			break // decode the whole value
+		}
+		for k, v := range srcMap {
+			field, ok := findFieldByKoanfTag(dst, k)
+			if !ok {
+				return fmt.Errorf("unknown config `%s`", k)
+			}
+			if err := mergeValue(field, v); err != nil {
+				return fmt.Errorf("%s: %w", k, err)
+			}
+		}
+		return nil
+	}
+
+	// the leaf value is decoded from the JSON value of the overlay
+	raw, err := json.Marshal(src)
+	if err != nil {
+		return err
+	}
+	val := reflect.New(dst.Type())
+	if err := json.Unmarshal(raw, val.Interface()); err != nil {
+		return err
+	}
+	dst.Set(val.Elem())
+
+	return nil
+}
+
+func findFieldByKoanfTag(v reflect.Value, name string) (reflect.Value, bool) {
+	for i := 0; i < v.NumField(); i++ {
+		if tag, _, _ := strings.Cut(v.Type().Field(i).Tag.Get("koanf"), ","); tag == name {
+			return v.Field(i), true
+		}
+	}
+	return reflect.Value{}, false
+}
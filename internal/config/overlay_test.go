@@ -0,0 +1,88 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMergeOverlay(t *testing.T) {
+	conf := &Config{
+		Moderator: ModeratorConf{PendingDefault: false, ApiFailBlock: true},
+		Email:     EmailConf{MailTpl: "default", SendAddr: "noreply@example.com"},
+		AdminNotify: AdminNotifyConf{
+			Email:    &AdminEmailConf{Enabled: true, MailTpl: "admin"},
+			Telegram: NotifyTelegramConf{Enabled: false, Receivers: []int64{1, 2}},
+		},
+	}
+
+	t.Run("Merge", func(t *testing.T) {
+		merged, err := conf.MergeOverlay(`{
+			"moderator": {"pending_default": true},
+			"email": {"mail_tpl": "site"},
+			"admin_notify": {"email": {"mail_tpl": "site_admin"}, "telegram": {"enabled": true, "receivers": [3]}}
+		}`)
+		require.NoError(t, err)
+
+		assert.True(t, merged.Moderator.PendingDefault)
+		assert.True(t, merged.Moderator.ApiFailBlock, "the options not in the overlay should be kept")
+		assert.Equal(t, "site", merged.Email.MailTpl)
+		assert.Equal(t, "noreply@example.com", merged.Email.SendAddr)
+		assert.Equal(t, "site_admin", merged.AdminNotify.Email.MailTpl)
+		assert.True(t, merged.AdminNotify.Email.Enabled)
+		assert.Equal(t, []int64{3}, merged.AdminNotify.Telegram.Receivers)
+
+		// the original config is not modified
+		assert.False(t, conf.Moderator.PendingDefault)
+		assert.Equal(t, "default", conf.Email.MailTpl)
+		assert.Equal(t, "admin", conf.AdminNotify.Email.MailTpl)
+		assert.Equal(t, []int64{1, 2}, conf.AdminNotify.Telegram.Receivers)
+	})
+
+	t.Run("Fields hidden from JSON", func(t *testing.T) {
+		conf := &Config{Email: EmailConf{MailSubjectToAdmin: "legacy"}, Captcha: CaptchaConf{ActionTimeout: 60}}
+		merged, err := conf.MergeOverlay(`{"email": {"mail_tpl": "site"}, "captcha": {"always": true}}`)
+		require.NoError(t, err)
+		assert.Equal(t, "legacy", merged.Email.MailSubjectToAdmin)
+		assert.Equal(t, 60, merged.Captcha.ActionTimeout)
+	})
+
+	t.Run("Image upload", func(t *testing.T) {
+		conf := &Config{ImgUpload: ImgUploadConf{Enabled: false, Path: "./data/artalk-img/", MaxSize: 5}}
+		merged, err := conf.MergeOverlay(`{"img_upload": {"enabled": true, "max_size": 1}}`)
+		require.NoError(t, err)
+		assert.True(t, merged.ImgUpload.Enabled)
+		assert.Equal(t, int64(1), merged.ImgUpload.MaxSize)
+		assert.Equal(t, "./data/artalk-img/", merged.ImgUpload.Path)
+
+		// the options which touch the host are global only
+		for _, overlay := range []string{
+			`{"img_upload": {"path": "/etc"}}`,
+			`{"img_upload": {"upgit": {"enabled": true, "exec": "rm -rf /"}}}`,
+			`{"img_upload": {"public_path": "https://evil.com"}}`,
+		} {
+			_, err := conf.MergeOverlay(overlay)
+			assert.Error(t, err, overlay)
+		}
+	})
+
+	t.Run("Empty overlay", func(t *testing.T) {
+		merged, err := conf.MergeOverlay("")
+		require.NoError(t, err)
+		assert.Equal(t, *conf, *merged)
+	})
+
+	t.Run("Not allowed paths", func(t *testing.T) {
+		for _, overlay := range []string{
+			`{"app_key": "hack"}`,
+			`{"email": {"smtp": {"host": "evil.com"}}}`,
+			`{"email": "site"}`,
+			`{"moderator": true}`,
+			`not json`,
+		} {
+			_, err := conf.MergeOverlay(overlay)
+			assert.Error(t, err, overlay)
+		}
+	})
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/artalkjs/artalk/v2/internal/config"
 	"github.com/artalkjs/artalk/v2/internal/dao"
 	"github.com/artalkjs/artalk/v2/internal/db"
+	"github.com/artalkjs/artalk/v2/internal/entity"
 	"github.com/artalkjs/artalk/v2/internal/hook"
 	"github.com/artalkjs/artalk/v2/internal/i18n"
 	"github.com/artalkjs/artalk/v2/internal/log"
@@ -25,6 +26,17 @@ type App struct {
 
 	onTerminate   *hook.Hook[*TerminateEvent]
 	onConfUpdated *hook.Hook[*ConfUpdatedEvent]
+
+	onCommentCreated  *hook.Hook[*CommentCreatedEvent]
+	onCommentUpdated  *hook.Hook[*CommentUpdatedEvent]
+	onCommentApproved *hook.Hook[*CommentApprovedEvent]
+	onCommentDeleted  *hook.Hook[*CommentDeletedEvent]
+	onVoteCreated     *hook.Hook[*VoteCreatedEvent]
+	onPageCreated     *hook.Hook[*PageCreatedEvent]
+	onSiteDeleted     *hook.Hook[*SiteDeletedEvent]
+	onUserRegistered  *hook.Hook[*UserRegisteredEvent]
+	onUserLogin       *hook.Hook[*UserLoginEvent]
+	onNotify          *hook.Hook[*NotifyEvent]
 }
 
 func NewApp(conf *config.Config) *App {
@@ -33,6 +45,17 @@ func NewApp(conf *config.Config) *App {
 		service:       &map[string]Service{},
 		onTerminate:   &hook.Hook[*TerminateEvent]{},
 		onConfUpdated: &hook.Hook[*ConfUpdatedEvent]{},
+
+		onCommentCreated:  &hook.Hook[*CommentCreatedEvent]{},
+		onCommentUpdated:  &hook.Hook[*CommentUpdatedEvent]{},
+		onCommentApproved: &hook.Hook[*CommentApprovedEvent]{},
+		onCommentDeleted:  &hook.Hook[*CommentDeletedEvent]{},
+		onVoteCreated:     &hook.Hook[*VoteCreatedEvent]{},
+		onPageCreated:     &hook.Hook[*PageCreatedEvent]{},
+		onSiteDeleted:     &hook.Hook[*SiteDeletedEvent]{},
+		onUserRegistered:  &hook.Hook[*UserRegisteredEvent]{},
+		onUserLogin:       &hook.Hook[*UserLoginEvent]{},
+		onNotify:          &hook.Hook[*NotifyEvent]{},
 	}
 
 	app.injectDefaultServices()
@@ -44,16 +67,12 @@ func NewApp(conf *config.Config) *App {
 func (app *App) injectDefaultServices() {
 	// Please do not depend on the order of dependency injection
 	AppInject(app, NewEmailService(app))
+	AppInject(app, NewEmailDigestService(app))
 	AppInject(app, NewIPRegionService(app))
 	AppInject(app, NewNotifyService(app))
 	AppInject(app, NewAntiSpamService(app))
-}
-
-func (app *App) registerDefaultHooks() {
-	app.OnTerminate().Add(func(e *TerminateEvent) error {
-		app.ResetBootstrapState()
-		return nil
-	})
+	AppInject(app, NewTrashService(app))
+	AppInject(app, NewWebhookService(app))
 }
 
 var mutex = sync.Mutex{}
@@ -203,6 +222,17 @@ func (app *App) Dao() *dao.Dao {
 
 func (app *App) SetDao(dao *dao.Dao) {
 	app.dao = dao
+
+	// bridge the hooks of the dao to the app
+	dao.OnPageCreated().Add(func(page *entity.Page) error {
+		return app.OnPageCreated().Trigger(&PageCreatedEvent{App: app, Page: page})
+	})
+	dao.OnCommentDeleted().Add(func(comment *entity.Comment) error {
+		return app.OnCommentDeleted().Trigger(&CommentDeletedEvent{App: app, Comment: comment})
+	})
+	dao.OnSiteDeleted().Add(func(site *entity.Site) error {
+		return app.OnSiteDeleted().Trigger(&SiteDeletedEvent{App: app, Site: site})
+	})
 }
 
 func (app *App) Cache() *cache.Cache {
@@ -289,3 +319,43 @@ func (app *App) OnTerminate() *hook.Hook[*TerminateEvent] {
 func (app *App) OnConfUpdated() *hook.Hook[*ConfUpdatedEvent] {
 	return app.onConfUpdated
 }
+
+func (app *App) OnCommentCreated() *hook.Hook[*CommentCreatedEvent] {
+	return app.onCommentCreated
+}
+
+func (app *App) OnCommentUpdated() *hook.Hook[*CommentUpdatedEvent] {
+	return app.onCommentUpdated
+}
+
+func (app *App) OnCommentApproved() *hook.Hook[*CommentApprovedEvent] {
+	return app.onCommentApproved
+}
+
+func (app *App) OnCommentDeleted() *hook.Hook[*CommentDeletedEvent] {
+	return app.onCommentDeleted
+}
+
+func (app *App) OnVoteCreated() *hook.Hook[*VoteCreatedEvent] {
+	return app.onVoteCreated
+}
+
+func (app *App) OnPageCreated() *hook.Hook[*PageCreatedEvent] {
+	return app.onPageCreated
+}
+
+func (app *App) OnSiteDeleted() *hook.Hook[*SiteDeletedEvent] {
+	return app.onSiteDeleted
+}
+
+func (app *App) OnUserRegistered() *hook.Hook[*UserRegisteredEvent] {
+	return app.onUserRegistered
+}
+
+func (app *App) OnUserLogin() *hook.Hook[*UserLoginEvent] {
+	return app.onUserLogin
+}
+
+func (app *App) OnNotify() *hook.Hook[*NotifyEvent] {
+	return app.onNotify
+}
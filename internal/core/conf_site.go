@@ -0,0 +1,90 @@
+package core
+
+import (
+	"sync"
+
+	"github.com/artalkjs/artalk/v2/internal/config"
+	"github.com/artalkjs/artalk/v2/internal/log"
+)
+
+// Get the config of the site
+//
+// The site config overlay is merged over the global config,
+// and the global config is returned if the site has no overlay or the overlay is invalid.
+func (app *App) SiteConf(siteName string) *config.Config {
+	if siteName == "" {
+		return app.Conf()
+	}
+
+	overlay := app.Dao().FindSite(siteName).ConfigOverlay
+	if overlay == "" {
+		return app.Conf()
+	}
+
+	conf, err := app.Conf().MergeOverlay(overlay)
+	if err != nil {
+		log.Warn("[SiteConf] Site ", siteName, " config overlay is invalid: ", err)
+		return app.Conf()
+	}
+
+	return conf
+}
+
+// The instances created with the site config
+//
+// They are cached by the site config overlay, so the sites without overlay share the same instance,
+// and a new instance is created after the overlay of the site is modified.
+type siteInstances[T any] struct {
+	mu        sync.Mutex
+	instances map[string]T
+	create    func(conf *config.Config) T
+}
+
+func newSiteInstances[T any](create func(conf *config.Config) T) *siteInstances[T] {
+	return &siteInstances[T]{
+		instances: map[string]T{},
+		create:    create,
+	}
+}
+
+// Get the instance for the site (empty site name means the global one)
+func (s *siteInstances[T]) Get(app *App, siteName string) T {
+	overlay := ""
+	if siteName != "" {
+		overlay = app.Dao().FindSite(siteName).ConfigOverlay
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if instance, ok := s.instances[overlay]; ok {
+		return instance
+	}
+
+	conf := app.Conf()
+	if overlay != "" {
+		conf = app.SiteConf(siteName)
+	}
+
+	instance := s.create(conf)
+	s.instances[overlay] = instance
+	return instance
+}
+
+// Remove the instances of the overlays which are not used by any site any more
+// (e.g. the site is deleted or its overlay is modified)
+func (s *siteInstances[T]) Prune(app *App) {
+	used := map[string]bool{"": true}
+	for _, site := range app.Dao().FindAllSites() {
+		used[site.ConfigOverlay] = true
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for overlay := range s.instances {
+		if !used[overlay] {
+			delete(s.instances, overlay)
+		}
+	}
+}
@@ -1,6 +1,9 @@
 package core
 
-import "github.com/artalkjs/artalk/v2/internal/config"
+import (
+	"github.com/artalkjs/artalk/v2/internal/config"
+	"github.com/artalkjs/artalk/v2/internal/entity"
+)
 
 // -------------------------------------------------------------------
 // Event data
@@ -18,3 +21,79 @@ type ConfUpdatedEvent struct {
 	App  *App
 	Conf *config.Config
 }
+
+// The comment is created (triggered in background)
+//
+// The handlers may modify the comment, and the following handlers are skipped
+// if `hook.ErrStopPropagation` is returned (e.g. the comment is blocked by the anti-spam check).
+type CommentCreatedEvent struct {
+	App           *App
+	Comment       *entity.Comment
+	ParentComment *entity.Comment // Empty if it is a root comment
+	Page          *entity.Page
+	ReqIP         string
+	ReqUserAgent  string
+	ReqReferer    string
+	IsAdmin       bool
+}
+
+// The comment is updated by the moderators
+type CommentUpdatedEvent struct {
+	App         *App
+	Comment     *entity.Comment
+	IsModerated bool // The pending status is changed
+}
+
+// The pending comment is approved by the moderators
+type CommentApprovedEvent struct {
+	App     *App
+	Comment *entity.Comment
+}
+
+// The comment is moved into the trash by the moderators, the anti-spam rules or its author
+//
+// The replies moved into the trash along are not included.
+type CommentDeletedEvent struct {
+	App     *App
+	Comment *entity.Comment
+}
+
+// The vote or the reaction is created
+type VoteCreatedEvent struct {
+	App      *App
+	Vote     *entity.Vote
+	SiteName string
+}
+
+// The page is created
+type PageCreatedEvent struct {
+	App  *App
+	Page *entity.Page
+}
+
+// The site is moved into the trash
+type SiteDeletedEvent struct {
+	App  *App
+	Site *entity.Site
+}
+
+// The user is registered by email, or signs in with a social account for the first time
+type UserRegisteredEvent struct {
+	App  *App
+	User *entity.User
+}
+
+// The user logs in
+type UserLoginEvent struct {
+	App  *App
+	User *entity.User
+}
+
+// The notifies of the comment are going to be pushed
+//
+// Return `hook.ErrStopPropagation` to skip pushing.
+type NotifyEvent struct {
+	App           *App
+	Comment       *entity.Comment
+	ParentComment *entity.Comment
+}
@@ -0,0 +1,64 @@
+package core
+
+import (
+	"github.com/artalkjs/artalk/v2/internal/hook"
+	"github.com/artalkjs/artalk/v2/internal/log"
+)
+
+// Register the default handlers of the events
+//
+// The handlers of the services look up the service when the event is triggered,
+// so they work with the services injected by the embedders.
+func (app *App) registerDefaultHooks() {
+	app.OnTerminate().Add(func(e *TerminateEvent) error {
+		app.ResetBootstrapState()
+		return nil
+	})
+
+	// The jobs after the comment created (in order):
+	//  1. Page Update
+	//  2. AntiSpam Check (the following jobs are skipped if the comment is blocked)
+	//  3. Send Notify (email, webhook, telegram, etc.)
+	//  4. Send Webhook Event
+	app.OnCommentCreated().Add(func(e *CommentCreatedEvent) error {
+		// if the original page title is empty and the URL is given
+		if e.Page == nil || e.Page.Title != "" {
+			return nil
+		}
+		if page := *e.Page; app.Dao().CookPage(&page).URL != "" {
+			go app.Dao().FetchPageFromURL(&page)
+		}
+		return nil
+	})
+	app.OnCommentCreated().Add(serviceHandler(app, (*AntiSpamService).onCommentCreated))
+	app.OnCommentCreated().Add(serviceHandler(app, (*NotifyService).onCommentCreated))
+	app.OnCommentCreated().Add(serviceHandler(app, (*WebhookService).onCommentCreated))
+
+	// Learn from the moderation decisions
+	app.OnCommentUpdated().Add(serviceHandler(app, (*AntiSpamService).onCommentUpdated))
+
+	// Send Webhook Events
+	app.OnCommentApproved().Add(serviceHandler(app, (*WebhookService).onCommentApproved))
+	app.OnCommentDeleted().Add(serviceHandler(app, (*WebhookService).onCommentDeleted))
+	app.OnVoteCreated().Add(serviceHandler(app, (*WebhookService).onVoteCreated))
+	app.OnPageCreated().Add(serviceHandler(app, (*WebhookService).onPageCreated))
+	app.OnUserRegistered().Add(serviceHandler(app, (*WebhookService).onUserRegistered))
+
+	// Release the instances created with the config of the deleted sites
+	app.OnSiteDeleted().Add(serviceHandler(app, (*NotifyService).onSiteDeleted))
+	app.OnSiteDeleted().Add(serviceHandler(app, (*AntiSpamService).onSiteDeleted))
+}
+
+// Create the hook handler which calls the method of the service
+//
+// The error of looking up the service is logged and skipped, so the other handlers still run.
+func serviceHandler[S Service, T any](app *App, method func(s S, e T) error) hook.Handler[T] {
+	return func(e T) error {
+		s, err := AppService[S](app)
+		if err != nil {
+			log.Error("[Hook] ", err)
+			return nil
+		}
+		return method(s, e)
+	}
+}
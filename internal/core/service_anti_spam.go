@@ -3,16 +3,23 @@ package core
 import (
 	"fmt"
 	"net/url"
+	"time"
 
 	"github.com/artalkjs/artalk/v2/internal/anti_spam"
+	"github.com/artalkjs/artalk/v2/internal/config"
+	"github.com/artalkjs/artalk/v2/internal/dao"
 	"github.com/artalkjs/artalk/v2/internal/entity"
+	"github.com/artalkjs/artalk/v2/internal/hook"
+	"github.com/artalkjs/artalk/v2/internal/log"
+	"github.com/samber/lo"
+	"gorm.io/gorm"
 )
 
 var _ Service = (*AntiSpamService)(nil)
 
 type AntiSpamService struct {
-	app    *App
-	client *anti_spam.AntiSpam
+	app     *App
+	clients *siteInstances[*anti_spam.AntiSpam]
 }
 
 func NewAntiSpamService(app *App) *AntiSpamService {
@@ -20,12 +27,21 @@ func NewAntiSpamService(app *App) *AntiSpamService {
 }
 
 func (s *AntiSpamService) Init() error {
-	s.client = anti_spam.NewAntiSpam(&anti_spam.AntiSpamConf{
-		ModeratorConf: s.app.Conf().Moderator,
+	// the client is created with the config of the site (the moderator options can be overridden by site)
+	s.clients = newSiteInstances(func(conf *config.Config) *anti_spam.AntiSpam {
+		return s.newClient(conf)
+	})
+
+	return nil
+}
+
+func (s *AntiSpamService) newClient(conf *config.Config) *anti_spam.AntiSpam {
+	return anti_spam.NewAntiSpam(&anti_spam.AntiSpamConf{
+		ModeratorConf: conf.Moderator,
 		OnBlockComment: func(commentID uint) {
 			comment := s.app.dao.FindComment(commentID)
-			if comment.IsPending {
-				return // no need to block again
+			if comment.IsEmpty() || comment.IsPending {
+				return // no need to block again (or the comment had been moved into the trash)
 			}
 
 			// update comment status
@@ -37,19 +53,151 @@ func (s *AntiSpamService) Init() error {
 			comment.Content = content
 			s.app.dao.UpdateComment(&comment)
 		},
-	})
+		OnRejectComment: func(commentID uint) {
+			comment := s.app.dao.FindComment(commentID)
+			if comment.IsEmpty() {
+				return
+			}
 
-	return nil
+			// move comment into the trash
+			s.app.dao.DelComment(&comment)
+
+			if err := s.app.dao.SaveCommentModeration(&comment, entity.CommentModerationRejected); err != nil {
+				log.Error("[AntiSpam] Save the moderation decision failed: ", err)
+			}
+		},
+		CountRepeatedComments: func(p *anti_spam.CheckerParams, since time.Time) int64 {
+			return s.app.dao.CountRepeatedComments(p.Content, p.UserID, p.UserIP, since, p.CommentID)
+		},
+		BayesModel: &bayesModel{dao: s.app.dao},
+		OnVerdict: func(p *anti_spam.CheckerParams, result *anti_spam.CheckResult) {
+			if err := s.app.dao.SaveAntiSpamVerdict(&entity.AntiSpamVerdict{
+				CommentID: p.CommentID,
+				Pass:      result.Pass,
+				Checker:   result.Checker,
+				Score:     result.Score,
+				Reason:    result.Reason,
+				Matched:   result.Matched,
+				Response:  result.Response,
+				UserIP:    p.UserIP,
+				UserAgent: p.UserAgent,
+				Referrer:  p.Referrer,
+			}); err != nil {
+				log.Error("[AntiSpamService] save verdict err: ", err)
+			}
+		},
+	})
 }
 
 func (s *AntiSpamService) Dispose() error {
-	s.client = nil
+	s.clients = nil
+
+	return nil
+}
 
+func (s *AntiSpamService) onSiteDeleted(e *SiteDeletedEvent) error {
+	s.clients.Prune(s.app)
 	return nil
 }
 
 func (s *AntiSpamService) CheckAndBlock(data *AntiSpamCheckPayload) {
-	s.client.CheckAndBlock(s.payload2CheckerParams(data))
+	s.clients.Get(s.app, data.Comment.SiteName).CheckAndBlock(s.payload2CheckerParams(data))
+}
+
+// Report the moderation decision of the admin to the anti-spam services (e.g. Akismet)
+//
+// The comment is reported as spam if it is pending, otherwise as ham.
+// It is only reported when the decision differs from the stored verdict (or the last reported decision), and
+// the request metadata stored at check time is sent alongside. The stored verdict is kept as it was checked.
+func (s *AntiSpamService) Feedback(comment *entity.Comment) error {
+	verdict := s.app.dao.FindAntiSpamVerdict(comment.ID)
+	if verdict.IsEmpty() {
+		return nil
+	}
+
+	feedback := lo.If(comment.IsPending, "spam").Else("ham")
+	if verdict.Feedback == feedback {
+		return nil // already reported
+	}
+
+	params := s.payload2CheckerParams(&AntiSpamCheckPayload{
+		Comment:      comment,
+		ReqReferer:   verdict.Referrer,
+		ReqIP:        verdict.UserIP,
+		ReqUserAgent: verdict.UserAgent,
+	})
+	// the later decision is compared with the reported one
+	pass := verdict.Pass
+	if verdict.Feedback != "" {
+		pass = verdict.Feedback == "ham"
+	}
+	reported := s.clients.Get(s.app, comment.SiteName).Feedback(params, &anti_spam.CheckResult{
+		Checker: verdict.Checker,
+		Pass:    pass,
+	}, comment.IsPending)
+	if len(reported) == 0 {
+		return nil
+	}
+
+	verdict.Feedback = feedback
+	return s.app.dao.SaveAntiSpamVerdict(&verdict)
+}
+
+// Train the naive Bayes classifier with the moderation decision of the admin
+//
+// The comment is trained as spam if it is pending, otherwise as ham.
+func (s *AntiSpamService) Train(comment *entity.Comment) error {
+	if !s.app.SiteConf(comment.SiteName).Moderator.Bayes.Enabled {
+		return nil
+	}
+	return s.app.dao.TrainBayes(comment.ID, anti_spam.BayesTokenize(comment.Content), comment.IsPending)
+}
+
+// Train the naive Bayes classifier from the history of the comments (the pending ones are trained as spam)
+//
+// The comments which have been trained are skipped, unless the classifier is reset.
+func (s *AntiSpamService) TrainFromHistory(reset bool) (spam int, ham int, err error) {
+	if reset {
+		if err := s.app.dao.ResetBayes(); err != nil {
+			return 0, 0, err
+		}
+	}
+
+	var comments []entity.Comment
+	err = s.app.dao.DB().Model(&entity.Comment{}).Order("id ASC").FindInBatches(&comments, 500, func(tx *gorm.DB, batch int) error {
+		for _, c := range comments {
+			if !s.app.dao.FindBayesDocument(c.ID).IsEmpty() {
+				continue
+			}
+			if err := s.app.dao.TrainBayes(c.ID, anti_spam.BayesTokenize(c.Content), c.IsPending); err != nil {
+				return err
+			}
+			if c.IsPending {
+				spam++
+			} else {
+				ham++
+			}
+		}
+		return nil
+	}).Error
+
+	return spam, ham, err
+}
+
+// The naive Bayes model stored in the database
+type bayesModel struct {
+	dao *dao.Dao
+}
+
+func (m *bayesModel) GetCounts(tokens []string) map[string]anti_spam.BayesCount {
+	return lo.MapValues(m.dao.GetBayesTokens(tokens), func(t entity.BayesToken, _ string) anti_spam.BayesCount {
+		return anti_spam.BayesCount{Spam: t.Spam, Ham: t.Ham}
+	})
+}
+
+func (m *bayesModel) GetTotal() anti_spam.BayesCount {
+	total := m.dao.GetBayesTokens(nil)[entity.BayesTotalToken]
+	return anti_spam.BayesCount{Spam: total.Spam, Ham: total.Ham}
 }
 
 // Payload for CheckAndBlock function
@@ -90,5 +238,57 @@ func (s *AntiSpamService) payload2CheckerParams(payload *AntiSpamCheckPayload) *
 		UserID:    user.ID,
 		UserIP:    payload.ReqIP,
 		UserAgent: payload.ReqUserAgent,
+		Referrer:  payload.ReqReferer,
+
+		UserLink:             user.Link,
+		UserCreatedAt:        user.CreatedAt,
+		UserApprovedComments: s.app.dao.CountUserApprovedComments(user.ID, payload.Comment.ID),
+	}
+}
+
+// Check the created comment (the following handlers are skipped if the comment is removed)
+func (s *AntiSpamService) onCommentCreated(e *CommentCreatedEvent) error {
+	if e.IsAdmin { // if the user is an admin, skip the anti-spam check
+		return nil
+	}
+
+	// The check and block is sync, if comment is blocked, the message will not be sent
+	s.CheckAndBlock(&AntiSpamCheckPayload{
+		Comment:      e.Comment,
+		ReqReferer:   e.ReqReferer,
+		ReqIP:        e.ReqIP,
+		ReqUserAgent: e.ReqUserAgent,
+	})
+
+	// Reload the comment which may be blocked, modified or moved into the trash
+	comment := s.app.Dao().FindComment(e.Comment.ID)
+	if comment.IsEmpty() {
+		return hook.ErrStopPropagation
+	}
+	*e.Comment = comment
+
+	return nil
+}
+
+// Train the spam classifier with the moderation decision, and report it to the anti-spam services
+func (s *AntiSpamService) onCommentUpdated(e *CommentUpdatedEvent) error {
+	if !e.IsModerated {
+		return nil
 	}
+
+	if err := s.Train(e.Comment); err != nil {
+		log.Error("[AntiSpamService] train err: ", err)
+	}
+
+	// Report the decision to the anti-spam services which checked the comment (it may take a while)
+	if s.app.Dao().FindAntiSpamVerdict(e.Comment.ID).IsEmpty() {
+		return nil
+	}
+	go func(comment entity.Comment) {
+		if err := s.Feedback(&comment); err != nil {
+			log.Error("[AntiSpamService] feedback err: ", err)
+		}
+	}(*e.Comment)
+
+	return nil
 }
@@ -3,6 +3,8 @@ package core
 import (
 	"time"
 
+	"github.com/artalkjs/artalk/v2/internal/config"
+	"github.com/artalkjs/artalk/v2/internal/dao"
 	"github.com/artalkjs/artalk/v2/internal/email"
 	"github.com/artalkjs/artalk/v2/internal/entity"
 	"github.com/artalkjs/artalk/v2/internal/log"
@@ -11,9 +13,13 @@ import (
 
 var _ Service = (*EmailService)(nil)
 
+// The interval to check the expired email tasks
+const emailPurgeInterval = time.Hour
+
 type EmailService struct {
 	app   *App
 	queue *email.EmailQueue
+	stop  chan struct{}
 }
 
 func NewEmailService(app *App) *EmailService {
@@ -23,6 +29,7 @@ func NewEmailService(app *App) *EmailService {
 func (e *EmailService) Init() error {
 	e.queue = email.NewQueue(email.EmailConf{
 		EmailConf: e.app.Conf().Email,
+		Dao:       e.app.Dao(),
 		OnSendSuccess: func(email *email.Email) {
 			if email.LinkedNotify == nil {
 				log.Debug("email.LinkedNotify is null")
@@ -35,6 +42,11 @@ func (e *EmailService) Init() error {
 		},
 	})
 
+	if retentionDays := e.app.Conf().Email.Queue.RetentionDays; retentionDays > 0 {
+		e.stop = make(chan struct{})
+		go e.runAutoPurge(e.app.Dao(), retentionDays, e.stop)
+	}
+
 	return nil
 }
 
@@ -42,20 +54,52 @@ func (e *EmailService) Dispose() error {
 	if e.queue != nil {
 		e.queue.Close()
 	}
+	if e.stop != nil {
+		close(e.stop)
+		e.stop = nil
+	}
 
 	return nil
 }
 
+// Delete the sent and failed email tasks kept over the retention days
+func (e *EmailService) runAutoPurge(d *dao.Dao, retentionDays int, stop chan struct{}) {
+	ticker := time.NewTicker(emailPurgeInterval)
+	defer ticker.Stop()
+
+	for {
+		before := time.Now().AddDate(0, 0, -retentionDays)
+		if err := d.PurgeEmailTasks(before); err != nil {
+			log.Error("[EmailService] auto purge err: ", err)
+		}
+
+		select {
+		case <-ticker.C:
+		case <-stop:
+			return
+		}
+	}
+}
+
+// Send the email in the queue again (e.g. the failed one)
+func (e *EmailService) Resend(task *entity.EmailTask) error {
+	return e.queue.Resend(task)
+}
+
 func (e *EmailService) GetRenderer(useAdminTplParam ...bool) *template.Renderer {
 	useAdminTpl := false
 	if len(useAdminTplParam) > 0 {
 		useAdminTpl = useAdminTplParam[0]
 	}
 
-	mailTplName := e.app.Conf().Email.MailTpl
+	return e.getRenderer(e.app.Conf(), useAdminTpl)
+}
+
+func (e *EmailService) getRenderer(conf *config.Config, useAdminTpl bool) *template.Renderer {
+	mailTplName := conf.Email.MailTpl
 
 	// 发送给管理员的邮件单独使用管理员邮件模板
-	adminTpl := e.app.Conf().AdminNotify.Email.MailTpl
+	adminTpl := conf.AdminNotify.Email.MailTpl
 	if useAdminTpl && adminTpl != "" {
 		mailTplName = adminTpl
 	}
@@ -83,16 +127,26 @@ func (e *EmailService) AsyncSend(notify *entity.Notify) {
 		return
 	}
 
+	// the email options can be overridden by the site of the comment
+	conf := e.app.SiteConf(e.app.Dao().FetchCommentForNotify(notify).SiteName)
+
 	receiveUser := e.app.Dao().FetchUserForNotify(notify)
-	renderer := e.GetRenderer(receiveUser.IsAdmin)
+
+	// the notify is sent in the summary email later if the receiver chooses the digest
+	if digestService, err := AppService[*EmailDigestService](e.app); err == nil &&
+		digestService.Frequency(&receiveUser) != entity.EmailDigestImmediate {
+		return
+	}
+
+	renderer := e.getRenderer(conf, receiveUser.IsAdmin)
 
 	// render email body
 	mailBody := renderer.Render(notify)
 	mailSubject := ""
 	if !receiveUser.IsAdmin {
-		mailSubject = renderer.Render(notify, e.app.Conf().Email.MailSubject)
+		mailSubject = renderer.Render(notify, conf.Email.MailSubject)
 	} else {
-		mailSubject = renderer.Render(notify, e.app.Conf().AdminNotify.Email.MailSubject)
+		mailSubject = renderer.Render(notify, conf.AdminNotify.Email.MailSubject)
 	}
 
 	log.Debug(time.Now(), " "+receiveUser.Email)
@@ -100,7 +154,7 @@ func (e *EmailService) AsyncSend(notify *entity.Notify) {
 	// add email send task to queue
 	e.queue.Push(&email.Email{
 		FromAddr:     e.app.Conf().Email.SendAddr,
-		FromName:     renderer.Render(notify, e.app.Conf().Email.SendName),
+		FromName:     renderer.Render(notify, conf.Email.SendName),
 		ToAddr:       receiveUser.Email,
 		Subject:      mailSubject,
 		Body:         mailBody,
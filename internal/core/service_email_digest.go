@@ -0,0 +1,194 @@
+package core
+
+import (
+	"cmp"
+	"sync"
+	"time"
+
+	"github.com/artalkjs/artalk/v2/internal/entity"
+	"github.com/artalkjs/artalk/v2/internal/log"
+	"github.com/artalkjs/artalk/v2/internal/template"
+	"github.com/samber/lo"
+)
+
+var _ Service = (*EmailDigestService)(nil)
+
+// The service to send the summary emails of the unread notifies
+// to the users who choose the daily or weekly digest (see `config.EmailDigestConf`)
+type EmailDigestService struct {
+	app   *App
+	mux   sync.Mutex
+	timer *time.Timer
+}
+
+func NewEmailDigestService(app *App) *EmailDigestService {
+	return &EmailDigestService{app: app}
+}
+
+func (s *EmailDigestService) Init() error {
+	s.stop()
+
+	if !s.app.Conf().Email.Enabled {
+		return nil
+	}
+
+	s.schedule()
+
+	return nil
+}
+
+func (s *EmailDigestService) Dispose() error {
+	s.stop()
+
+	return nil
+}
+
+// The frequency of the notification emails received by the user
+//
+// The config default of the admins (including the site admins and moderators)
+// or the users is used if the user has not chosen one.
+func (s *EmailDigestService) Frequency(user *entity.User) entity.EmailDigestFrequency {
+	conf := s.app.Conf()
+
+	frequency := user.EmailDigest
+	if frequency == "" {
+		frequency = entity.EmailDigestFrequency(lo.Ternary(s.isSiteManager(user), conf.AdminNotify.Email.Digest, conf.Email.Digest.Frequency))
+	}
+	if !frequency.IsValid() {
+		return entity.EmailDigestImmediate
+	}
+
+	return frequency
+}
+
+// The next sending time after the time (in the timezone of the digest)
+func (s *EmailDigestService) NextRunAt(after time.Time) time.Time {
+	after = after.In(s.location())
+
+	at := time.Date(after.Year(), after.Month(), after.Day(), s.app.Conf().Email.Digest.Hour, 0, 0, 0, after.Location())
+	if !at.After(after) {
+		at = at.AddDate(0, 0, 1)
+	}
+
+	return at
+}
+
+// Send the digests which are due at the time
+//
+// The daily digests are sent every time, and the weekly ones are sent on the weekday of the config.
+func (s *EmailDigestService) Run(at time.Time) {
+	at = at.In(s.location())
+
+	s.runDigests(entity.EmailDigestDaily, at, at.AddDate(0, 0, -1))
+	if int(at.Weekday()) == s.app.Conf().Email.Digest.Weekday {
+		s.runDigests(entity.EmailDigestWeekly, at, at.AddDate(0, 0, -7))
+	}
+}
+
+// Send the digests of the frequency which contain the notifies since the last run, and record this run
+//
+// The period before the time is summarized if it is the first run.
+func (s *EmailDigestService) runDigests(frequency entity.EmailDigestFrequency, at time.Time, firstSince time.Time) {
+	run := s.app.Dao().FindEmailDigestRun(frequency)
+
+	since := firstSince
+	if !run.IsEmpty() {
+		since = run.RanAt
+	}
+
+	s.sendDigests(frequency, since)
+
+	run.Frequency = frequency
+	run.RanAt = at
+	if err := s.app.Dao().SaveEmailDigestRun(&run); err != nil {
+		log.Error("[EmailDigest] Save the run failed: ", err)
+	}
+}
+
+// Send a summary email to each user of the frequency,
+// which contains the notifies received since the time
+func (s *EmailDigestService) sendDigests(frequency entity.EmailDigestFrequency, since time.Time) {
+	emailService, err := AppService[*EmailService](s.app)
+	if err != nil {
+		log.Error("[EmailDigest] ", err)
+		return
+	}
+
+	conf := s.app.Conf()
+	renderer := template.NewRenderer(s.app.Dao(), template.TYPE_DIGEST, template.NewFileLoader(conf.Email.Digest.MailTpl))
+	// the digests of the site managers contain the notifies of the new comments, not only the replies
+	adminRenderer := template.NewRenderer(s.app.Dao(), template.TYPE_DIGEST, template.NewFileLoader(cmp.Or(conf.AdminNotify.Email.DigestMailTpl, "admin")))
+
+	notifiesByUser := lo.GroupBy(s.app.Dao().FindDigestNotifies(since), func(n entity.Notify) uint { return n.UserID })
+	for userID, notifies := range notifiesByUser {
+		user := s.app.Dao().FindUserByID(userID)
+		if user.IsEmpty() || !user.ReceiveEmail || user.Email == "" || s.Frequency(&user) != frequency {
+			continue
+		}
+
+		if s.isSiteManager(&user) {
+			emailService.AsyncSendTo(adminRenderer.RenderDigest(notifies, conf.AdminNotify.Email.DigestMailSubject), adminRenderer.RenderDigest(notifies), user.Email)
+		} else {
+			emailService.AsyncSendTo(renderer.RenderDigest(notifies, conf.Email.Digest.MailSubject), renderer.RenderDigest(notifies), user.Email)
+		}
+
+		// the email is kept in the queue and retried if failed, so the notifies are not summarized again
+		for _, notify := range notifies {
+			if err := s.app.Dao().NotifySetEmailed(&notify); err != nil {
+				log.Error("[EmailDigest] Flag the notify as emailed failed: ", err)
+			}
+		}
+	}
+}
+
+// Wait for the next sending time, and then schedule the next one after sent
+func (s *EmailDigestService) schedule() {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	at := s.NextRunAt(time.Now())
+
+	var timer *time.Timer
+	timer = time.AfterFunc(time.Until(at), func() {
+		s.Run(at)
+
+		s.mux.Lock()
+		isStopped := s.timer != timer
+		s.mux.Unlock()
+
+		if !isStopped {
+			s.schedule()
+		}
+	})
+	s.timer = timer
+}
+
+func (s *EmailDigestService) stop() {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	if s.timer != nil {
+		s.timer.Stop()
+		s.timer = nil
+	}
+}
+
+// The global admins, and the site admins and moderators (see `dao.GetSiteManagers`)
+func (s *EmailDigestService) isSiteManager(user *entity.User) bool {
+	return user.IsAdmin || len(s.app.Dao().GetUserRoleSiteNames(user.ID)) > 0
+}
+
+func (s *EmailDigestService) location() *time.Location {
+	timezone := cmp.Or(s.app.Conf().Email.Digest.TimeZone, s.app.Conf().TimeZone)
+	if timezone == "" {
+		return time.Local
+	}
+
+	location, err := time.LoadLocation(timezone)
+	if err != nil {
+		log.Error("[EmailDigest] Load the timezone failed: ", err)
+		return time.Local
+	}
+
+	return location
+}
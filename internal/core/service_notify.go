@@ -1,15 +1,23 @@
 package core
 
 import (
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/artalkjs/artalk/v2/internal/config"
 	"github.com/artalkjs/artalk/v2/internal/entity"
+	"github.com/artalkjs/artalk/v2/internal/log"
 	"github.com/artalkjs/artalk/v2/internal/notify_pusher"
+	"github.com/artalkjs/artalk/v2/internal/utils"
+	"github.com/samber/lo"
 )
 
 var _ Service = (*NotifyService)(nil)
 
 type NotifyService struct {
-	app    *App
-	pusher *notify_pusher.NotifyPusher
+	app     *App
+	pushers *siteInstances[*notify_pusher.NotifyPusher]
 }
 
 func NewNotifyService(app *App) *NotifyService {
@@ -17,29 +25,152 @@ func NewNotifyService(app *App) *NotifyService {
 }
 
 func (s *NotifyService) Init() error {
-	s.pusher = notify_pusher.NewNotifyPusher(&notify_pusher.NotifyPusherConf{
-		AdminNotifyConf: s.app.Conf().AdminNotify,
-		Dao:             s.app.Dao(),
-		EmailPush: func(notify *entity.Notify) error {
-			emailService, err := AppService[*EmailService](s.app)
-			if err != nil {
-				return err
-			}
-			emailService.AsyncSend(notify)
-			return nil
-		},
+	// the pusher is created with the config of the site (the admin notify options can be overridden by site)
+	s.pushers = newSiteInstances(func(conf *config.Config) *notify_pusher.NotifyPusher {
+		return notify_pusher.NewNotifyPusher(&notify_pusher.NotifyPusherConf{
+			AdminNotifyConf: conf.AdminNotify,
+			Dao:             s.app.Dao(),
+			EmailPush: func(notify *entity.Notify) error {
+				emailService, err := AppService[*EmailService](s.app)
+				if err != nil {
+					return err
+				}
+				emailService.AsyncSend(notify)
+				return nil
+			},
+			EmailSendTo: func(subject string, body string, toAddr string) error {
+				emailService, err := AppService[*EmailService](s.app)
+				if err != nil {
+					return err
+				}
+				emailService.AsyncSendTo(subject, body, toAddr)
+				return nil
+			},
+		})
 	})
 
 	return nil
 }
 
 func (s *NotifyService) Dispose() error {
-	s.pusher = nil
+	s.pushers = nil
+
+	return nil
+}
 
+func (s *NotifyService) onSiteDeleted(e *SiteDeletedEvent) error {
+	s.pushers.Prune(s.app)
 	return nil
 }
 
 func (s *NotifyService) Push(comment *entity.Comment, pComment *entity.Comment) error {
-	s.pusher.Push(comment, pComment)
+	if s.isShadowBanned(comment) {
+		return nil
+	}
+
+	s.pushers.Get(s.app, comment.SiteName).Push(comment, pComment)
+	return s.PushMentions(comment, pComment)
+}
+
+// Push the notifies of the created comment
+//
+// The handlers of `OnNotify` run before, and pushing is skipped if one of them stops the propagation.
+func (s *NotifyService) onCommentCreated(e *CommentCreatedEvent) error {
+	return s.app.OnNotify().Trigger(&NotifyEvent{
+		App:           s.app,
+		Comment:       e.Comment,
+		ParentComment: e.ParentComment,
+	}, func(e *NotifyEvent) error {
+		if err := s.Push(e.Comment, e.ParentComment); err != nil {
+			log.Error("[NotifyService] notify push err: ", err)
+		}
+		return nil
+	})
+}
+
+// Send notifies to the users mentioned in the comment
+//
+// The users who have been notified of the comment are skipped,
+// so it is safe to call again after the comment is edited.
+func (s *NotifyService) PushMentions(comment *entity.Comment, pComment *entity.Comment) error {
+	if !s.app.Conf().Comment.Mention.Enabled || s.isShadowBanned(comment) {
+		return nil
+	}
+
+	s.pushers.Get(s.app, comment.SiteName).PushMentions(comment, pComment)
 	return nil
 }
+
+// Notify the admins that the comment is reported
+//
+// The notifies are sent in the background (it may take a while).
+func (s *NotifyService) PushReport(comment *entity.Comment, report *entity.CommentReport, reportCount int64, action config.CommentReportAction) {
+	pusher := s.pushers.Get(s.app, comment.SiteName)
+	go pusher.PushReport(comment, report, reportCount, action)
+}
+
+// Deliver the webhook again in the background (without retries), the result is recorded in the delivery
+func (s *NotifyService) RedeliverWebhook(delivery *entity.WebhookDelivery) {
+	s.pushers.Get(s.app, delivery.SiteName).RedeliverWebhook(delivery)
+}
+
+// Check the pending delivery of the WebHook notify is interrupted (e.g. by a restart)
+func (s *NotifyService) IsWebhookDeliveryStale(delivery *entity.WebhookDelivery) bool {
+	return s.pushers.Get(s.app, delivery.SiteName).IsWebhookDeliveryStale(delivery)
+}
+
+// Resolve the `@name` mentions in the comment content to the users
+//
+// The result is set to `comment.MentionUserIDs` (the caller should save the comment).
+// Only the users who have commented on the same site can be mentioned,
+// and the mentions exceeding the limits are ignored.
+func (s *NotifyService) ResolveMentions(comment *entity.Comment) {
+	conf := s.app.Conf().Comment.Mention
+	prevMentions := len(comment.MentionUserIDs)
+	comment.MentionUserIDs = []uint{}
+
+	if !conf.Enabled {
+		return
+	}
+
+	marked, err := utils.Marked(comment.Content)
+	if err != nil {
+		return
+	}
+	names := utils.FindMentions(marked)
+	if len(names) == 0 {
+		return
+	}
+
+	users := s.app.Dao().FindMentionableUsers(comment.SiteName, names)
+	users = lo.Filter(users, func(u entity.User, _ int) bool { return u.ID != comment.UserID })
+
+	// Keep the order of appearance in the content
+	byName := lo.KeyBy(users, func(u entity.User) string { return strings.ToLower(u.Name) })
+	userIDs := []uint{}
+	for _, name := range names {
+		if u, ok := byName[strings.ToLower(name)]; ok && !slices.Contains(userIDs, u.ID) {
+			userIDs = append(userIDs, u.ID)
+		}
+	}
+
+	limit := len(userIDs)
+	if conf.MaxPerComment > 0 {
+		limit = min(limit, conf.MaxPerComment)
+	}
+	if conf.MaxPerDay > 0 {
+		since := time.Now().Add(-24 * time.Hour)
+		used := s.app.Dao().CountUserMentionsSince(comment.UserID, since)
+		if comment.ID != 0 && comment.CreatedAt.After(since) {
+			used -= prevMentions // the comment is being edited, exclude its own previous mentions
+		}
+		limit = min(limit, max(conf.MaxPerDay-used, 0))
+	}
+
+	comment.MentionUserIDs = userIDs[:limit]
+}
+
+// The comments of the shadow-banned users never trigger notifications
+func (s *NotifyService) isShadowBanned(comment *entity.Comment) bool {
+	return s.app.dao.FindUserByID(comment.UserID).IsShadowBanned
+}
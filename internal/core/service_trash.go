@@ -0,0 +1,62 @@
+package core
+
+import (
+	"time"
+
+	"github.com/artalkjs/artalk/v2/internal/dao"
+	"github.com/artalkjs/artalk/v2/internal/log"
+)
+
+var _ Service = (*TrashService)(nil)
+
+// The interval to check the expired items in the trash
+const trashPurgeInterval = time.Hour
+
+type TrashService struct {
+	app  *App
+	stop chan struct{}
+}
+
+func NewTrashService(app *App) *TrashService {
+	return &TrashService{app: app}
+}
+
+func (s *TrashService) Init() error {
+	retentionDays := s.app.Conf().Trash.RetentionDays
+	if retentionDays <= 0 {
+		return nil // keep forever
+	}
+
+	s.stop = make(chan struct{})
+	go s.runAutoPurge(s.app.Dao(), retentionDays, s.stop)
+
+	return nil
+}
+
+func (s *TrashService) Dispose() error {
+	if s.stop != nil {
+		close(s.stop)
+		s.stop = nil
+	}
+
+	return nil
+}
+
+// Purge the items which are kept in the trash over the retention days
+func (s *TrashService) runAutoPurge(d *dao.Dao, retentionDays int, stop chan struct{}) {
+	ticker := time.NewTicker(trashPurgeInterval)
+	defer ticker.Stop()
+
+	for {
+		before := time.Now().AddDate(0, 0, -retentionDays)
+		if err := d.PurgeTrash(before); err != nil {
+			log.Error("[TrashService] auto purge err: ", err)
+		}
+
+		select {
+		case <-ticker.C:
+		case <-stop:
+			return
+		}
+	}
+}
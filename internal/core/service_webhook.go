@@ -0,0 +1,179 @@
+package core
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/artalkjs/artalk/v2/internal/config"
+	"github.com/artalkjs/artalk/v2/internal/dao"
+	"github.com/artalkjs/artalk/v2/internal/entity"
+	"github.com/artalkjs/artalk/v2/internal/log"
+	"github.com/artalkjs/artalk/v2/internal/notify_pusher"
+	"github.com/samber/lo"
+)
+
+var _ Service = (*WebhookService)(nil)
+
+// The interval to check the expired delivery logs
+const webhookPurgeInterval = time.Hour
+
+// The service to send the events to the webhook subscriptions
+type WebhookService struct {
+	app  *App
+	stop chan struct{}
+}
+
+func NewWebhookService(app *App) *WebhookService {
+	return &WebhookService{app: app}
+}
+
+func (s *WebhookService) Init() error {
+	retentionDays := s.app.Conf().Webhooks.RetentionDays
+	if retentionDays <= 0 {
+		return nil // keep forever
+	}
+
+	s.stop = make(chan struct{})
+	go s.runAutoPurge(s.app.Dao(), retentionDays, s.stop)
+
+	return nil
+}
+
+func (s *WebhookService) Dispose() error {
+	if s.stop != nil {
+		close(s.stop)
+		s.stop = nil
+	}
+
+	return nil
+}
+
+// Delete the delivery logs (of the subscriptions and the WebHook notify) kept over the retention days
+func (s *WebhookService) runAutoPurge(d *dao.Dao, retentionDays int, stop chan struct{}) {
+	ticker := time.NewTicker(webhookPurgeInterval)
+	defer ticker.Stop()
+
+	for {
+		before := time.Now().AddDate(0, 0, -retentionDays)
+		if err := d.PurgeWebhookDeliveries(before); err != nil {
+			log.Error("[WebhookService] auto purge err: ", err)
+		}
+
+		select {
+		case <-ticker.C:
+		case <-stop:
+			return
+		}
+	}
+}
+
+// Send the event to the subscriptions which subscribe it
+//
+// The deliveries are recorded before returning, and sent in the background.
+func (s *WebhookService) Emit(event entity.WebhookEvent, siteName string, data entity.WebhookPayloadData) {
+	conf := s.app.Conf().Webhooks
+	subscriptions := lo.Filter(conf.Subscriptions, func(sub config.WebhookSubscriptionConf, _ int) bool {
+		return sub.IsSubscribed(string(event), siteName)
+	})
+	if len(subscriptions) == 0 {
+		return
+	}
+
+	payload, err := json.Marshal(entity.WebhookPayload{
+		Version:  entity.WebhookPayloadVersion,
+		Event:    event,
+		SiteName: siteName,
+		Date:     time.Now().Format(dao.CommonDateTimeFormat),
+		Data:     data,
+	})
+	if err != nil {
+		log.Error("[WebhookService] marshal payload err: ", err)
+		return
+	}
+
+	for _, sub := range subscriptions {
+		delivery := entity.WebhookDelivery{
+			SiteName: siteName,
+			Event:    string(event),
+			URL:      sub.URL,
+			Payload:  string(payload),
+			Status:   entity.WebhookDeliveryStatusPending,
+		}
+		if err := s.app.Dao().SaveWebhookDelivery(&delivery); err != nil {
+			log.Error("[WebhookService] save delivery err: ", err)
+			continue
+		}
+
+		go notify_pusher.DeliverWebhook(s.app.Dao(), &delivery, sub.Secret, s.timeout(), conf.MaxRetries)
+	}
+}
+
+// Deliver the event again to the subscription in the background (without retries)
+//
+// The subscription is found by the URL of the delivery, and its current secret is used.
+func (s *WebhookService) Redeliver(delivery *entity.WebhookDelivery) error {
+	sub, ok := lo.Find(s.app.Conf().Webhooks.Subscriptions, func(sub config.WebhookSubscriptionConf) bool {
+		return sub.URL == delivery.URL
+	})
+	if !ok {
+		return fmt.Errorf("the webhook subscription of %s is not found", delivery.URL)
+	}
+
+	notify_pusher.RedeliverWebhook(s.app.Dao(), delivery, sub.Secret, s.timeout())
+	return nil
+}
+
+// Check the pending delivery of the subscription is interrupted (e.g. by a restart)
+func (s *WebhookService) IsDeliveryStale(delivery *entity.WebhookDelivery) bool {
+	return notify_pusher.IsWebhookDeliveryStale(delivery, s.timeout(), s.app.Conf().Webhooks.MaxRetries)
+}
+
+func (s *WebhookService) timeout() time.Duration {
+	return time.Duration(s.app.Conf().Webhooks.Timeout) * time.Second
+}
+
+func (s *WebhookService) onCommentCreated(e *CommentCreatedEvent) error {
+	data := entity.WebhookPayloadData{Comment: lo.ToPtr(s.app.Dao().CookComment(e.Comment))}
+	if e.ParentComment != nil && !e.ParentComment.IsEmpty() {
+		data.ParentComment = lo.ToPtr(s.app.Dao().CookComment(e.ParentComment))
+	}
+	s.Emit(entity.WebhookEventCommentCreated, e.Comment.SiteName, data)
+	return nil
+}
+
+func (s *WebhookService) onCommentApproved(e *CommentApprovedEvent) error {
+	s.Emit(entity.WebhookEventCommentApproved, e.Comment.SiteName, entity.WebhookPayloadData{
+		Comment: lo.ToPtr(s.app.Dao().CookComment(e.Comment)),
+	})
+	return nil
+}
+
+func (s *WebhookService) onCommentDeleted(e *CommentDeletedEvent) error {
+	s.Emit(entity.WebhookEventCommentDeleted, e.Comment.SiteName, entity.WebhookPayloadData{
+		Comment: lo.ToPtr(s.app.Dao().CookComment(e.Comment)),
+	})
+	return nil
+}
+
+func (s *WebhookService) onVoteCreated(e *VoteCreatedEvent) error {
+	s.Emit(entity.WebhookEventVoteCreated, e.SiteName, entity.WebhookPayloadData{
+		Vote: lo.ToPtr(s.app.Dao().CookVote(e.Vote)),
+	})
+	return nil
+}
+
+func (s *WebhookService) onPageCreated(e *PageCreatedEvent) error {
+	s.Emit(entity.WebhookEventPageCreated, e.Page.SiteName, entity.WebhookPayloadData{
+		Page: lo.ToPtr(s.app.Dao().CookPage(e.Page)),
+	})
+	return nil
+}
+
+// The user events are only sent to the subscriptions of all sites (no site name)
+func (s *WebhookService) onUserRegistered(e *UserRegisteredEvent) error {
+	s.Emit(entity.WebhookEventUserRegistered, "", entity.WebhookPayloadData{
+		User: lo.ToPtr(s.app.Dao().CookUser(e.User)),
+	})
+	return nil
+}
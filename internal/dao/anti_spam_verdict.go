@@ -0,0 +1,32 @@
+package dao
+
+import (
+	"github.com/artalkjs/artalk/v2/internal/entity"
+	"github.com/samber/lo"
+)
+
+func (dao *Dao) FindAntiSpamVerdict(commentID uint) entity.AntiSpamVerdict {
+	var verdict entity.AntiSpamVerdict
+	dao.DB().Where("comment_id = ?", commentID).First(&verdict)
+	return verdict
+}
+
+// Find the verdicts of the comments (comment id => verdict)
+func (dao *Dao) FindAntiSpamVerdicts(commentIDs []uint) map[uint]entity.AntiSpamVerdict {
+	var verdicts []entity.AntiSpamVerdict
+	for _, chunk := range lo.Chunk(commentIDs, 500) {
+		var found []entity.AntiSpamVerdict
+		dao.DB().Where("comment_id IN (?)", chunk).Find(&found)
+		verdicts = append(verdicts, found...)
+	}
+	return lo.KeyBy(verdicts, func(v entity.AntiSpamVerdict) uint { return v.CommentID })
+}
+
+// Save the verdict of the comment, the previous verdict is replaced
+func (dao *Dao) SaveAntiSpamVerdict(verdict *entity.AntiSpamVerdict) error {
+	if prev := dao.FindAntiSpamVerdict(verdict.CommentID); !prev.IsEmpty() {
+		verdict.ID = prev.ID
+		verdict.CreatedAt = prev.CreatedAt
+	}
+	return dao.DB().Save(verdict).Error
+}
@@ -16,6 +16,16 @@ type Dao struct {
 	// As the cache could be nil.
 	// Therefore, it is necessary to check if the cache is not nil before referencing it.
 	cache *DaoCache
+
+	// The cache actions deferred until the transaction is committed
+	// (only set for the dao bound to a transaction, see `Transaction`)
+	txCacheActions *[]func(cache *DaoCache)
+
+	// The hooks triggered after the records are created
+	hooks *daoHooks
+
+	// The hook triggers deferred until the transaction is committed
+	txHookTriggers *[]func()
 }
 
 // Create new dao instance
@@ -23,7 +33,8 @@ type Dao struct {
 // This function will auto migrate database tables
 func NewDao(db *DB) *Dao {
 	dao := &Dao{
-		db: db,
+		db:    db,
+		hooks: &daoHooks{},
 	}
 
 	dao.MigrateModels()
@@ -40,7 +51,43 @@ func (dao *Dao) SetCache(cache *DaoCache) {
 }
 
 func (dao *Dao) CacheAction(fn func(cache *DaoCache)) {
+	if dao.txCacheActions != nil {
+		*dao.txCacheActions = append(*dao.txCacheActions, fn)
+		return
+	}
 	if dao.cache != nil {
 		fn(dao.cache)
 	}
 }
+
+// Run the function in a database transaction
+//
+// The `tx` dao passed to the function is bound to the transaction,
+// the queries of it bypass the cache to avoid caching the uncommitted records,
+// and the cache actions and the hooks of it are deferred until the transaction is committed
+// (discarded if rolled back).
+//
+// The nested call joins the outer transaction.
+func (dao *Dao) Transaction(fn func(tx *Dao) error) error {
+	if dao.txCacheActions != nil {
+		return fn(dao)
+	}
+
+	cacheActions := []func(cache *DaoCache){}
+	hookTriggers := []func(){}
+	err := dao.db.Transaction(func(db *DB) error {
+		return fn(&Dao{db: db, hooks: dao.hooks, txCacheActions: &cacheActions, txHookTriggers: &hookTriggers})
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, fn := range cacheActions {
+		dao.CacheAction(fn)
+	}
+	for _, trigger := range hookTriggers {
+		trigger()
+	}
+
+	return nil
+}
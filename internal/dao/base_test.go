@@ -1,6 +1,7 @@
 package dao_test
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/artalkjs/artalk/v2/internal/cache"
@@ -74,3 +75,48 @@ func TestDaoCache(t *testing.T) {
 		assert.False(t, cacheActionFnInvoked)
 	})
 }
+
+func TestTransaction(t *testing.T) {
+	d, ddb := newTestDao(t)
+	defer db.CloseDB(ddb)
+
+	cacheAdaptor := dao.NewCacheAdaptor(newTestCache(t))
+	defer cacheAdaptor.Close()
+	d.SetCache(cacheAdaptor)
+
+	t.Run("Commit", func(t *testing.T) {
+		cacheActionFnInvoked := false
+		err := d.Transaction(func(tx *dao.Dao) error {
+			tx.CacheAction(func(cache *dao.DaoCache) {
+				cacheActionFnInvoked = true
+				assert.Equal(t, cacheAdaptor, cache)
+			})
+			assert.False(t, cacheActionFnInvoked, "cache action should be deferred until commit")
+			return nil
+		})
+		assert.NoError(t, err)
+		assert.True(t, cacheActionFnInvoked)
+	})
+
+	t.Run("Rollback", func(t *testing.T) {
+		cacheActionFnInvoked := false
+		err := d.Transaction(func(tx *dao.Dao) error {
+			tx.CacheAction(func(cache *dao.DaoCache) {
+				cacheActionFnInvoked = true
+			})
+			return errors.New("rollback")
+		})
+		assert.Error(t, err)
+		assert.False(t, cacheActionFnInvoked, "cache action should be discarded when rolled back")
+	})
+
+	t.Run("Nested", func(t *testing.T) {
+		err := d.Transaction(func(tx *dao.Dao) error {
+			return tx.Transaction(func(nested *dao.Dao) error {
+				assert.Equal(t, tx, nested, "nested call should join the outer transaction")
+				return nil
+			})
+		})
+		assert.NoError(t, err)
+	})
+}
@@ -0,0 +1,93 @@
+package dao
+
+import (
+	"github.com/artalkjs/artalk/v2/internal/entity"
+	"github.com/samber/lo"
+	"gorm.io/gorm"
+)
+
+// Get the trained counts of the tokens (token => counts)
+//
+// The total counts of the trained documents are included with the key `entity.BayesTotalToken`.
+func (dao *Dao) GetBayesTokens(tokens []string) map[string]entity.BayesToken {
+	var records []entity.BayesToken
+	for _, chunk := range lo.Chunk(append([]string{entity.BayesTotalToken}, tokens...), 500) {
+		var found []entity.BayesToken
+		dao.DB().Where("token IN (?)", chunk).Find(&found)
+		records = append(records, found...)
+	}
+	return lo.KeyBy(records, func(t entity.BayesToken) string { return t.Token })
+}
+
+func (dao *Dao) FindBayesDocument(commentID uint) entity.BayesDocument {
+	var doc entity.BayesDocument
+	dao.DB().Where("comment_id = ?", commentID).First(&doc)
+	return doc
+}
+
+// Train the comment into the naive Bayes classifier with the label
+//
+// If the comment has been trained with the other label, the previous training is reverted.
+// Nothing is changed if the comment has been trained with the same label.
+func (dao *Dao) TrainBayes(commentID uint, tokens []string, isSpam bool) error {
+	return dao.Transaction(func(tx *Dao) error {
+		doc := tx.FindBayesDocument(commentID)
+		if !doc.IsEmpty() {
+			if doc.IsSpam == isSpam {
+				return nil
+			}
+			if err := tx.updateBayesTokens(doc.Tokens, doc.IsSpam, -1); err != nil {
+				return err
+			}
+		}
+
+		if err := tx.updateBayesTokens(tokens, isSpam, 1); err != nil {
+			return err
+		}
+
+		doc.CommentID = commentID
+		doc.IsSpam = isSpam
+		doc.Tokens = tokens
+		return tx.DB().Save(&doc).Error
+	})
+}
+
+// Revert the training of the comment
+func (dao *Dao) UntrainBayes(commentID uint) error {
+	return dao.Transaction(func(tx *Dao) error {
+		doc := tx.FindBayesDocument(commentID)
+		if doc.IsEmpty() {
+			return nil
+		}
+		if err := tx.updateBayesTokens(doc.Tokens, doc.IsSpam, -1); err != nil {
+			return err
+		}
+		return tx.DB().Unscoped().Delete(&doc).Error
+	})
+}
+
+// Clear the naive Bayes classifier
+func (dao *Dao) ResetBayes() error {
+	return dao.Transaction(func(tx *Dao) error {
+		if err := tx.DB().Unscoped().Where("1 = 1").Delete(&entity.BayesToken{}).Error; err != nil {
+			return err
+		}
+		return tx.DB().Unscoped().Where("1 = 1").Delete(&entity.BayesDocument{}).Error
+	})
+}
+
+// Add the delta to the counts of the tokens and the total count
+func (dao *Dao) updateBayesTokens(tokens []string, isSpam bool, delta int64) error {
+	column := lo.If(isSpam, "spam").Else("ham")
+	for _, token := range append([]string{entity.BayesTotalToken}, tokens...) {
+		record := entity.BayesToken{Token: token}
+		if err := dao.DB().Where("token = ?", token).FirstOrCreate(&record).Error; err != nil {
+			return err
+		}
+		if err := dao.DB().Model(&record).
+			UpdateColumn(column, gorm.Expr(column+" + ?", delta)).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
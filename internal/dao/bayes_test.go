@@ -0,0 +1,60 @@
+package dao_test
+
+import (
+	"testing"
+
+	"github.com/artalkjs/artalk/v2/internal/entity"
+	"github.com/artalkjs/artalk/v2/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTrainBayes(t *testing.T) {
+	app, _ := test.NewTestApp()
+	defer app.Cleanup()
+
+	counts := func(tokens ...string) map[string][2]int64 {
+		ret := map[string][2]int64{}
+		for token, t := range app.Dao().GetBayesTokens(tokens) {
+			ret[token] = [2]int64{t.Spam, t.Ham}
+		}
+		return ret
+	}
+
+	require.NoError(t, app.Dao().TrainBayes(1000, []string{"free", "money"}, true))
+	require.NoError(t, app.Dao().TrainBayes(1001, []string{"free", "article"}, false))
+	assert.Equal(t, map[string][2]int64{
+		entity.BayesTotalToken: {1, 1},
+		"free":                 {1, 1},
+		"money":                {1, 0},
+	}, counts("free", "money", "unknown"))
+
+	t.Run("Train again with the same label", func(t *testing.T) {
+		require.NoError(t, app.Dao().TrainBayes(1000, []string{"free", "money"}, true))
+		assert.Equal(t, [2]int64{1, 1}, counts()[entity.BayesTotalToken])
+	})
+
+	t.Run("Change the label", func(t *testing.T) {
+		// the previous tokens are reverted even if the content is changed
+		require.NoError(t, app.Dao().TrainBayes(1000, []string{"money", "back"}, false))
+		assert.Equal(t, map[string][2]int64{
+			entity.BayesTotalToken: {0, 2},
+			"free":                 {0, 1},
+			"money":                {0, 1},
+			"back":                 {0, 1},
+		}, counts("free", "money", "back"))
+		assert.False(t, app.Dao().FindBayesDocument(1000).IsSpam)
+	})
+
+	t.Run("Untrain", func(t *testing.T) {
+		require.NoError(t, app.Dao().UntrainBayes(1001))
+		assert.True(t, app.Dao().FindBayesDocument(1001).IsEmpty())
+		assert.Equal(t, [2]int64{0, 0}, counts("free")["free"])
+	})
+
+	t.Run("Reset", func(t *testing.T) {
+		require.NoError(t, app.Dao().ResetBayes())
+		assert.Empty(t, counts("money"))
+		assert.True(t, app.Dao().FindBayesDocument(1000).IsEmpty())
+	})
+}
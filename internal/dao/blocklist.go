@@ -0,0 +1,81 @@
+package dao
+
+import (
+	"time"
+
+	"github.com/artalkjs/artalk/v2/internal/entity"
+	"github.com/samber/lo"
+)
+
+func (dao *Dao) FindBlocklistItem(id uint) entity.BlocklistItem {
+	var item entity.BlocklistItem
+	dao.DB().Where("id = ?", id).First(&item)
+	return item
+}
+
+// Find the items of the blocklist which are not expired
+//
+// The items are cached until the blocklist is changed, so the expiry is checked again when found.
+func (dao *Dao) FindActiveBlocklistItems() []entity.BlocklistItem {
+	items, _ := QueryDBWithCache(dao, BlocklistActiveKey, func() ([]entity.BlocklistItem, error) {
+		var items []entity.BlocklistItem
+		dao.DB().Where("expires_at IS NULL OR expires_at > ?", time.Now()).Order("id ASC").Find(&items)
+		return items, nil
+	})
+
+	return lo.Filter(items, func(item entity.BlocklistItem, _ int) bool {
+		return !item.IsExpired()
+	})
+}
+
+// Find the first active item of the blocklist matching the target, empty if not blocked
+func (dao *Dao) MatchBlocklist(target entity.BlocklistTarget) entity.BlocklistItem {
+	for _, item := range dao.FindActiveBlocklistItems() {
+		if item.Match(target) {
+			return item
+		}
+	}
+	return entity.BlocklistItem{}
+}
+
+// Save the items of the blocklist, the existing items with the same type and value are replaced
+func (dao *Dao) SaveBlocklistItems(items []entity.BlocklistItem) error {
+	return dao.Transaction(func(tx *Dao) error {
+		for i := range items {
+			var existing entity.BlocklistItem
+			tx.DB().Where("type = ? AND value = ?", items[i].Type, items[i].Value).First(&existing)
+			items[i].ID = existing.ID
+			items[i].CreatedAt = existing.CreatedAt
+			if err := tx.DB().Save(&items[i]).Error; err != nil {
+				return err
+			}
+		}
+
+		tx.CacheAction(func(cache *DaoCache) {
+			cache.BlocklistCacheDel()
+		})
+		return nil
+	})
+}
+
+func (dao *Dao) UpdateBlocklistItem(item *entity.BlocklistItem) error {
+	if err := dao.DB().Save(item).Error; err != nil {
+		return err
+	}
+
+	dao.CacheAction(func(cache *DaoCache) {
+		cache.BlocklistCacheDel()
+	})
+	return nil
+}
+
+func (dao *Dao) DelBlocklistItem(item *entity.BlocklistItem) error {
+	if err := dao.DB().Unscoped().Delete(item).Error; err != nil {
+		return err
+	}
+
+	dao.CacheAction(func(cache *DaoCache) {
+		cache.BlocklistCacheDel()
+	})
+	return nil
+}
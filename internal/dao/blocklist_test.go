@@ -0,0 +1,115 @@
+package dao_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/artalkjs/artalk/v2/internal/dao"
+	"github.com/artalkjs/artalk/v2/internal/db"
+	"github.com/artalkjs/artalk/v2/internal/entity"
+	"github.com/artalkjs/artalk/v2/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMatchBlocklist(t *testing.T) {
+	app, _ := test.NewTestApp()
+	defer app.Cleanup()
+
+	expired := time.Now().Add(-time.Hour)
+	require.NoError(t, app.Dao().SaveBlocklistItems([]entity.BlocklistItem{
+		{Type: entity.BlocklistTypeIP, Value: "10.0.0.0/8"},
+		{Type: entity.BlocklistTypeIP, Value: "2001:db8::1"},
+		{Type: entity.BlocklistTypeEmail, Value: "Troll@Example.org"},
+		{Type: entity.BlocklistTypeEmailDomain, Value: "spam.com"},
+		{Type: entity.BlocklistTypeUserID, Value: "1002"},
+		{Type: entity.BlocklistTypeUA, Value: `(?i)curl/`},
+		{Type: entity.BlocklistTypeIP, Value: "192.168.1.1", ExpiresAt: &expired},
+	}))
+
+	tests := []struct {
+		name    string
+		target  entity.BlocklistTarget
+		blocked bool
+	}{
+		{"Not blocked", entity.BlocklistTarget{IP: "127.0.0.1", Email: "user@example.org", UserIDs: []uint{1001}, UA: "Mozilla/5.0"}, false},
+		{"CIDR", entity.BlocklistTarget{IP: "10.1.2.3"}, true},
+		{"IPv6", entity.BlocklistTarget{IP: "2001:db8::1"}, true},
+		{"IPv4-mapped IPv6", entity.BlocklistTarget{IP: "::ffff:10.0.0.1"}, true},
+		{"Email (case-insensitive)", entity.BlocklistTarget{Email: "troll@example.org"}, true},
+		{"Email domain", entity.BlocklistTarget{Email: "a@spam.com"}, true},
+		{"Email subdomain", entity.BlocklistTarget{Email: "a@mail.spam.com"}, true},
+		{"Email domain suffix only", entity.BlocklistTarget{Email: "a@notspam.com"}, false},
+		{"User ID", entity.BlocklistTarget{UserIDs: []uint{1001, 1002}}, true},
+		{"UA", entity.BlocklistTarget{UA: "curl/8.0"}, true},
+		{"Expired", entity.BlocklistTarget{IP: "192.168.1.1"}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.blocked, !app.Dao().MatchBlocklist(tt.target).IsEmpty())
+		})
+	}
+
+	t.Run("Replace the existing item", func(t *testing.T) {
+		items := []entity.BlocklistItem{{Type: entity.BlocklistTypeIP, Value: "10.0.0.0/8", Reason: "updated"}}
+		require.NoError(t, app.Dao().SaveBlocklistItems(items))
+
+		var count int64
+		app.Dao().DB().Model(&entity.BlocklistItem{}).Where("type = ? AND value = ?", "ip", "10.0.0.0/8").Count(&count)
+		assert.Equal(t, int64(1), count)
+		assert.Equal(t, "updated", app.Dao().FindBlocklistItem(items[0].ID).Reason)
+	})
+}
+
+func TestBlocklistCache(t *testing.T) {
+	d, ddb := newTestDao(t)
+	defer db.CloseDB(ddb)
+
+	cacheAdaptor := dao.NewCacheAdaptor(newTestCache(t))
+	defer cacheAdaptor.Close()
+	d.SetCache(cacheAdaptor)
+
+	target := entity.BlocklistTarget{UA: "curl/8.0"}
+	items := []entity.BlocklistItem{{Type: entity.BlocklistTypeUA, Value: "^curl/"}}
+	assert.True(t, d.MatchBlocklist(target).IsEmpty(), "the empty blocklist is cached")
+
+	// the cached items are invalidated after changed
+	require.NoError(t, d.SaveBlocklistItems(items))
+	assert.False(t, d.MatchBlocklist(target).IsEmpty())
+
+	items[0].Value = "^wget/"
+	require.NoError(t, d.UpdateBlocklistItem(&items[0]))
+	assert.True(t, d.MatchBlocklist(target).IsEmpty())
+	assert.False(t, d.MatchBlocklist(entity.BlocklistTarget{UA: "wget/1.0"}).IsEmpty())
+
+	require.NoError(t, d.DelBlocklistItem(&items[0]))
+	assert.Empty(t, d.FindActiveBlocklistItems())
+}
+
+func TestBlocklistItemValidate(t *testing.T) {
+	valid := []entity.BlocklistItem{
+		{Type: entity.BlocklistTypeIP, Value: "1.2.3.4"},
+		{Type: entity.BlocklistTypeIP, Value: "1.2.3.0/24"},
+		{Type: entity.BlocklistTypeEmail, Value: "a@b.com"},
+		{Type: entity.BlocklistTypeEmailDomain, Value: "b.com"},
+		{Type: entity.BlocklistTypeUserID, Value: "1000"},
+		{Type: entity.BlocklistTypeUA, Value: "bot"},
+	}
+	for _, item := range valid {
+		assert.NoError(t, item.Validate(), item.Value)
+	}
+
+	invalid := []entity.BlocklistItem{
+		{Type: "unknown", Value: "x"},
+		{Type: entity.BlocklistTypeIP, Value: ""},
+		{Type: entity.BlocklistTypeIP, Value: "1.2.3"},
+		{Type: entity.BlocklistTypeIP, Value: "1.2.3.0/33"},
+		{Type: entity.BlocklistTypeEmail, Value: "ab.com"},
+		{Type: entity.BlocklistTypeUserID, Value: "abc"},
+		{Type: entity.BlocklistTypeUA, Value: "("},
+	}
+	for _, item := range invalid {
+		assert.Error(t, item.Validate(), item.Value)
+	}
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/artalkjs/artalk/v2/internal/cache"
 	"github.com/artalkjs/artalk/v2/internal/entity"
+	"github.com/samber/lo"
 	"golang.org/x/exp/slices"
 )
 
@@ -20,6 +21,8 @@ const (
 	CommentByIDKey         = "comment#id=%d"
 	CommentChildIDsByIDKey = "comment_child_ids#id=%d"
 	NotifyByUserCommentKey = "notify#user_id=%d;comment_id=%d"
+	UserCommentStatsKey    = "user_comment_stats#user_id=%d"
+	BlocklistActiveKey     = "blocklist#active"
 )
 
 type DaoCache struct {
@@ -86,6 +89,23 @@ func (c *DaoCache) CommentCacheDel(comment *entity.Comment) {
 	c.ChildCommentCacheDel(comment)
 }
 
+// Invalidate the comments updated in batch (the relationships of the comments are not changed)
+func (c *DaoCache) CommentsFieldsCacheDel(comments []entity.Comment) {
+	keys := []string{}
+	for _, comment := range comments {
+		keys = append(keys, fmt.Sprintf(CommentByIDKey, comment.ID))
+	}
+	c.DelCache(lo.Uniq(keys)...)
+}
+
+func (c *DaoCache) UserCommentStatsCacheDel(userID uint) {
+	c.DelCache(fmt.Sprintf(UserCommentStatsKey, userID))
+}
+
+func (c *DaoCache) BlocklistCacheDel() {
+	c.DelCache(BlocklistActiveKey)
+}
+
 // 缓存 父ID=>子ID 评论数据
 func (c *DaoCache) ChildCommentCacheSave(comment *entity.Comment) error {
 	// 若 comment 为根评论
@@ -0,0 +1,69 @@
+package dao
+
+import (
+	"github.com/artalkjs/artalk/v2/internal/entity"
+	"github.com/samber/lo"
+	"gorm.io/gorm"
+)
+
+// Check the comment has been reported by the user (if logged in) or the IP
+func (dao *Dao) HasReportedComment(commentID uint, userID uint, ip string) bool {
+	var count int64
+	q := dao.DB().Model(&entity.CommentReport{}).Where("comment_id = ?", commentID)
+	if userID != 0 {
+		q = q.Where("user_id = ? OR ip = ?", userID, ip)
+	} else {
+		q = q.Where("ip = ?", ip)
+	}
+	q.Count(&count)
+	return count > 0
+}
+
+// Get the number of the open reports of the comment
+func (dao *Dao) CountOpenCommentReports(commentID uint) int64 {
+	var count int64
+	dao.DB().Model(&entity.CommentReport{}).
+		Where("comment_id = ? AND status = ?", commentID, entity.CommentReportStatusOpen).Count(&count)
+	return count
+}
+
+// Get the ids of the reported comments in the report queue (the latest reported first)
+//
+// The comments of all the sites are included if `siteNames` is nil,
+// and the comments in the trash are excluded.
+// The scopes (such as the pagination) are applied to the query of the ids, but not to the total.
+func (dao *Dao) FindReportedCommentIDs(status entity.CommentReportStatus, siteNames []string, scopes ...func(*gorm.DB) *gorm.DB) (commentIDs []uint, total int64) {
+	scope := func(d *gorm.DB) *gorm.DB {
+		comments := dao.DB().Model(&entity.Comment{}).Select("id")
+		if siteNames != nil {
+			comments = comments.Where("site_name IN (?)", siteNames)
+		}
+		return d.Model(&entity.CommentReport{}).Where("status = ? AND comment_id IN (?)", status, comments)
+	}
+
+	dao.DB().Scopes(scope).Distinct("comment_id").Count(&total)
+
+	commentIDs = []uint{}
+	dao.DB().Scopes(scope).Group("comment_id").Order("MAX(created_at) DESC").
+		Scopes(scopes...).Pluck("comment_id", &commentIDs)
+
+	return commentIDs, total
+}
+
+// Find the reports with the status of the comments (comment id => reports, the latest first)
+func (dao *Dao) FindCommentReports(commentIDs []uint, status entity.CommentReportStatus) map[uint][]entity.CommentReport {
+	var reports []entity.CommentReport
+	for _, chunk := range lo.Chunk(commentIDs, 500) {
+		var found []entity.CommentReport
+		dao.DB().Where("comment_id IN (?) AND status = ?", chunk, status).Order("id DESC").Find(&found)
+		reports = append(reports, found...)
+	}
+	return lo.GroupBy(reports, func(r entity.CommentReport) uint { return r.CommentID })
+}
+
+// Close the open reports of the comment with the status
+func (dao *Dao) CloseCommentReports(commentID uint, status entity.CommentReportStatus) error {
+	return dao.DB().Model(&entity.CommentReport{}).
+		Where("comment_id = ? AND status = ?", commentID, entity.CommentReportStatusOpen).
+		Update("status", status).Error
+}
@@ -3,11 +3,16 @@
 package dao
 
 import (
+	"cmp"
+	"fmt"
+	"html"
 	"strings"
 
+	"github.com/artalkjs/artalk/v2/internal/config"
 	"github.com/artalkjs/artalk/v2/internal/entity"
 	"github.com/artalkjs/artalk/v2/internal/utils"
 	"github.com/samber/lo"
+	"gorm.io/gorm"
 )
 
 const CommonDateTimeFormat = "2006-01-02 15:04:05"
@@ -19,6 +24,40 @@ func (dao *Dao) SetCommentEmailHashFunc(fn func(string) string) {
 	getCommentEmailHash = fn
 }
 
+func cookDeletedAt(deletedAt gorm.DeletedAt) string {
+	if !deletedAt.Valid {
+		return ""
+	}
+	return deletedAt.Time.Local().Format(CommonDateTimeFormat)
+}
+
+// Render the resolved `@name` mentions in the marked content as links
+func (dao *Dao) renderMentions(markedContent string, mentionUserIDs []uint) string {
+	if len(mentionUserIDs) == 0 {
+		return markedContent
+	}
+
+	users := map[string]entity.User{}
+	for _, id := range mentionUserIDs {
+		if u := dao.FindUserByID(id); !u.IsEmpty() {
+			users[strings.ToLower(u.Name)] = u
+		}
+	}
+
+	return utils.ReplaceMentions(markedContent, func(name string) string {
+		u, ok := users[strings.ToLower(name)]
+		if !ok {
+			return ""
+		}
+
+		href := ""
+		if utils.ValidateURL(u.Link) {
+			href = fmt.Sprintf(` href="%s" target="_blank" rel="noreferrer noopener nofollow"`, html.EscapeString(u.Link))
+		}
+		return fmt.Sprintf(`<a class="atk-mention" data-user-id="%d"%s>@%s</a>`, u.ID, href, html.EscapeString(name))
+	})
+}
+
 // ===============
 //  Comment
 // ===============
@@ -42,6 +81,17 @@ func (dao *Dao) CookComment(c *entity.Comment) entity.CookedComment {
 	}
 
 	markedContent, _ := utils.Marked(c.Content)
+	markedContent = dao.renderMentions(markedContent, c.MentionUserIDs)
+
+	editedAt := ""
+	if c.EditedAt != nil {
+		editedAt = c.EditedAt.Local().Format(CommonDateTimeFormat)
+	}
+
+	// hide the author of the withdrawn comment
+	if c.IsTombstone {
+		user = &entity.User{}
+	}
 
 	return entity.CookedComment{
 		ID:             c.ID,
@@ -56,7 +106,7 @@ func (dao *Dao) CookComment(c *entity.Comment) entity.CookedComment {
 		IsCollapsed:    c.IsCollapsed,
 		IsPending:      c.IsPending,
 		IsPinned:       c.IsPinned,
-		IsAllowReply:   c.IsAllowReply(),
+		IsAllowReply:   c.IsAllowReply() && !page.IsClosed(),
 		IsVerified:     lo.If(user.IsAdmin, true).Else(c.IsVerified),
 		Rid:            c.Rid,
 		BadgeName:      user.BadgeName,
@@ -65,9 +115,14 @@ func (dao *Dao) CookComment(c *entity.Comment) entity.CookedComment {
 		Visible:        true,
 		VoteUp:         c.VoteUp,
 		VoteDown:       c.VoteDown,
+		Reactions:      lo.If(c.Reactions != nil, c.Reactions).Else(map[string]int{}),
 		PageKey:        c.PageKey,
 		PageURL:        dao.GetPageAccessibleURL(page, site),
 		SiteName:       c.SiteName,
+		IsEdited:       c.IsEdited(),
+		EditedAt:       editedAt,
+		IsTombstone:    c.IsTombstone,
+		DeletedAt:      cookDeletedAt(c.DeletedAt),
 	}
 }
 
@@ -115,11 +170,87 @@ func (dao *Dao) CookCommentForEmail(c *entity.Comment) entity.CookedCommentForEm
 	}
 }
 
+func (dao *Dao) CookCommentRevision(r *entity.CommentRevision) entity.CookedCommentRevision {
+	user := dao.FindUserByID(r.UserID)
+	markedContent, _ := utils.Marked(r.Content)
+
+	return entity.CookedCommentRevision{
+		ID:            r.ID,
+		CommentID:     r.CommentID,
+		UserID:        r.UserID,
+		Nick:          user.Name,
+		Content:       r.Content,
+		ContentMarked: markedContent,
+		Date:          r.CreatedAt.Local().Format(CommonDateTimeFormat),
+	}
+}
+
+func (dao *Dao) CookAllCommentRevisions(revisions []entity.CommentRevision) []entity.CookedCommentRevision {
+	cookedRevisions := []entity.CookedCommentRevision{}
+	for _, r := range revisions {
+		cookedRevisions = append(cookedRevisions, dao.CookCommentRevision(&r))
+	}
+	return cookedRevisions
+}
+
+func (dao *Dao) CookAntiSpamVerdict(v *entity.AntiSpamVerdict) entity.CookedAntiSpamVerdict {
+	return entity.CookedAntiSpamVerdict{
+		Pass:     v.Pass,
+		Checker:  v.Checker,
+		Score:    v.Score,
+		Reason:   v.Reason,
+		Matched:  v.Matched,
+		Response: v.Response,
+		Date:     v.UpdatedAt.Local().Format(CommonDateTimeFormat),
+	}
+}
+
+func (dao *Dao) CookCommentReport(r *entity.CommentReport) entity.CookedCommentReport {
+	userName := ""
+	if r.UserID != 0 {
+		userName = dao.FindUserByID(r.UserID).Name
+	}
+
+	return entity.CookedCommentReport{
+		ID:        r.ID,
+		CommentID: r.CommentID,
+		UserID:    r.UserID,
+		UserName:  userName,
+		IP:        r.IP,
+		UA:        r.UA,
+		Reason:    r.Reason,
+		Status:    r.Status,
+		Date:      r.CreatedAt.Local().Format(CommonDateTimeFormat),
+	}
+}
+
+func (dao *Dao) CookBlocklistItem(b *entity.BlocklistItem) entity.CookedBlocklistItem {
+	expiresAt := ""
+	if b.ExpiresAt != nil {
+		expiresAt = b.ExpiresAt.Local().Format(CommonDateTimeFormat)
+	}
+
+	return entity.CookedBlocklistItem{
+		ID:        b.ID,
+		Type:      b.Type,
+		Value:     b.Value,
+		Reason:    b.Reason,
+		ExpiresAt: expiresAt,
+		IsExpired: b.IsExpired(),
+		Date:      b.CreatedAt.Local().Format(CommonDateTimeFormat),
+	}
+}
+
 // ===============
 //  Page
 // ===============
 
 func (dao *Dao) CookPage(p *entity.Page) entity.CookedPage {
+	closeAt := ""
+	if t := p.AutoCloseAt(); t != nil {
+		closeAt = t.Local().Format(CommonDateTimeFormat)
+	}
+
 	return entity.CookedPage{
 		ID:        p.ID,
 		AdminOnly: p.AdminOnly,
@@ -131,6 +262,13 @@ func (dao *Dao) CookPage(p *entity.Page) entity.CookedPage {
 		VoteDown:  p.VoteDown,
 		PV:        p.PV,
 		Date:      p.CreatedAt.Local().Format(CommonDateTimeFormat),
+		DeletedAt: cookDeletedAt(p.DeletedAt),
+
+		IsLocked:       p.IsLocked,
+		CloseAfterDays: p.CloseAfterDays,
+		CloseAt:        closeAt,
+		IsClosed:       p.IsClosed(),
+		Settings:       p.Settings,
 	}
 }
 
@@ -154,11 +292,25 @@ func (dao *Dao) CookSite(s *entity.Site) entity.CookedSite {
 	}
 
 	return entity.CookedSite{
-		ID:       s.ID,
-		Name:     s.Name,
-		Urls:     splitUrls,
-		UrlsRaw:  s.Urls,
-		FirstUrl: firstUrl,
+		ID:            s.ID,
+		Name:          s.Name,
+		Urls:          splitUrls,
+		UrlsRaw:       s.Urls,
+		FirstUrl:      firstUrl,
+		ReactionTypes: utils.SplitAndTrimSpace(s.ReactionTypes, ","),
+		DeletedAt:     cookDeletedAt(s.DeletedAt),
+	}
+}
+
+func (dao *Dao) SiteToCookedForAdmin(s *entity.Site) entity.CookedSiteForAdmin {
+	overlay, err := config.ParseOverlay(s.ConfigOverlay)
+	if err != nil {
+		overlay = map[string]any{} // the invalid overlay is ignored
+	}
+
+	return entity.CookedSiteForAdmin{
+		CookedSite:    dao.CookSite(s),
+		ConfigOverlay: overlay,
 	}
 }
 
@@ -187,6 +339,8 @@ func (dao *Dao) CookUser(u *entity.User) entity.CookedUser {
 		BadgeColor:   u.BadgeColor,
 		IsAdmin:      u.IsAdmin,
 		ReceiveEmail: u.ReceiveEmail,
+		EmailDigest:  string(u.EmailDigest),
+		SiteRoles:    dao.GetUserSiteRoles(u.ID),
 	}
 }
 
@@ -196,11 +350,14 @@ func (dao *Dao) UserToCookedForAdmin(u *entity.User) entity.CookedUserForAdmin {
 	dao.DB().Model(&entity.Comment{}).Where("user_id = ?", u.ID).Count(&commentCount)
 
 	return entity.CookedUserForAdmin{
-		CookedUser:   cookedUser,
-		LastIP:       u.LastIP,
-		LastUA:       u.LastUA,
-		IsInConf:     u.IsInConf,
-		CommentCount: commentCount,
+		CookedUser:     cookedUser,
+		LastIP:         u.LastIP,
+		LastUA:         u.LastUA,
+		IsInConf:       u.IsInConf,
+		IsShadowBanned: u.IsShadowBanned,
+		TrustLevel:     string(cmp.Or(u.TrustLevel, entity.UserTrustLevelAuto)),
+		CommentCount:   commentCount,
+		DeletedAt:      cookDeletedAt(u.DeletedAt),
 	}
 }
 
@@ -216,6 +373,7 @@ func (dao *Dao) CookNotify(n *entity.Notify) entity.CookedNotify {
 		IsRead:    n.IsRead,
 		IsEmailed: n.IsEmailed,
 		ReadLink:  dao.GetReadLinkByNotify(n),
+		IsMention: n.IsMention,
 	}
 }
 
@@ -226,3 +384,59 @@ func (dao *Dao) CookAllNotifies(notifies []entity.Notify) []entity.CookedNotify
 	}
 	return cookedNotifies
 }
+
+func (dao *Dao) CookVote(v *entity.Vote) entity.CookedVote {
+	return entity.CookedVote{
+		ID:       v.ID,
+		TargetID: v.TargetID,
+		Type:     v.Type,
+		Reaction: v.Reaction,
+		UserID:   v.UserID,
+		Date:     v.CreatedAt.Local().Format(CommonDateTimeFormat),
+	}
+}
+
+func (dao *Dao) CookWebhookDelivery(d *entity.WebhookDelivery) entity.CookedWebhookDelivery {
+	return entity.CookedWebhookDelivery{
+		ID:         d.ID,
+		SiteName:   d.SiteName,
+		Event:      d.Event,
+		URL:        d.URL,
+		Payload:    d.Payload,
+		Status:     d.Status,
+		Attempts:   d.Attempts,
+		StatusCode: d.StatusCode,
+		Response:   d.Response,
+		Error:      d.Error,
+		Date:       d.CreatedAt.Local().Format(CommonDateTimeFormat),
+		UpdatedAt:  d.UpdatedAt.Local().Format(CommonDateTimeFormat),
+	}
+}
+
+func (dao *Dao) CookEmailTask(t *entity.EmailTask) entity.CookedEmailTask {
+	nextAttemptAt := ""
+	if t.NextAttemptAt != nil && t.Status == entity.EmailTaskStatusPending {
+		nextAttemptAt = t.NextAttemptAt.Local().Format(CommonDateTimeFormat)
+	}
+	sentAt := ""
+	if t.SentAt != nil {
+		sentAt = t.SentAt.Local().Format(CommonDateTimeFormat)
+	}
+
+	return entity.CookedEmailTask{
+		ID:            t.ID,
+		FromAddr:      t.FromAddr,
+		FromName:      t.FromName,
+		ToAddr:        t.ToAddr,
+		Subject:       t.Subject,
+		Body:          t.Body,
+		NotifyID:      t.NotifyID,
+		Status:        t.Status,
+		Attempts:      t.Attempts,
+		NextAttemptAt: nextAttemptAt,
+		SentAt:        sentAt,
+		Error:         t.Error,
+		Date:          t.CreatedAt.Local().Format(CommonDateTimeFormat),
+		UpdatedAt:     t.UpdatedAt.Local().Format(CommonDateTimeFormat),
+	}
+}
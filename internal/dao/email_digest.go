@@ -0,0 +1,15 @@
+package dao
+
+import (
+	"github.com/artalkjs/artalk/v2/internal/entity"
+)
+
+func (dao *Dao) FindEmailDigestRun(frequency entity.EmailDigestFrequency) entity.EmailDigestRun {
+	var run entity.EmailDigestRun
+	dao.DB().Where("frequency = ?", frequency).First(&run)
+	return run
+}
+
+func (dao *Dao) SaveEmailDigestRun(run *entity.EmailDigestRun) error {
+	return dao.DB().Save(run).Error
+}
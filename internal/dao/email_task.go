@@ -0,0 +1,58 @@
+package dao
+
+import (
+	"time"
+
+	"github.com/artalkjs/artalk/v2/internal/entity"
+)
+
+func (dao *Dao) FindEmailTask(id uint) entity.EmailTask {
+	var task entity.EmailTask
+	dao.DB().Where("id = ?", id).First(&task)
+	return task
+}
+
+func (dao *Dao) SaveEmailTask(task *entity.EmailTask) error {
+	return dao.DB().Save(task).Error
+}
+
+// Find the pending email tasks (the earliest first)
+func (dao *Dao) FindPendingEmailTasks() []entity.EmailTask {
+	var tasks []entity.EmailTask
+	dao.DB().Where("status = ?", entity.EmailTaskStatusPending).Order("id ASC").Find(&tasks)
+	return tasks
+}
+
+// Mark the pending email task as sending
+//
+// False is returned if the task is not pending (e.g. it is taken by another worker).
+func (dao *Dao) ClaimEmailTask(id uint) bool {
+	result := dao.DB().Model(&entity.EmailTask{}).
+		Where("id = ? AND status = ?", id, entity.EmailTaskStatusPending).
+		Update("status", entity.EmailTaskStatusSending)
+	return result.Error == nil && result.RowsAffected == 1
+}
+
+// Find the email tasks being sent (claimed by the queues)
+func (dao *Dao) FindSendingEmailTasks() []entity.EmailTask {
+	var tasks []entity.EmailTask
+	dao.DB().Where("status = ?", entity.EmailTaskStatusSending).Order("id ASC").Find(&tasks)
+	return tasks
+}
+
+// Reset the email task interrupted while sending (e.g. by a restart) to pending
+//
+// False is returned if the task is not sending, or it is claimed after the time (the `updated_at`),
+// which may be still being sent by another queue.
+func (dao *Dao) ResetSendingEmailTask(id uint, claimedBefore time.Time) bool {
+	result := dao.DB().Model(&entity.EmailTask{}).
+		Where("id = ? AND status = ? AND updated_at < ?", id, entity.EmailTaskStatusSending, claimedBefore).
+		Update("status", entity.EmailTaskStatusPending)
+	return result.Error == nil && result.RowsAffected == 1
+}
+
+// Delete the sent and failed email tasks which are last updated before the specific time
+func (dao *Dao) PurgeEmailTasks(before time.Time) error {
+	return dao.purgeWhere(&entity.EmailTask{}, "updated_at < ? AND status IN ?", before,
+		[]entity.EmailTaskStatus{entity.EmailTaskStatusSent, entity.EmailTaskStatusFailed})
+}
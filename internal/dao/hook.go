@@ -0,0 +1,45 @@
+package dao
+
+import (
+	"github.com/artalkjs/artalk/v2/internal/entity"
+	"github.com/artalkjs/artalk/v2/internal/hook"
+	"github.com/artalkjs/artalk/v2/internal/log"
+)
+
+type daoHooks struct {
+	onPageCreated    hook.Hook[*entity.Page]
+	onCommentDeleted hook.Hook[*entity.Comment]
+	onSiteDeleted    hook.Hook[*entity.Site]
+}
+
+// The hook triggered after a page is created
+func (dao *Dao) OnPageCreated() *hook.Hook[*entity.Page] {
+	return &dao.hooks.onPageCreated
+}
+
+// The hook triggered after a comment is moved into the trash
+//
+// The replies moved into the trash along are not included.
+func (dao *Dao) OnCommentDeleted() *hook.Hook[*entity.Comment] {
+	return &dao.hooks.onCommentDeleted
+}
+
+// The hook triggered after a site is moved into the trash
+func (dao *Dao) OnSiteDeleted() *hook.Hook[*entity.Site] {
+	return &dao.hooks.onSiteDeleted
+}
+
+// Trigger the hook with a copy of the record (deferred until the transaction is committed)
+func triggerDaoHook[T any](dao *Dao, h *hook.Hook[*T], record T) {
+	trigger := func() {
+		if err := h.Trigger(&record); err != nil {
+			log.Error("[DaoHook] err: ", err)
+		}
+	}
+
+	if dao.txHookTriggers != nil {
+		*dao.txHookTriggers = append(*dao.txHookTriggers, trigger)
+		return
+	}
+	trigger()
+}
@@ -0,0 +1,94 @@
+package dao_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/artalkjs/artalk/v2/internal/dao"
+	"github.com/artalkjs/artalk/v2/internal/entity"
+	"github.com/artalkjs/artalk/v2/test"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOnPageCreated(t *testing.T) {
+	app, _ := test.NewTestApp()
+	defer app.Cleanup()
+
+	d := app.Dao()
+
+	created := []string{}
+	d.OnPageCreated().Add(func(page *entity.Page) error {
+		created = append(created, page.Key)
+		return nil
+	})
+
+	t.Run("Create", func(t *testing.T) {
+		d.FindCreatePage("/page_a", "", "Site A")
+		d.FindCreatePage("/page_a", "", "Site A") // found, not created
+		assert.Equal(t, []string{"/page_a"}, created)
+	})
+
+	t.Run("Transaction", func(t *testing.T) {
+		created = []string{}
+		err := d.Transaction(func(tx *dao.Dao) error {
+			tx.NewPage("/page_b", "", "Site A")
+			assert.Empty(t, created, "hook should be deferred until commit")
+			return nil
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"/page_b"}, created)
+
+		created = []string{}
+		err = d.Transaction(func(tx *dao.Dao) error {
+			tx.NewPage("/page_c", "", "Site A")
+			return errors.New("rollback")
+		})
+		assert.Error(t, err)
+		assert.Empty(t, created, "hook should be discarded when rolled back")
+	})
+}
+
+func TestOnCommentDeleted(t *testing.T) {
+	app, _ := test.NewTestApp()
+	defer app.Cleanup()
+
+	d := app.Dao()
+
+	deleted := []uint{}
+	d.OnCommentDeleted().Add(func(comment *entity.Comment) error {
+		deleted = append(deleted, comment.ID)
+		return nil
+	})
+
+	t.Run("Delete", func(t *testing.T) {
+		comment := d.FindComment(1001)
+		assert.NoError(t, d.DelComment(&comment))
+		assert.Equal(t, []uint{1001}, deleted, "the replies moved into the trash along are not included")
+	})
+
+	t.Run("Withdraw", func(t *testing.T) {
+		deleted = []uint{}
+		comment := d.FindComment(1007)
+		isTombstone, err := d.WithdrawComment(&comment)
+		assert.NoError(t, err)
+		assert.False(t, isTombstone)
+		assert.Equal(t, []uint{1007}, deleted)
+	})
+}
+
+func TestOnSiteDeleted(t *testing.T) {
+	app, _ := test.NewTestApp()
+	defer app.Cleanup()
+
+	d := app.Dao()
+
+	deleted := []string{}
+	d.OnSiteDeleted().Add(func(site *entity.Site) error {
+		deleted = append(deleted, site.Name)
+		return nil
+	})
+
+	site := d.FindSite("Site B")
+	assert.NoError(t, d.DelSite(&site))
+	assert.Equal(t, []string{"Site B"}, deleted)
+}
@@ -5,6 +5,8 @@ import (
 
 	"github.com/artalkjs/artalk/v2/internal/entity"
 	"github.com/artalkjs/artalk/v2/internal/log"
+	"github.com/samber/lo"
+	"gorm.io/gorm"
 )
 
 func (dao *Dao) MigrateModels() {
@@ -17,8 +19,12 @@ func (dao *Dao) MigrateModels() {
 
 	// Migrate the schema
 	dao.DB().AutoMigrate(&entity.Site{}, &entity.Page{}, &entity.User{},
-		&entity.AuthIdentity{}, &entity.UserEmailVerify{},
-		&entity.Comment{}, &entity.Notify{}, &entity.Vote{})
+		&entity.UserSiteRole{}, &entity.AuthIdentity{}, &entity.UserEmailVerify{},
+		&entity.Comment{}, &entity.CommentRevision{}, &entity.Notify{}, &entity.Vote{},
+		&entity.BayesToken{}, &entity.BayesDocument{}, &entity.AntiSpamVerdict{}, &entity.BlocklistItem{},
+		&entity.CommentReport{}, &entity.WebhookDelivery{}, &entity.EmailTask{}, &entity.EmailDigestRun{})
+
+	dao.MigrateCommentModerations()
 
 	// Delete all foreign key constraints
 	// Leave relationship maintenance to the program and reduce the difficulty of database management.
@@ -110,6 +116,36 @@ func (dao *Dao) MigrateRootID() {
 	log.Info(TAG, "Root IDs generated successfully.")
 }
 
+// Migrate the table of the moderation decisions (see `GetUserCommentStats`)
+//
+// The approved comments of the users are backfilled when the table is created,
+// so the moderation history before the upgrade is not lost.
+func (dao *Dao) MigrateCommentModerations() {
+	const TAG = "[DB Migrator] "
+
+	isNewTable := !dao.DB().Migrator().HasTable(&entity.CommentModeration{})
+	if err := dao.DB().AutoMigrate(&entity.CommentModeration{}); err != nil {
+		log.Error(TAG, "Failed to migrate the comment moderations. ", err)
+		return
+	}
+	if !isNewTable {
+		return
+	}
+
+	var comments []entity.Comment
+	err := dao.DB().Select("id", "user_id").
+		Where("user_id <> 0 AND is_pending = ? AND is_tombstone = ?", false, false).
+		FindInBatches(&comments, 1000, func(tx *gorm.DB, batch int) error {
+			moderations := lo.Map(comments, func(c entity.Comment, _ int) entity.CommentModeration {
+				return entity.CommentModeration{CommentID: c.ID, UserID: c.UserID, Decision: entity.CommentModerationApproved}
+			})
+			return dao.DB().Create(&moderations).Error
+		}).Error
+	if err != nil {
+		log.Error(TAG, "Failed to backfill the comment moderations. ", err)
+	}
+}
+
 func (dao *Dao) MergePages() {
 	// merge pages with same key and site_name, sum pv
 	pages := []*entity.Page{}
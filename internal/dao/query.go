@@ -1,7 +1,11 @@
 package dao
 
 import (
+	"strings"
+	"time"
+
 	"github.com/artalkjs/artalk/v2/internal/entity"
+	"github.com/samber/lo"
 	"gorm.io/gorm"
 )
 
@@ -11,6 +15,57 @@ func (dao *Dao) GetUserAllCommentIDs(userID uint) []uint {
 	return userAllCommentIDs
 }
 
+// Find the users who can be mentioned by `@name` (the users who have commented on the site)
+//
+// The names are case-insensitive. If several users have the same name, the earliest one is taken.
+func (dao *Dao) FindMentionableUsers(siteName string, names []string) []entity.User {
+	if len(names) == 0 {
+		return []entity.User{}
+	}
+
+	lowerNames := lo.Map(names, func(name string, _ int) string { return strings.ToLower(name) })
+	commenters := dao.DB().Model(&entity.Comment{}).Select("user_id").Where("site_name = ?", siteName)
+
+	var users []entity.User
+	dao.DB().Where("LOWER(name) IN ? AND id IN (?)", lowerNames, commenters).Order("id ASC").Find(&users)
+
+	return lo.UniqBy(users, func(u entity.User) string { return strings.ToLower(u.Name) })
+}
+
+// Get the number of users mentioned by the user in the comments created since the time
+func (dao *Dao) CountUserMentionsSince(userID uint, since time.Time) int {
+	var comments []entity.Comment
+	dao.DB().Select("id", "mention_user_ids").
+		Where("user_id = ? AND created_at >= ?", userID, since).Find(&comments)
+
+	return lo.SumBy(comments, func(c entity.Comment) int { return len(c.MentionUserIDs) })
+}
+
+// Get the ids of the comments which mentioned the user
+func (dao *Dao) GetUserMentionedCommentIDs(userID uint) []uint {
+	commentIDs := []uint{}
+	dao.DB().Model(&entity.Notify{}).Select("comment_id").Where("user_id = ? AND is_mention = ?", userID, true).Find(&commentIDs)
+	return commentIDs
+}
+
+// Get the number of the approved comments of the user (excluding the specific comment)
+func (dao *Dao) CountUserApprovedComments(userID uint, excludeCommentID uint) int64 {
+	var count int64
+	dao.DB().Model(&entity.Comment{}).
+		Where("user_id = ? AND is_pending = ? AND id != ?", userID, false, excludeCommentID).Count(&count)
+	return count
+}
+
+// Get the number of the comments with the same content sent by the user or the IP since the time
+// (excluding the specific comment)
+func (dao *Dao) CountRepeatedComments(content string, userID uint, ip string, since time.Time, excludeCommentID uint) int64 {
+	var count int64
+	dao.DB().Model(&entity.Comment{}).
+		Where("content = ? AND (user_id = ? OR (ip != '' AND ip = ?)) AND created_at >= ? AND id != ?",
+			content, userID, ip, since, excludeCommentID).Count(&count)
+	return count
+}
+
 // Get the table name of the entity
 func (dao *Dao) GetTableName(entity any) string {
 	// @see https://github.com/go-gorm/gorm/issues/3603#issuecomment-709883403
@@ -1,30 +1,89 @@
 package dao
 
 import (
+	"time"
+
 	"github.com/artalkjs/artalk/v2/internal/entity"
 )
 
-// TODO: consider refactor make all delete operations in a transaction
+// The `Del*` functions move the records into the trash (soft delete),
+// the records deleted in one operation share the same `deleted_at`,
+// so that they can be restored or purged together (see query_trash.go).
+//
+// Each operation runs in a transaction, nothing is left behind partially if it fails,
+// and the cache is only invalidated after the transaction is committed.
+
+var (
+	commentVoteTypes = []string{string(entity.VoteTypeCommentUp), string(entity.VoteTypeCommentDown), string(entity.VoteTypeCommentReaction)}
+	pageVoteTypes    = []string{string(entity.VoteTypePageUp), string(entity.VoteTypePageDown)}
+)
+
+// The `deleted_at` for the records deleted in one operation
+func newTrashTime() time.Time {
+	return time.Now().Truncate(time.Millisecond)
+}
 
+// Soft delete the records which are not in the trash yet
+func (dao *Dao) trashWhere(model any, deletedAt time.Time, query any, args ...any) error {
+	return dao.DB().Model(model).Where(query, args...).Update("deleted_at", deletedAt).Error
+}
+
+// 删除评论 (包括所有子评论)
 func (dao *Dao) DelComment(comment *entity.Comment) error {
+	return dao.Transaction(func(tx *Dao) error {
+		deletedAt := newTrashTime()
+
+		children := tx.FindCommentChildren(comment.ID)
+
+		if err := tx.trashComment(comment, deletedAt); err != nil {
+			return err
+		}
+		for _, c := range children {
+			if err := tx.trashComment(&c, deletedAt); err != nil {
+				return err
+			}
+		}
+
+		triggerDaoHook(tx, tx.OnCommentDeleted(), *comment)
+
+		return nil
+	})
+}
+
+// 删除所有子评论
+func (dao *Dao) DelCommentChildren(parentID uint) error {
+	return dao.Transaction(func(tx *Dao) error {
+		deletedAt := newTrashTime()
+
+		children := tx.FindCommentChildren(parentID)
+		for _, c := range children {
+			if err := tx.trashComment(&c, deletedAt); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+func (dao *Dao) trashComment(comment *entity.Comment, deletedAt time.Time) error {
 	// 清除 notify
-	if err := dao.DB().Unscoped().Where("comment_id = ?", comment.ID).Delete(&entity.Notify{}).Error; err != nil {
+	if err := dao.trashWhere(&entity.Notify{}, deletedAt, "comment_id = ?", comment.ID); err != nil {
+		return err
+	}
+
+	// 清除 revision
+	if err := dao.trashWhere(&entity.CommentRevision{}, deletedAt, "comment_id = ?", comment.ID); err != nil {
 		return err
 	}
 
 	// 清除 vote
-	if err := dao.DB().Unscoped().Where(
-		"target_id = ? AND (type = ? OR type = ?)",
-		comment.ID,
-		string(entity.VoteTypeCommentUp),
-		string(entity.VoteTypeCommentDown),
-	).Delete(&entity.Vote{}).Error; err != nil {
+	if err := dao.trashWhere(&entity.Vote{}, deletedAt, "target_id = ? AND type IN ?", comment.ID, commentVoteTypes); err != nil {
 		return err
 	}
 
 	// 删除 comment
-	err := dao.DB().Unscoped().Delete(comment).Error
-	if err != nil {
+	if err := dao.trashWhere(&entity.Comment{}, deletedAt, "id = ?", comment.ID); err != nil {
 		return err
 	}
 
@@ -36,22 +95,73 @@ func (dao *Dao) DelComment(comment *entity.Comment) error {
 	return nil
 }
 
-// 删除所有子评论
-func (dao *Dao) DelCommentChildren(parentID uint) error {
-	var rErr error
-	children := dao.FindCommentChildren(parentID)
-	for _, c := range children {
-		err := dao.DelComment(&c)
-		if err != nil {
-			rErr = err
+// Withdraw a comment by its author
+//
+// If the comment has replies, it will be replaced by a tombstone to keep the thread intact,
+// otherwise it will be deleted, along with the tombstone ancestors which have no replies left.
+func (dao *Dao) WithdrawComment(comment *entity.Comment) (isTombstone bool, err error) {
+	err = dao.Transaction(func(tx *Dao) error {
+		if len(tx.FindCommentChildrenShallow(comment.ID)) > 0 {
+			isTombstone = true
+			return tx.tombstoneComment(comment)
+		}
+
+		if err := tx.DelComment(comment); err != nil {
+			return err
 		}
+
+		// prune the tombstone ancestors which have no replies left
+		visited := map[uint]bool{comment.ID: true}
+		parentID := comment.Rid
+		for parentID != 0 && !visited[parentID] {
+			visited[parentID] = true // avoid infinite loop (rid = id)
+
+			parent := tx.FindComment(parentID)
+			if parent.IsEmpty() || !parent.IsTombstone || len(tx.FindCommentChildrenShallow(parent.ID)) > 0 {
+				break
+			}
+			if err := tx.DelComment(&parent); err != nil {
+				return err
+			}
+
+			parentID = parent.Rid
+		}
+
+		return nil
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return isTombstone, nil
+}
+
+func (dao *Dao) tombstoneComment(comment *entity.Comment) error {
+	// 清除 notify
+	if err := dao.DB().Unscoped().Where("comment_id = ?", comment.ID).Delete(&entity.Notify{}).Error; err != nil {
+		return err
+	}
+
+	// 清除 revision (the withdrawn content should not be kept)
+	if err := dao.DB().Unscoped().Where("comment_id = ?", comment.ID).Delete(&entity.CommentRevision{}).Error; err != nil {
+		return err
 	}
-	return rErr
+
+	comment.Content = entity.CommentTombstoneContent
+	comment.IsTombstone = true
+	comment.IsPinned = false
+
+	return dao.UpdateComment(comment)
 }
 
 func (dao *Dao) DelPage(page *entity.Page) error {
-	err := dao.DB().Unscoped().Delete(page).Error
-	if err != nil {
+	return dao.Transaction(func(tx *Dao) error {
+		return tx.trashPage(page, newTrashTime())
+	})
+}
+
+func (dao *Dao) trashPage(page *entity.Page, deletedAt time.Time) error {
+	if err := dao.trashWhere(&entity.Page{}, deletedAt, "id = ?", page.ID); err != nil {
 		return err
 	}
 
@@ -60,16 +170,15 @@ func (dao *Dao) DelPage(page *entity.Page) error {
 	dao.DB().Where("page_key = ? AND site_name = ?", page.Key, page.SiteName).Find(&comments)
 
 	for _, c := range comments {
-		dao.DelComment(&c)
+		if err := dao.trashComment(&c, deletedAt); err != nil {
+			return err
+		}
 	}
 
 	// 删除 vote
-	dao.DB().Unscoped().Where(
-		"target_id = ? AND (type = ? OR type = ?)",
-		page.ID,
-		string(entity.VoteTypePageUp),
-		string(entity.VoteTypePageDown),
-	).Delete(&entity.Vote{})
+	if err := dao.trashWhere(&entity.Vote{}, deletedAt, "target_id = ? AND type IN ?", page.ID, pageVoteTypes); err != nil {
+		return err
+	}
 
 	// 删除缓存
 	dao.CacheAction(func(cache *DaoCache) {
@@ -80,53 +189,68 @@ func (dao *Dao) DelPage(page *entity.Page) error {
 }
 
 func (dao *Dao) DelSite(site *entity.Site) error {
-	err := dao.DB().Unscoped().Delete(&site).Error
-	if err != nil {
-		return err
-	}
+	return dao.Transaction(func(tx *Dao) error {
+		deletedAt := newTrashTime()
 
-	// 删除所有相关内容
-	var pages []entity.Page
-	dao.DB().Where("site_name = ?", site.Name).Find(&pages)
-	for _, p := range pages {
-		dao.DelPage(&p)
-	}
+		if err := tx.trashWhere(&entity.Site{}, deletedAt, "id = ?", site.ID); err != nil {
+			return err
+		}
 
-	// 删除缓存
-	dao.CacheAction(func(cache *DaoCache) {
-		cache.SiteCacheDel(site)
-	})
+		// 删除所有相关内容
+		var pages []entity.Page
+		tx.DB().Where("site_name = ?", site.Name).Find(&pages)
+		for _, p := range pages {
+			if err := tx.trashPage(&p, deletedAt); err != nil {
+				return err
+			}
+		}
 
-	return nil
+		// 删除缓存
+		tx.CacheAction(func(cache *DaoCache) {
+			cache.SiteCacheDel(site)
+		})
+
+		triggerDaoHook(tx, tx.OnSiteDeleted(), *site)
+
+		return nil
+	})
 }
 
 func (dao *Dao) DelUser(user *entity.User) error {
-	err := dao.DB().Unscoped().Delete(&user).Error
-	if err != nil {
-		return err
-	}
+	return dao.Transaction(func(tx *Dao) error {
+		deletedAt := newTrashTime()
 
-	// Delete user comments
-	var comments []entity.Comment
-	dao.DB().Where("user_id = ?", user.ID).Find(&comments)
-	for _, c := range comments {
-		dao.DelComment(&c)           // Delete parent comment
-		dao.DelCommentChildren(c.ID) // Delete all child comments
-	}
+		if err := tx.trashWhere(&entity.User{}, deletedAt, "id = ?", user.ID); err != nil {
+			return err
+		}
 
-	// Delete user auth identities
-	var authIdentity []entity.AuthIdentity
-	dao.DB().Where("user_id = ?", user.ID).Find(&authIdentity)
-	for _, a := range authIdentity {
-		dao.DelAuthIdentity(&a)
-	}
+		// Delete user comments
+		var comments []entity.Comment
+		tx.DB().Where("user_id = ?", user.ID).Find(&comments)
+		for _, c := range comments {
+			children := tx.FindCommentChildren(c.ID)
+			if err := tx.trashComment(&c, deletedAt); err != nil { // Delete parent comment
+				return err
+			}
+			for _, child := range children {
+				if err := tx.trashComment(&child, deletedAt); err != nil { // Delete all child comments
+					return err
+				}
+			}
+		}
 
-	// Clear cache
-	dao.CacheAction(func(cache *DaoCache) {
-		cache.UserCacheDel(user)
-	})
+		// Delete user auth identities
+		if err := tx.trashWhere(&entity.AuthIdentity{}, deletedAt, "user_id = ?", user.ID); err != nil {
+			return err
+		}
 
-	return nil
+		// Clear cache
+		tx.CacheAction(func(cache *DaoCache) {
+			cache.UserCacheDel(user)
+		})
+
+		return nil
+	})
 }
 
 func (dao *Dao) DelAuthIdentity(authIdentity *entity.AuthIdentity) error {
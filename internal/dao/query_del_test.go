@@ -1,11 +1,14 @@
 package dao_test
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/artalkjs/artalk/v2/internal/entity"
 	"github.com/artalkjs/artalk/v2/test"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
 )
 
 func TestDelComment(t *testing.T) {
@@ -82,3 +85,131 @@ func TestDelUser(t *testing.T) {
 	app.Dao().DB().Where("user_id = ?", 1000).Model(&entity.AuthIdentity{}).Count(&authIdentityCount)
 	assert.Equal(t, int64(0), authIdentityCount, "User auth identities not cleaned")
 }
+
+func TestWithdrawComment(t *testing.T) {
+	app, _ := test.NewTestApp()
+	defer app.Cleanup()
+
+	// 1000 -> 1001 -> 1002 -> 1004
+	//              -> 1003
+	t.Run("Comment with replies becomes tombstone", func(t *testing.T) {
+		comment := app.Dao().FindComment(1002)
+		isTombstone, err := app.Dao().WithdrawComment(&comment)
+		assert.NoError(t, err)
+		assert.True(t, isTombstone)
+
+		tombstone := app.Dao().FindComment(1002)
+		assert.True(t, tombstone.IsTombstone)
+		assert.Equal(t, entity.CommentTombstoneContent, tombstone.Content)
+		assert.False(t, tombstone.IsAllowReply())
+		assert.False(t, app.Dao().FindComment(1004).IsEmpty(), "reply should be kept")
+	})
+
+	t.Run("Leaf comment is deleted and empty tombstone ancestors are pruned", func(t *testing.T) {
+		comment := app.Dao().FindComment(1004)
+		isTombstone, err := app.Dao().WithdrawComment(&comment)
+		assert.NoError(t, err)
+		assert.False(t, isTombstone)
+
+		assert.True(t, app.Dao().FindComment(1004).IsEmpty(), "comment should be deleted")
+		assert.True(t, app.Dao().FindComment(1002).IsEmpty(), "tombstone parent without replies should be pruned")
+		assert.False(t, app.Dao().FindComment(1001).IsEmpty(), "normal ancestor should be kept")
+		assert.False(t, app.Dao().FindComment(1003).IsEmpty(), "sibling should be kept")
+	})
+}
+
+// Make the update statements on the table fail after `n` statements succeeded,
+// to simulate the failure in the middle of a cascade delete
+func injectUpdateFailure(t *testing.T, app *test.TestApp, table string, n int) {
+	db := app.Dao().DB()
+	count := 0
+	err := db.Callback().Update().Before("gorm:update").Register("test:inject_failure", func(tx *gorm.DB) {
+		if tx.Statement.Table != table {
+			return
+		}
+		if count++; count > n {
+			tx.AddError(errors.New("injected failure"))
+		}
+	})
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		db.Callback().Update().Remove("test:inject_failure")
+	})
+}
+
+func TestDelRollback(t *testing.T) {
+	countActive := func(app *test.TestApp, model any, query any, args ...any) int64 {
+		var count int64
+		app.Dao().DB().Model(model).Where(query, args...).Count(&count)
+		return count
+	}
+
+	t.Run("DelComment", func(t *testing.T) {
+		app, _ := test.NewTestApp()
+		defer app.Cleanup()
+
+		// fail when trashing the third comment of the thread
+		injectUpdateFailure(t, app, "atk_comments", 2)
+
+		comment := app.Dao().FindComment(1000)
+		assert.Error(t, app.Dao().DelComment(&comment))
+
+		assert.Equal(t, int64(5), countActive(app, &entity.Comment{}, "id IN ?", []uint{1000, 1001, 1002, 1003, 1004}), "comments should not be deleted partially")
+		assert.Equal(t, int64(2), countActive(app, &entity.Notify{}, "comment_id = ?", 1000), "notifies should not be deleted partially")
+		assert.Equal(t, int64(6), countActive(app, &entity.Vote{}, "target_id = ? AND type LIKE ?", 1000, "comment_%"), "votes should not be deleted partially")
+	})
+
+	t.Run("DelCommentChildren", func(t *testing.T) {
+		app, _ := test.NewTestApp()
+		defer app.Cleanup()
+
+		injectUpdateFailure(t, app, "atk_comments", 1)
+
+		assert.Error(t, app.Dao().DelCommentChildren(1000))
+		assert.Equal(t, int64(4), countActive(app, &entity.Comment{}, "id IN ?", []uint{1001, 1002, 1003, 1004}))
+	})
+
+	t.Run("DelPage", func(t *testing.T) {
+		app, _ := test.NewTestApp()
+		defer app.Cleanup()
+
+		// fail at the last step, trashing the page votes
+		// (after the votes of the two page comments are trashed)
+		injectUpdateFailure(t, app, "atk_votes", 2)
+
+		page := app.Dao().FindPageByID(1001)
+		assert.Error(t, app.Dao().DelPage(&page))
+
+		assert.False(t, app.Dao().FindPageByID(1001).IsEmpty(), "page should not be deleted")
+		assert.Equal(t, int64(2), countActive(app, &entity.Comment{}, "id IN ?", []uint{1006, 1007}), "page comments should not be deleted")
+		assert.Equal(t, int64(3), countActive(app, &entity.Vote{}, "target_id = ? AND type LIKE ?", 1001, "page_%"), "page votes should not be deleted")
+	})
+
+	t.Run("DelSite", func(t *testing.T) {
+		app, _ := test.NewTestApp()
+		defer app.Cleanup()
+
+		injectUpdateFailure(t, app, "atk_pages", 1)
+
+		site := app.Dao().FindSiteByID(1000)
+		assert.Error(t, app.Dao().DelSite(&site))
+
+		assert.False(t, app.Dao().FindSiteByID(1000).IsEmpty(), "site should not be deleted")
+		assert.Equal(t, int64(0), countUnscoped(app, &entity.Page{}, "site_name = ? AND deleted_at IS NOT NULL", site.Name), "pages should not be deleted partially")
+		assert.Equal(t, int64(0), countUnscoped(app, &entity.Comment{}, "site_name = ? AND deleted_at IS NOT NULL", site.Name), "comments should not be deleted partially")
+	})
+
+	t.Run("DelUser", func(t *testing.T) {
+		app, _ := test.NewTestApp()
+		defer app.Cleanup()
+
+		injectUpdateFailure(t, app, "atk_comments", 1)
+
+		user := app.Dao().FindUserByID(1001)
+		assert.Error(t, app.Dao().DelUser(&user))
+
+		assert.False(t, app.Dao().FindUserByID(1001).IsEmpty(), "user should not be deleted")
+		assert.Equal(t, int64(0), countUnscoped(app, &entity.Comment{}, "deleted_at IS NOT NULL"), "comments should not be deleted partially")
+	})
+}
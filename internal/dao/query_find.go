@@ -3,6 +3,7 @@ package dao
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/artalkjs/artalk/v2/internal/cache"
 	"github.com/artalkjs/artalk/v2/internal/entity"
@@ -180,6 +181,22 @@ func (dao *Dao) FindAllSites() []entity.Site {
 	return sites
 }
 
+// #region Comment Revision
+func (dao *Dao) FindCommentRevision(id uint) entity.CommentRevision {
+	var revision entity.CommentRevision
+	dao.DB().Where("id = ?", id).First(&revision)
+	return revision
+}
+
+// Find all revisions of a comment (latest first)
+func (dao *Dao) FindCommentRevisions(commentID uint) []entity.CommentRevision {
+	var revisions []entity.CommentRevision
+	dao.DB().Where("comment_id = ?", commentID).Order("created_at DESC, id DESC").Find(&revisions)
+	return revisions
+}
+
+//#endregion
+
 // #region Notify
 func (dao *Dao) FindNotify(userID uint, commentID uint) entity.Notify {
 	var notify entity.Notify
@@ -187,6 +204,12 @@ func (dao *Dao) FindNotify(userID uint, commentID uint) entity.Notify {
 	return notify
 }
 
+func (dao *Dao) FindNotifyByID(id uint) entity.Notify {
+	var notify entity.Notify
+	dao.DB().Where("id = ?", id).First(&notify)
+	return notify
+}
+
 func (dao *Dao) FindNotifyForComment(commentID uint, key string) entity.Notify {
 	var notify entity.Notify
 	dao.DB().Where(entity.Notify{CommentID: commentID, Key: key}).First(&notify)
@@ -204,6 +227,15 @@ func (dao *Dao) FindUnreadNotifies(userID uint) []entity.Notify {
 	return notifies
 }
 
+// Find the unread notifies which are not emailed and updated after the time (the earliest first)
+func (dao *Dao) FindDigestNotifies(since time.Time) []entity.Notify {
+	var notifies []entity.Notify
+	dao.DB().Where("is_read = ? AND is_emailed = ? AND updated_at >= ?", false, false, since).
+		Order("user_id ASC, updated_at ASC").Find(&notifies)
+
+	return notifies
+}
+
 func (dao *Dao) FindNotifyParentComment(n *entity.Notify) entity.Comment {
 	comment := dao.FetchCommentForNotify(n)
 	if comment.Rid == 0 {
@@ -230,6 +262,39 @@ func (dao *Dao) GetVoteNumUpDown(targetName string, targetID uint) (int, int) {
 	return int(up), int(down)
 }
 
+// Get the count of each emoji reaction of the comment
+func (dao *Dao) GetReactionNums(commentID uint) map[string]int {
+	var rows []struct {
+		Reaction string
+		Num      int
+	}
+	dao.DB().Model(&entity.Vote{}).Select("reaction, COUNT(*) AS num").
+		Where("target_id = ? AND type = ?", commentID, entity.VoteTypeCommentReaction).
+		Group("reaction").Scan(&rows)
+
+	nums := map[string]int{}
+	for _, r := range rows {
+		nums[r.Reaction] = r.Num
+	}
+	return nums
+}
+
+// Get the emoji reactions made by the IP, grouped by the comment ID
+func (dao *Dao) FindReactionsByIP(ip string, commentIDs []uint) map[uint][]string {
+	reactions := map[uint][]string{}
+	if len(commentIDs) == 0 {
+		return reactions
+	}
+
+	var votes []entity.Vote
+	dao.DB().Where("type = ? AND ip = ? AND target_id IN ?", entity.VoteTypeCommentReaction, ip, commentIDs).
+		Order("id ASC").Find(&votes)
+	for _, v := range votes {
+		reactions[v.TargetID] = append(reactions[v.TargetID], v.Reaction)
+	}
+	return reactions
+}
+
 //#endregion
 
 // #region 管理员账号检测
@@ -249,6 +314,13 @@ func (dao *Dao) GetAllAdminIDs() []uint {
 	return ids
 }
 
+// Get the IDs of the shadow-banned users
+func (dao *Dao) GetShadowBannedUserIDs() []uint {
+	ids := []uint{}
+	dao.DB().Model(&entity.User{}).Where("is_shadow_banned = ?", true).Pluck("id", &ids)
+	return ids
+}
+
 func (dao *Dao) IsAdminUser(userID uint) bool {
 	admins := dao.GetAllAdmins()
 	for _, admin := range admins {
@@ -275,6 +347,58 @@ func (dao *Dao) IsAdminUserByNameEmail(name string, email string) bool {
 
 //#endregion
 
+// #region 站点角色
+func (dao *Dao) FindUserSiteRoles(userID uint) []entity.UserSiteRole {
+	var roles []entity.UserSiteRole
+	dao.DB().Where("user_id = ?", userID).Order("id ASC").Find(&roles)
+	return roles
+}
+
+// Get the roles of the user (site name => role)
+func (dao *Dao) GetUserSiteRoles(userID uint) map[string]entity.SiteRole {
+	roles := map[string]entity.SiteRole{}
+	for _, r := range dao.FindUserSiteRoles(userID) {
+		roles[r.SiteName] = r.Role
+	}
+	return roles
+}
+
+// Get the role of the user on the site
+//
+// The global admin is the admin of all the sites,
+// an empty role is returned if the user has no role on the site.
+func (dao *Dao) GetUserSiteRole(user *entity.User, siteName string) entity.SiteRole {
+	if user.IsEmpty() {
+		return ""
+	}
+	if user.IsAdmin {
+		return entity.SiteRoleAdmin
+	}
+	var role entity.UserSiteRole
+	dao.DB().Where("user_id = ? AND site_name = ?", user.ID, siteName).First(&role)
+	return role.Role
+}
+
+// Get the names of the sites which the user has a role on
+func (dao *Dao) GetUserRoleSiteNames(userID uint) []string {
+	names := []string{}
+	dao.DB().Model(&entity.UserSiteRole{}).Where("user_id = ?", userID).Pluck("site_name", &names)
+	return names
+}
+
+// Get the users who manage the site
+// (the global admins, and the site admins and moderators of the site)
+func (dao *Dao) GetSiteManagers(siteName string) []entity.User {
+	var userIDs []uint
+	dao.DB().Model(&entity.UserSiteRole{}).Where("site_name = ?", siteName).Pluck("user_id", &userIDs)
+
+	var users []entity.User
+	dao.DB().Where("is_admin = ? OR id IN (?)", true, userIDs).Order("id ASC").Find(&users)
+	return users
+}
+
+//#endregion
+
 func (dao *Dao) FindAuthIdentityByToken(provider string, token string) entity.AuthIdentity {
 	var identity entity.AuthIdentity
 	dao.DB().Where("provider = ? AND token = ?", provider, token).First(&identity)
@@ -45,6 +45,10 @@ func (dao *Dao) FindCreateSite(siteName string, siteURLs string) entity.Site {
 	r, _ := FindCreateAction(fmt.Sprintf(SiteByNameKey, siteName), func() (entity.Site, error) {
 		return dao.FindSite(siteName), nil
 	}, func() (entity.Site, error) {
+		// the site name is unique, take back the site in the trash (its pages are kept in the trash)
+		if trashed := dao.FindTrashedSiteByName(siteName); !trashed.IsEmpty() {
+			return trashed, dao.restoreSiteOnly(&trashed)
+		}
 		return dao.NewSite(siteName, siteURLs), nil
 	})
 	return r
@@ -89,6 +89,8 @@ func (dao *Dao) CreatePage(page *entity.Page) error {
 		cache.PageCacheSave(page)
 	})
 
+	triggerDaoHook(dao, dao.OnPageCreated(), *page)
+
 	return nil
 }
 
@@ -140,6 +142,24 @@ func (dao *Dao) NewVote(targetID uint, voteType entity.VoteType, userID uint, ua
 	return vote, err
 }
 
+func (dao *Dao) NewReaction(commentID uint, reaction string, userID uint, ua string, ip string) (entity.Vote, error) {
+	vote := entity.Vote{
+		TargetID: commentID,
+		Type:     entity.VoteTypeCommentReaction,
+		Reaction: reaction,
+		UserID:   userID,
+		UA:       ua,
+		IP:       ip,
+	}
+
+	err := dao.DB().Create(&vote).Error
+	if err != nil {
+		log.Error("Create Reaction error: ", err)
+	}
+
+	return vote, err
+}
+
 func (dao *Dao) CreateAuthIdentity(identity *entity.AuthIdentity) error {
 	err := dao.DB().Create(&identity).Error
 	if err != nil {
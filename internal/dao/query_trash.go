@@ -0,0 +1,445 @@
+package dao
+
+import (
+	"errors"
+	"time"
+
+	"github.com/artalkjs/artalk/v2/internal/entity"
+)
+
+// Trash bin
+//
+// The records deleted by `Del*` functions are kept in the trash,
+// they can be restored by `Restore*` or be permanently deleted by `Purge*`.
+// The related records which are deleted in the same operation (with the same `deleted_at`)
+// will be restored or purged together.
+// Like the `Del*` functions, each `Restore*` and `Purge*` operation runs in a transaction.
+
+var (
+	ErrRestoreParentMissing = errors.New("the parent record is missing or in the trash")
+	ErrRestoreConflict      = errors.New("a record with the same identity already exists")
+)
+
+// Restore the records which are deleted at the specific time
+func (dao *Dao) restoreWhere(model any, deletedAt time.Time, query any, args ...any) error {
+	return dao.DB().Unscoped().Model(model).
+		Where("deleted_at = ?", deletedAt).
+		Where(query, args...).
+		Update("deleted_at", nil).Error
+}
+
+// Permanently delete the records (including the records in the trash)
+func (dao *Dao) purgeWhere(model any, query any, args ...any) error {
+	return dao.DB().Unscoped().Where(query, args...).Delete(model).Error
+}
+
+func findTrashed[T any](dao *Dao, query any, args ...any) T {
+	var record T
+	dao.DB().Unscoped().Where("deleted_at IS NOT NULL").Where(query, args...).First(&record)
+	return record
+}
+
+func (dao *Dao) FindTrashedComment(id uint) entity.Comment {
+	return findTrashed[entity.Comment](dao, "id = ?", id)
+}
+
+func (dao *Dao) FindTrashedPage(id uint) entity.Page {
+	return findTrashed[entity.Page](dao, "id = ?", id)
+}
+
+func (dao *Dao) FindTrashedSite(id uint) entity.Site {
+	return findTrashed[entity.Site](dao, "id = ?", id)
+}
+
+func (dao *Dao) FindTrashedSiteByName(name string) entity.Site {
+	return findTrashed[entity.Site](dao, "name = ?", name)
+}
+
+func (dao *Dao) FindTrashedUser(id uint) entity.User {
+	return findTrashed[entity.User](dao, "id = ?", id)
+}
+
+// #region Restore
+
+// Restore the comment, and the replies which are deleted together with it
+func (dao *Dao) RestoreComment(comment *entity.Comment) error {
+	return dao.Transaction(func(tx *Dao) error {
+		if !comment.DeletedAt.Valid {
+			return nil
+		}
+
+		// the page, the author and the parent comment should not be in the trash
+		if tx.FindPage(comment.PageKey, comment.SiteName).IsEmpty() ||
+			tx.FindUserByID(comment.UserID).IsEmpty() ||
+			(comment.Rid != 0 && tx.FindComment(comment.Rid).IsEmpty()) {
+			return ErrRestoreParentMissing
+		}
+
+		deletedAt := comment.DeletedAt.Time
+		if err := tx.restoreComment(comment, deletedAt); err != nil {
+			return err
+		}
+
+		return tx.restoreCommentReplies(comment.ID, deletedAt, map[uint]bool{comment.ID: true})
+	})
+}
+
+func (dao *Dao) restoreComment(comment *entity.Comment, deletedAt time.Time) error {
+	if err := dao.restoreWhere(&entity.Notify{}, deletedAt, "comment_id = ?", comment.ID); err != nil {
+		return err
+	}
+	if err := dao.restoreWhere(&entity.CommentRevision{}, deletedAt, "comment_id = ?", comment.ID); err != nil {
+		return err
+	}
+	if err := dao.restoreWhere(&entity.Vote{}, deletedAt, "target_id = ? AND type IN ?", comment.ID, commentVoteTypes); err != nil {
+		return err
+	}
+	if err := dao.restoreWhere(&entity.Comment{}, deletedAt, "id = ?", comment.ID); err != nil {
+		return err
+	}
+
+	comment.DeletedAt.Valid = false
+
+	dao.CacheAction(func(cache *DaoCache) {
+		cache.CommentCacheDel(comment)
+	})
+
+	return nil
+}
+
+func (dao *Dao) restoreCommentReplies(parentID uint, deletedAt time.Time, visited map[uint]bool) error {
+	var replies []entity.Comment
+	dao.DB().Unscoped().Where("rid = ? AND deleted_at = ?", parentID, deletedAt).Find(&replies)
+
+	for _, r := range replies {
+		if visited[r.ID] {
+			continue // avoid infinite loop (rid = id)
+		}
+		visited[r.ID] = true
+
+		if err := dao.restoreComment(&r, deletedAt); err != nil {
+			return err
+		}
+		if err := dao.restoreCommentReplies(r.ID, deletedAt, visited); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Restore the page, and the comments which are deleted together with it
+func (dao *Dao) RestorePage(page *entity.Page) error {
+	return dao.Transaction(func(tx *Dao) error {
+		if !page.DeletedAt.Valid {
+			return nil
+		}
+
+		if tx.FindSite(page.SiteName).IsEmpty() {
+			return ErrRestoreParentMissing
+		}
+
+		// the page with the same key may be created again after deleted
+		if !tx.FindPage(page.Key, page.SiteName).IsEmpty() {
+			return ErrRestoreConflict
+		}
+
+		return tx.restorePage(page, page.DeletedAt.Time)
+	})
+}
+
+func (dao *Dao) restorePage(page *entity.Page, deletedAt time.Time) error {
+	var comments []entity.Comment
+	dao.DB().Unscoped().Where("page_key = ? AND site_name = ? AND deleted_at = ?", page.Key, page.SiteName, deletedAt).Find(&comments)
+	for _, c := range comments {
+		if err := dao.restoreComment(&c, deletedAt); err != nil {
+			return err
+		}
+	}
+
+	if err := dao.restoreWhere(&entity.Vote{}, deletedAt, "target_id = ? AND type IN ?", page.ID, pageVoteTypes); err != nil {
+		return err
+	}
+	if err := dao.restoreWhere(&entity.Page{}, deletedAt, "id = ?", page.ID); err != nil {
+		return err
+	}
+
+	page.DeletedAt.Valid = false
+
+	dao.CacheAction(func(cache *DaoCache) {
+		cache.PageCacheDel(page)
+	})
+
+	return nil
+}
+
+// Restore the site, and the pages which are deleted together with it
+func (dao *Dao) RestoreSite(site *entity.Site) error {
+	return dao.Transaction(func(tx *Dao) error {
+		if !site.DeletedAt.Valid {
+			return nil
+		}
+
+		if !tx.FindSite(site.Name).IsEmpty() {
+			return ErrRestoreConflict
+		}
+
+		deletedAt := site.DeletedAt.Time
+
+		var pages []entity.Page
+		tx.DB().Unscoped().Where("site_name = ? AND deleted_at = ?", site.Name, deletedAt).Find(&pages)
+		for _, p := range pages {
+			if err := tx.restorePage(&p, deletedAt); err != nil {
+				return err
+			}
+		}
+
+		return tx.restoreSiteOnly(site)
+	})
+}
+
+// Restore the site record without the pages deleted together with it
+func (dao *Dao) restoreSiteOnly(site *entity.Site) error {
+	if err := dao.restoreWhere(&entity.Site{}, site.DeletedAt.Time, "id = ?", site.ID); err != nil {
+		return err
+	}
+
+	site.DeletedAt.Valid = false
+
+	dao.CacheAction(func(cache *DaoCache) {
+		cache.SiteCacheDel(site)
+	})
+
+	return nil
+}
+
+// Restore the user, and the comments which are deleted together with it
+func (dao *Dao) RestoreUser(user *entity.User) error {
+	return dao.Transaction(func(tx *Dao) error {
+		if !user.DeletedAt.Valid {
+			return nil
+		}
+
+		// the user with the same name and email may be created again after deleted
+		if !tx.FindUser(user.Name, user.Email).IsEmpty() {
+			return ErrRestoreConflict
+		}
+
+		deletedAt := user.DeletedAt.Time
+
+		var comments []entity.Comment
+		tx.DB().Unscoped().Where("user_id = ? AND deleted_at = ?", user.ID, deletedAt).Find(&comments)
+		for _, c := range comments {
+			if err := tx.restoreComment(&c, deletedAt); err != nil {
+				return err
+			}
+			if err := tx.restoreCommentReplies(c.ID, deletedAt, map[uint]bool{c.ID: true}); err != nil {
+				return err
+			}
+		}
+
+		if err := tx.restoreWhere(&entity.AuthIdentity{}, deletedAt, "user_id = ?", user.ID); err != nil {
+			return err
+		}
+		if err := tx.restoreWhere(&entity.User{}, deletedAt, "id = ?", user.ID); err != nil {
+			return err
+		}
+
+		user.DeletedAt.Valid = false
+
+		tx.CacheAction(func(cache *DaoCache) {
+			cache.UserCacheDel(user)
+			cache.UserCommentStatsCacheDel(user.ID)
+		})
+
+		return nil
+	})
+}
+
+//#endregion
+
+// #region Purge
+
+// Permanently delete the comment, and the replies in the trash
+func (dao *Dao) PurgeComment(comment *entity.Comment) error {
+	return dao.Transaction(func(tx *Dao) error {
+		return tx.purgeComment(comment, map[uint]bool{})
+	})
+}
+
+func (dao *Dao) purgeComment(comment *entity.Comment, visited map[uint]bool) error {
+	visited[comment.ID] = true
+
+	var replies []entity.Comment
+	dao.DB().Unscoped().Where("rid = ? AND deleted_at IS NOT NULL", comment.ID).Find(&replies)
+	for _, r := range replies {
+		if visited[r.ID] {
+			continue // avoid infinite loop (rid = id)
+		}
+		if err := dao.purgeComment(&r, visited); err != nil {
+			return err
+		}
+	}
+
+	if err := dao.purgeWhere(&entity.Notify{}, "comment_id = ?", comment.ID); err != nil {
+		return err
+	}
+	if err := dao.purgeWhere(&entity.CommentRevision{}, "comment_id = ?", comment.ID); err != nil {
+		return err
+	}
+	if err := dao.purgeWhere(&entity.AntiSpamVerdict{}, "comment_id = ?", comment.ID); err != nil {
+		return err
+	}
+	if err := dao.purgeWhere(&entity.CommentReport{}, "comment_id = ?", comment.ID); err != nil {
+		return err
+	}
+	if err := dao.purgeWhere(&entity.Vote{}, "target_id = ? AND type IN ?", comment.ID, commentVoteTypes); err != nil {
+		return err
+	}
+	if err := dao.purgeWhere(&entity.Comment{}, "id = ?", comment.ID); err != nil {
+		return err
+	}
+
+	dao.CacheAction(func(cache *DaoCache) {
+		cache.CommentCacheDel(comment)
+	})
+
+	return nil
+}
+
+// Permanently delete the page, and the comments which are deleted together with it
+func (dao *Dao) PurgePage(page *entity.Page) error {
+	return dao.Transaction(func(tx *Dao) error {
+		if page.DeletedAt.Valid {
+			var comments []entity.Comment
+			tx.DB().Unscoped().Where("page_key = ? AND site_name = ? AND deleted_at = ?", page.Key, page.SiteName, page.DeletedAt.Time).Find(&comments)
+			for _, c := range comments {
+				if err := tx.PurgeComment(&c); err != nil {
+					return err
+				}
+			}
+		}
+
+		if err := tx.purgeWhere(&entity.Vote{}, "target_id = ? AND type IN ?", page.ID, pageVoteTypes); err != nil {
+			return err
+		}
+		if err := tx.purgeWhere(&entity.Page{}, "id = ?", page.ID); err != nil {
+			return err
+		}
+
+		tx.CacheAction(func(cache *DaoCache) {
+			cache.PageCacheDel(page)
+		})
+
+		return nil
+	})
+}
+
+// Permanently delete the site, and the pages which are deleted together with it
+func (dao *Dao) PurgeSite(site *entity.Site) error {
+	return dao.Transaction(func(tx *Dao) error {
+		if site.DeletedAt.Valid {
+			var pages []entity.Page
+			tx.DB().Unscoped().Where("site_name = ? AND deleted_at = ?", site.Name, site.DeletedAt.Time).Find(&pages)
+			for _, p := range pages {
+				if err := tx.PurgePage(&p); err != nil {
+					return err
+				}
+			}
+		}
+
+		if err := tx.purgeWhere(&entity.UserSiteRole{}, "site_name = ?", site.Name); err != nil {
+			return err
+		}
+		if err := tx.purgeWhere(&entity.Site{}, "id = ?", site.ID); err != nil {
+			return err
+		}
+
+		tx.CacheAction(func(cache *DaoCache) {
+			cache.SiteCacheDel(site)
+		})
+
+		return nil
+	})
+}
+
+// Permanently delete the user, and the comments which are deleted together with it
+func (dao *Dao) PurgeUser(user *entity.User) error {
+	return dao.Transaction(func(tx *Dao) error {
+		if user.DeletedAt.Valid {
+			var comments []entity.Comment
+			tx.DB().Unscoped().Where("user_id = ? AND deleted_at = ?", user.ID, user.DeletedAt.Time).Find(&comments)
+			for _, c := range comments {
+				if err := tx.PurgeComment(&c); err != nil {
+					return err
+				}
+			}
+		}
+
+		if err := tx.purgeWhere(&entity.UserSiteRole{}, "user_id = ?", user.ID); err != nil {
+			return err
+		}
+		if err := tx.purgeWhere(&entity.AuthIdentity{}, "user_id = ?", user.ID); err != nil {
+			return err
+		}
+		if err := tx.purgeWhere(&entity.CommentModeration{}, "user_id = ?", user.ID); err != nil {
+			return err
+		}
+		if err := tx.purgeWhere(&entity.User{}, "id = ?", user.ID); err != nil {
+			return err
+		}
+
+		tx.CacheAction(func(cache *DaoCache) {
+			cache.UserCacheDel(user)
+			cache.UserCommentStatsCacheDel(user.ID)
+		})
+
+		return nil
+	})
+}
+
+// Permanently delete all the records which are moved into the trash before the specific time
+func (dao *Dao) PurgeTrash(before time.Time) error {
+	var sites []entity.Site
+	dao.DB().Unscoped().Where("deleted_at < ?", before).Find(&sites)
+	for _, s := range sites {
+		if err := dao.PurgeSite(&s); err != nil {
+			return err
+		}
+	}
+
+	var pages []entity.Page
+	dao.DB().Unscoped().Where("deleted_at < ?", before).Find(&pages)
+	for _, p := range pages {
+		if err := dao.PurgePage(&p); err != nil {
+			return err
+		}
+	}
+
+	var users []entity.User
+	dao.DB().Unscoped().Where("deleted_at < ?", before).Find(&users)
+	for _, u := range users {
+		if err := dao.PurgeUser(&u); err != nil {
+			return err
+		}
+	}
+
+	var comments []entity.Comment
+	dao.DB().Unscoped().Where("deleted_at < ?", before).Find(&comments)
+	for _, c := range comments {
+		if err := dao.PurgeComment(&c); err != nil {
+			return err
+		}
+	}
+
+	// the remaining related records
+	for _, model := range []any{&entity.Notify{}, &entity.CommentRevision{}, &entity.AntiSpamVerdict{}, &entity.CommentReport{}, &entity.Vote{}, &entity.AuthIdentity{}} {
+		if err := dao.purgeWhere(model, "deleted_at < ?", before); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+//#endregion
@@ -0,0 +1,229 @@
+package dao_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/artalkjs/artalk/v2/internal/dao"
+	"github.com/artalkjs/artalk/v2/internal/entity"
+	"github.com/artalkjs/artalk/v2/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func countUnscoped(app *test.TestApp, model any, query any, args ...any) int64 {
+	var count int64
+	app.Dao().DB().Unscoped().Model(model).Where(query, args...).Count(&count)
+	return count
+}
+
+func countActive(app *test.TestApp, model any, query any, args ...any) int64 {
+	var count int64
+	app.Dao().DB().Model(model).Where(query, args...).Count(&count)
+	return count
+}
+
+func TestRestoreComment(t *testing.T) {
+	app, _ := test.NewTestApp()
+	defer app.Cleanup()
+
+	comment := app.Dao().FindComment(1000)
+	require.NoError(t, app.Dao().DelComment(&comment))
+
+	// moved into the trash with the replies, votes and notifies
+	for _, id := range []uint{1000, 1001, 1002, 1003, 1004} {
+		assert.True(t, app.Dao().FindComment(id).IsEmpty(), "comment %d should be in the trash", id)
+	}
+	assert.Equal(t, 0, app.Dao().GetVoteNum(1000, string(entity.VoteTypeCommentUp)))
+	assert.Equal(t, int64(0), countActive(app, &entity.Notify{}, "comment_id = ?", 1000))
+
+	trashed := app.Dao().FindTrashedComment(1000)
+	require.False(t, trashed.IsEmpty())
+	require.NoError(t, app.Dao().RestoreComment(&trashed))
+
+	for _, id := range []uint{1000, 1001, 1002, 1003, 1004} {
+		assert.False(t, app.Dao().FindComment(id).IsEmpty(), "comment %d should be restored", id)
+	}
+	assert.Equal(t, 4, app.Dao().GetVoteNum(1000, string(entity.VoteTypeCommentUp)))
+	assert.Equal(t, 2, app.Dao().GetVoteNum(1000, string(entity.VoteTypeCommentDown)))
+	assert.Equal(t, int64(2), countActive(app, &entity.Notify{}, "comment_id = ?", 1000))
+}
+
+func TestRestoreCommentParentMissing(t *testing.T) {
+	app, _ := test.NewTestApp()
+	defer app.Cleanup()
+
+	comment := app.Dao().FindComment(1002)
+	require.NoError(t, app.Dao().DelComment(&comment))
+	time.Sleep(10 * time.Millisecond) // deleted in another operation
+	parent := app.Dao().FindComment(1001)
+	require.NoError(t, app.Dao().DelComment(&parent))
+
+	trashed := app.Dao().FindTrashedComment(1002)
+	assert.ErrorIs(t, app.Dao().RestoreComment(&trashed), dao.ErrRestoreParentMissing)
+
+	// the comments deleted in the other operation are kept in the trash
+	trashedParent := app.Dao().FindTrashedComment(1001)
+	require.NoError(t, app.Dao().RestoreComment(&trashedParent))
+	assert.False(t, app.Dao().FindComment(1003).IsEmpty())
+	assert.True(t, app.Dao().FindComment(1002).IsEmpty())
+
+	require.NoError(t, app.Dao().RestoreComment(&trashed))
+	assert.False(t, app.Dao().FindComment(1002).IsEmpty())
+	assert.False(t, app.Dao().FindComment(1004).IsEmpty())
+}
+
+func TestRestorePage(t *testing.T) {
+	app, _ := test.NewTestApp()
+	defer app.Cleanup()
+
+	page := app.Dao().FindPageByID(1001)
+	require.NoError(t, app.Dao().DelPage(&page))
+	assert.True(t, app.Dao().FindComment(1007).IsEmpty())
+	assert.Equal(t, 0, app.Dao().GetVoteNum(1001, string(entity.VoteTypePageDown)))
+
+	trashed := app.Dao().FindTrashedPage(1001)
+	require.NoError(t, app.Dao().RestorePage(&trashed))
+
+	assert.False(t, app.Dao().FindPage(page.Key, page.SiteName).IsEmpty())
+	assert.False(t, app.Dao().FindComment(1006).IsEmpty())
+	assert.False(t, app.Dao().FindComment(1007).IsEmpty())
+	assert.Equal(t, 2, app.Dao().GetVoteNum(1001, string(entity.VoteTypePageDown)))
+}
+
+func TestRestoreConflict(t *testing.T) {
+	app, _ := test.NewTestApp()
+	defer app.Cleanup()
+
+	page := app.Dao().FindPageByID(1000)
+	require.NoError(t, app.Dao().DelPage(&page))
+
+	// the page is created again before restoring
+	app.Dao().FindCreatePage(page.Key, page.Title, page.SiteName)
+
+	trashed := app.Dao().FindTrashedPage(1000)
+	assert.ErrorIs(t, app.Dao().RestorePage(&trashed), dao.ErrRestoreConflict)
+}
+
+func TestRestoreSite(t *testing.T) {
+	app, _ := test.NewTestApp()
+	defer app.Cleanup()
+
+	site := app.Dao().FindSiteByID(1000)
+	require.NoError(t, app.Dao().DelSite(&site))
+	assert.True(t, app.Dao().FindSite(site.Name).IsEmpty())
+
+	trashed := app.Dao().FindTrashedSiteByName(site.Name)
+	require.NoError(t, app.Dao().RestoreSite(&trashed))
+
+	assert.False(t, app.Dao().FindSite(site.Name).IsEmpty())
+	assert.False(t, app.Dao().FindPageByID(1000).IsEmpty())
+	assert.False(t, app.Dao().FindComment(1004).IsEmpty())
+}
+
+func TestFindCreateSiteInTrash(t *testing.T) {
+	app, _ := test.NewTestApp()
+	defer app.Cleanup()
+
+	site := app.Dao().FindSiteByID(1000)
+	require.NoError(t, app.Dao().DelSite(&site))
+
+	// the site name is unique, so the site in the trash is taken back
+	created := app.Dao().FindCreateSite(site.Name, "")
+	assert.Equal(t, site.ID, created.ID)
+	assert.False(t, app.Dao().FindSite(site.Name).IsEmpty())
+
+	// but its pages are kept in the trash
+	assert.True(t, app.Dao().FindPageByID(1000).IsEmpty())
+	assert.False(t, app.Dao().FindTrashedPage(1000).IsEmpty())
+}
+
+func TestRestoreUser(t *testing.T) {
+	app, _ := test.NewTestApp()
+	defer app.Cleanup()
+
+	user := app.Dao().FindUserByID(1001)
+	require.NoError(t, app.Dao().DelUser(&user))
+	assert.True(t, app.Dao().FindComment(1001).IsEmpty())
+	assert.True(t, app.Dao().FindComment(1002).IsEmpty())
+
+	trashed := app.Dao().FindTrashedUser(1001)
+	require.NoError(t, app.Dao().RestoreUser(&trashed))
+
+	assert.False(t, app.Dao().FindUserByID(1001).IsEmpty())
+	for _, id := range []uint{1001, 1002, 1003, 1004, 1006} {
+		assert.False(t, app.Dao().FindComment(id).IsEmpty(), "comment %d should be restored", id)
+	}
+}
+
+func TestPurgeComment(t *testing.T) {
+	app, _ := test.NewTestApp()
+	defer app.Cleanup()
+
+	comment := app.Dao().FindComment(1000)
+	require.NoError(t, app.Dao().DelComment(&comment))
+
+	trashed := app.Dao().FindTrashedComment(1000)
+	require.NoError(t, app.Dao().PurgeComment(&trashed))
+
+	assert.Equal(t, int64(0), countUnscoped(app, &entity.Comment{}, "id IN ?", []uint{1000, 1001, 1002, 1003, 1004}))
+	assert.Equal(t, int64(0), countUnscoped(app, &entity.Vote{}, "target_id = ? AND type LIKE ?", 1000, "comment_%"))
+	assert.Equal(t, int64(0), countUnscoped(app, &entity.Notify{}, "comment_id IN ?", []uint{1000, 1001}))
+	assert.False(t, app.Dao().FindComment(1005).IsEmpty(), "the other comments should not be purged")
+}
+
+func TestPurgeTrash(t *testing.T) {
+	app, _ := test.NewTestApp()
+	defer app.Cleanup()
+
+	page := app.Dao().FindPageByID(1001)
+	require.NoError(t, app.Dao().DelPage(&page))
+
+	// the records deleted after the time are kept
+	require.NoError(t, app.Dao().PurgeTrash(time.Now().Add(-time.Hour)))
+	assert.False(t, app.Dao().FindTrashedPage(1001).IsEmpty())
+
+	require.NoError(t, app.Dao().PurgeTrash(time.Now().Add(time.Second)))
+	assert.Equal(t, int64(0), countUnscoped(app, &entity.Page{}, "id = ?", 1001))
+	assert.Equal(t, int64(0), countUnscoped(app, &entity.Comment{}, "id IN ?", []uint{1006, 1007}))
+	assert.Equal(t, int64(0), countUnscoped(app, &entity.Vote{}, "target_id = ? AND type LIKE ?", 1001, "page_%"))
+	assert.False(t, app.Dao().FindPageByID(1000).IsEmpty(), "the active records should not be purged")
+}
+
+func TestPurgeWebhookDeliveries(t *testing.T) {
+	app, _ := test.NewTestApp()
+	defer app.Cleanup()
+
+	success := entity.WebhookDelivery{URL: "https://example.com", Status: entity.WebhookDeliveryStatusSuccess}
+	pending := entity.WebhookDelivery{URL: "https://example.com", Status: entity.WebhookDeliveryStatusPending}
+	require.NoError(t, app.Dao().SaveWebhookDelivery(&success))
+	require.NoError(t, app.Dao().SaveWebhookDelivery(&pending))
+
+	// the deliveries updated after the time are kept
+	require.NoError(t, app.Dao().PurgeWebhookDeliveries(time.Now().Add(-time.Hour)))
+	assert.False(t, app.Dao().FindWebhookDelivery(success.ID).IsEmpty())
+
+	require.NoError(t, app.Dao().PurgeWebhookDeliveries(time.Now().Add(time.Second)))
+	assert.Equal(t, int64(0), countUnscoped(app, &entity.WebhookDelivery{}, "id = ?", success.ID))
+	assert.False(t, app.Dao().FindWebhookDelivery(pending.ID).IsEmpty(), "the pending deliveries should not be purged")
+}
+
+func TestPurgeEmailTasks(t *testing.T) {
+	app, _ := test.NewTestApp()
+	defer app.Cleanup()
+
+	sent := entity.EmailTask{ToAddr: "test@example.com", Status: entity.EmailTaskStatusSent}
+	failed := entity.EmailTask{ToAddr: "test@example.com", Status: entity.EmailTaskStatusFailed}
+	pending := entity.EmailTask{ToAddr: "test@example.com", Status: entity.EmailTaskStatusPending}
+	for _, task := range []*entity.EmailTask{&sent, &failed, &pending} {
+		require.NoError(t, app.Dao().SaveEmailTask(task))
+	}
+
+	// the tasks updated after the time are kept
+	require.NoError(t, app.Dao().PurgeEmailTasks(time.Now().Add(-time.Hour)))
+	assert.False(t, app.Dao().FindEmailTask(sent.ID).IsEmpty())
+
+	require.NoError(t, app.Dao().PurgeEmailTasks(time.Now().Add(time.Second)))
+	assert.Equal(t, int64(0), countUnscoped(app, &entity.EmailTask{}, "id IN ?", []uint{sent.ID, failed.ID}))
+	assert.False(t, app.Dao().FindEmailTask(pending.ID).IsEmpty(), "the pending tasks should not be purged")
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/artalkjs/artalk/v2/internal/entity"
 	"github.com/artalkjs/artalk/v2/internal/log"
+	"github.com/samber/lo"
 )
 
 // 更新评论
@@ -21,6 +22,47 @@ func (dao *Dao) UpdateComment(comment *entity.Comment) error {
 	return err
 }
 
+// Update a column of the comments in batch
+//
+// The fields of the passed comments are not changed, the caller should update them if needed.
+func (dao *Dao) UpdateCommentsColumn(comments []entity.Comment, column string, value any) error {
+	ids := lo.Map(comments, func(c entity.Comment, _ int) uint { return c.ID })
+	for _, chunk := range lo.Chunk(ids, 500) {
+		if err := dao.DB().Model(&entity.Comment{}).Where("id IN (?)", chunk).Update(column, value).Error; err != nil {
+			return err
+		}
+	}
+
+	// 删除缓存 (one call for all the comments)
+	dao.CacheAction(func(cache *DaoCache) {
+		cache.CommentsFieldsCacheDel(comments)
+	})
+	return nil
+}
+
+// 编辑评论内容 (保留编辑前的内容为历史版本)
+func (dao *Dao) UpdateCommentContent(comment *entity.Comment, content string, editorID uint) error {
+	if comment.Content == content {
+		return nil // content not changed, no need to create a revision
+	}
+
+	revision := entity.CommentRevision{
+		CommentID: comment.ID,
+		UserID:    editorID,
+		Content:   comment.Content,
+	}
+	if err := dao.DB().Create(&revision).Error; err != nil {
+		log.Error("Create CommentRevision error: ", err)
+		return err
+	}
+
+	nowTime := time.Now()
+	comment.Content = content
+	comment.EditedAt = &nowTime
+
+	return dao.UpdateComment(comment)
+}
+
 func (dao *Dao) UpdateSite(site *entity.Site) error {
 	err := dao.DB().Save(site).Error
 	if err != nil {
@@ -80,3 +122,18 @@ func (dao *Dao) UpdateAuthIdentity(authIdentity *entity.AuthIdentity) error {
 	// })
 	return err
 }
+
+// Replace all the site roles of the user (site name => role)
+func (dao *Dao) SetUserSiteRoles(userID uint, roles map[string]entity.SiteRole) error {
+	return dao.Transaction(func(tx *Dao) error {
+		if err := tx.DB().Unscoped().Where("user_id = ?", userID).Delete(&entity.UserSiteRole{}).Error; err != nil {
+			return err
+		}
+		for siteName, role := range roles {
+			if err := tx.DB().Create(&entity.UserSiteRole{UserID: userID, SiteName: siteName, Role: role}).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
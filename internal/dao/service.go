@@ -195,6 +195,7 @@ func (dao *Dao) VoteSync() {
 		voteDown := dao.GetVoteNum(c.ID, string(entity.VoteTypeCommentDown))
 		c.VoteUp = int(voteUp)
 		c.VoteDown = int(voteDown)
+		c.Reactions = dao.GetReactionNums(c.ID)
 		dao.UpdateComment(&c)
 	}
 
@@ -0,0 +1,71 @@
+package dao
+
+import (
+	"fmt"
+
+	"github.com/artalkjs/artalk/v2/internal/entity"
+)
+
+// The moderation history of the comments of the user
+type UserCommentStats struct {
+	Approved int64 // The comments approved by the moderators
+	Rejected int64 // The comments rejected by the moderators or the anti-spam rules
+}
+
+// Get the moderation history of the comments of the user (cached)
+//
+// It is counted by the recorded moderation decisions (see `SaveCommentModeration`),
+// so the comments never reviewed or withdrawn by the user are not counted,
+// and the decisions are kept after the comments are purged.
+func (dao *Dao) GetUserCommentStats(userID uint) UserCommentStats {
+	stats, _ := QueryDBWithCache(dao, fmt.Sprintf(UserCommentStatsKey, userID), func() (stats UserCommentStats, err error) {
+		dao.DB().Model(&entity.CommentModeration{}).
+			Where("user_id = ? AND decision = ?", userID, entity.CommentModerationApproved).Count(&stats.Approved)
+		dao.DB().Model(&entity.CommentModeration{}).
+			Where("user_id = ? AND decision = ?", userID, entity.CommentModerationRejected).Count(&stats.Rejected)
+		return stats, nil
+	})
+	return stats
+}
+
+// Record the moderation decision of the comment (the previous decision is replaced)
+func (dao *Dao) SaveCommentModeration(comment *entity.Comment, decision entity.CommentModerationDecision) error {
+	var moderation entity.CommentModeration
+	dao.DB().Where("comment_id = ?", comment.ID).First(&moderation)
+
+	moderation.CommentID = comment.ID
+	moderation.UserID = comment.UserID
+	moderation.Decision = decision
+	err := dao.DB().Save(&moderation).Error
+
+	dao.CacheAction(func(cache *DaoCache) {
+		cache.UserCommentStatsCacheDel(comment.UserID)
+	})
+
+	return err
+}
+
+// Check the user is trusted, the comments of the trusted users skip the default pending
+//
+// The trust level of the user takes precedence, otherwise (the `auto` level)
+// the user is trusted after `minApproved` approved comments with no rejected ones.
+// No user is trusted automatically if `minApproved` is not positive.
+func (dao *Dao) IsUserTrusted(user entity.User, minApproved int) bool {
+	if user.IsEmpty() {
+		return false
+	}
+
+	switch user.TrustLevel {
+	case entity.UserTrustLevelTrusted:
+		return true
+	case entity.UserTrustLevelUntrusted:
+		return false
+	}
+
+	if minApproved <= 0 {
+		return false
+	}
+
+	stats := dao.GetUserCommentStats(user.ID)
+	return stats.Rejected == 0 && stats.Approved >= int64(minApproved)
+}
@@ -0,0 +1,90 @@
+package dao_test
+
+import (
+	"testing"
+
+	"github.com/artalkjs/artalk/v2/internal/dao"
+	"github.com/artalkjs/artalk/v2/internal/entity"
+	"github.com/artalkjs/artalk/v2/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsUserTrusted(t *testing.T) {
+	app, _ := test.NewTestApp()
+	defer app.Cleanup()
+
+	// no moderation decision is recorded for the fixture comments
+	user := app.Dao().FindUserByID(1002)
+	assert.Equal(t, dao.UserCommentStats{}, app.Dao().GetUserCommentStats(user.ID))
+	assert.False(t, app.Dao().IsUserTrusted(user, 1), "the unreviewed comments are not counted")
+
+	t.Run("Approved", func(t *testing.T) {
+		comment := app.Dao().FindComment(1007)
+		require.NoError(t, app.Dao().SaveCommentModeration(&comment, entity.CommentModerationApproved))
+		assert.Equal(t, dao.UserCommentStats{Approved: 1}, app.Dao().GetUserCommentStats(user.ID), "the cache is invalidated")
+
+		// the decision of the comment is replaced rather than counted twice
+		require.NoError(t, app.Dao().SaveCommentModeration(&comment, entity.CommentModerationApproved))
+		assert.Equal(t, dao.UserCommentStats{Approved: 1}, app.Dao().GetUserCommentStats(user.ID))
+
+		assert.True(t, app.Dao().IsUserTrusted(user, 1))
+		assert.False(t, app.Dao().IsUserTrusted(user, 2))
+		assert.False(t, app.Dao().IsUserTrusted(user, 0), "disabled")
+		assert.False(t, app.Dao().IsUserTrusted(entity.User{}, 1), "empty user")
+	})
+
+	t.Run("Withdrawn", func(t *testing.T) {
+		comment := entity.Comment{Content: "oops", PageKey: "/test/1000.html", SiteName: "Site A", UserID: user.ID}
+		require.NoError(t, app.Dao().CreateComment(&comment))
+		require.NoError(t, app.Dao().DelComment(&comment))
+		require.NoError(t, app.Dao().PurgeComment(&comment))
+		assert.Equal(t, dao.UserCommentStats{Approved: 1}, app.Dao().GetUserCommentStats(user.ID), "the deletions are not rejections")
+	})
+
+	t.Run("Rejected", func(t *testing.T) {
+		comment := entity.Comment{Content: "spam", PageKey: "/test/1000.html", SiteName: "Site A", UserID: user.ID, IsPending: true}
+		require.NoError(t, app.Dao().CreateComment(&comment))
+		require.NoError(t, app.Dao().SaveCommentModeration(&comment, entity.CommentModerationRejected))
+		assert.Equal(t, dao.UserCommentStats{Approved: 1, Rejected: 1}, app.Dao().GetUserCommentStats(user.ID))
+		assert.False(t, app.Dao().IsUserTrusted(user, 1))
+
+		// the decision is kept after the comment is purged
+		require.NoError(t, app.Dao().DelComment(&comment))
+		require.NoError(t, app.Dao().PurgeComment(&comment))
+		assert.Equal(t, dao.UserCommentStats{Approved: 1, Rejected: 1}, app.Dao().GetUserCommentStats(user.ID))
+	})
+
+	t.Run("Override", func(t *testing.T) {
+		user.TrustLevel = entity.UserTrustLevelTrusted
+		assert.True(t, app.Dao().IsUserTrusted(user, 0), "trusted despite the rejection")
+
+		userA := app.Dao().FindUserByID(1001)
+		assert.Equal(t, entity.UserTrustLevelAuto, userA.TrustLevel, "auto by default")
+		assert.False(t, app.Dao().IsUserTrusted(userA, 1))
+
+		userA.TrustLevel = entity.UserTrustLevelTrusted
+		assert.True(t, app.Dao().IsUserTrusted(userA, 1))
+
+		userA.TrustLevel = entity.UserTrustLevelUntrusted
+		assert.False(t, app.Dao().IsUserTrusted(userA, 0))
+	})
+}
+
+func TestMigrateCommentModerations(t *testing.T) {
+	app, _ := test.NewTestApp()
+	defer app.Cleanup()
+
+	// upgrade from the version without the moderation decisions
+	require.NoError(t, app.Dao().DB().Migrator().DropTable(&entity.CommentModeration{}))
+	app.Dao().MigrateCommentModerations()
+
+	// userB has an approved comment (1005) and a pending comment (1007)
+	assert.Equal(t, dao.UserCommentStats{Approved: 1}, app.Dao().GetUserCommentStats(1002), "the approved comments are backfilled")
+
+	// only backfilled once
+	app.Dao().MigrateCommentModerations()
+	var count int64
+	app.Dao().DB().Model(&entity.CommentModeration{}).Where("user_id = ?", 1002).Count(&count)
+	assert.Equal(t, int64(1), count)
+}
@@ -0,0 +1,24 @@
+package dao
+
+import (
+	"time"
+
+	"github.com/artalkjs/artalk/v2/internal/entity"
+)
+
+func (dao *Dao) FindWebhookDelivery(id uint) entity.WebhookDelivery {
+	var delivery entity.WebhookDelivery
+	dao.DB().Where("id = ?", id).First(&delivery)
+	return delivery
+}
+
+func (dao *Dao) SaveWebhookDelivery(delivery *entity.WebhookDelivery) error {
+	return dao.DB().Save(delivery).Error
+}
+
+// Delete the finished deliveries which are last updated before the specific time
+//
+// The pending ones are kept, they may still be delivered or be redelivered.
+func (dao *Dao) PurgeWebhookDeliveries(before time.Time) error {
+	return dao.purgeWhere(&entity.WebhookDelivery{}, "updated_at < ? AND status <> ?", before, entity.WebhookDeliveryStatusPending)
+}
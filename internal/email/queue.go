@@ -1,17 +1,39 @@
 package email
 
 import (
+	"cmp"
 	"fmt"
+	"math"
 	"sync"
+	"time"
 
 	"github.com/artalkjs/artalk/v2/internal/config"
+	"github.com/artalkjs/artalk/v2/internal/dao"
+	"github.com/artalkjs/artalk/v2/internal/entity"
 	"github.com/artalkjs/artalk/v2/internal/log"
 )
 
+// The default delay before the first retry (in seconds)
+const defaultRetryDelay = 60
+
+// The default number of retries for the failed email
+const defaultMaxRetries = 3
+
+// The unit of the retry delay (changed in tests)
+var retryDelayUnit = time.Second
+
+// The email claimed longer than the lease is considered interrupted while sending,
+// the shorter ones may be still being sent by the queue before the app restart
+var sendingLease = 10 * time.Minute
+
 type EmailConf struct {
 	config.EmailConf
 	Sender        Sender
 	OnSendSuccess func(email *Email)
+
+	// The emails are persisted and retried if the dao is given,
+	// otherwise they are kept in memory and dropped if failed
+	Dao *dao.Dao
 }
 
 type EmailQueue struct {
@@ -51,18 +73,34 @@ func NewQueue(conf EmailConf) *EmailQueue {
 		}
 	}()
 
+	// resume the emails left by the last run
+	if conf.Dao != nil {
+		queue.resume()
+	}
+
 	return queue
 }
 
 func (q *EmailQueue) handleEmail(email *Email) {
 	log.Debug(fmt.Sprintf("[Email] Sending an email %+v: ", email))
 
+	// the email may be queued more than once (e.g. resumed after restart), only the first one is sent
+	if email.TaskID != 0 && !q.conf.Dao.ClaimEmailTask(email.TaskID) {
+		return
+	}
+
+	isOK := false
 	if q.sender == nil {
 		log.Error("[Email] Email Sender is nil")
-		return
+	} else {
+		isOK = q.sender.Send(email)
 	}
 
-	if isOK := q.sender.Send(email); isOK {
+	if email.TaskID != 0 {
+		q.saveResult(email, isOK)
+	}
+
+	if isOK {
 		if q.conf.OnSendSuccess != nil {
 			q.conf.OnSendSuccess(email)
 		}
@@ -71,8 +109,140 @@ func (q *EmailQueue) handleEmail(email *Email) {
 	}
 }
 
+// Record the result of the attempt
+//
+// The failed email is retried with exponential backoff,
+// and it is marked as failed when the max retries is exceeded.
+func (q *EmailQueue) saveResult(email *Email, isOK bool) {
+	task := q.conf.Dao.FindEmailTask(email.TaskID)
+	if task.IsEmpty() {
+		return
+	}
+
+	now := time.Now()
+	task.Attempts++
+	switch {
+	case isOK:
+		task.Status = entity.EmailTaskStatusSent
+		task.SentAt = &now
+		task.Error = ""
+	case q.sender == nil:
+		task.Status = entity.EmailTaskStatusFailed
+		task.Error = "Email sender is not initialized"
+	case task.Attempts > cmp.Or(q.conf.Queue.MaxRetries, defaultMaxRetries):
+		task.Status = entity.EmailTaskStatusFailed
+		task.Error = "Failed to send the email (max retries exceeded)"
+	default:
+		delay := time.Duration(cmp.Or(q.conf.Queue.RetryDelay, defaultRetryDelay)) * retryDelayUnit
+		nextAttemptAt := now.Add(delay * time.Duration(math.Pow(2, float64(task.Attempts-1))))
+		task.Status = entity.EmailTaskStatusPending
+		task.NextAttemptAt = &nextAttemptAt
+		task.Error = "Failed to send the email"
+	}
+
+	if err := q.conf.Dao.SaveEmailTask(&task); err != nil {
+		log.Error("[Email] Save email task failed: ", err)
+		return
+	}
+
+	if task.Status == entity.EmailTaskStatusPending {
+		q.enqueueAt(email, task.NextAttemptAt)
+	}
+}
+
+// Queue the pending emails persisted by the last run
+//
+// The emails interrupted while sending are sent again after the lease is expired.
+func (q *EmailQueue) resume() {
+	for _, task := range q.conf.Dao.FindPendingEmailTasks() {
+		q.enqueueAt(q.taskToEmail(&task), task.NextAttemptAt)
+	}
+
+	for _, task := range q.conf.Dao.FindSendingEmailTasks() {
+		q.resumeSending(task)
+	}
+}
+
+// Send the email again if it is still sending when the lease of the claim is expired
+func (q *EmailQueue) resumeSending(task entity.EmailTask) {
+	expiresAt := task.UpdatedAt.Add(sendingLease)
+
+	time.AfterFunc(time.Until(expiresAt), func() {
+		q.mux.Lock()
+		closed := q.closed
+		q.mux.Unlock()
+		if closed {
+			return // the task is left to the queue after restart
+		}
+
+		if q.conf.Dao.ResetSendingEmailTask(task.ID, time.Now().Add(-sendingLease)) {
+			q.enqueue(q.taskToEmail(&task))
+		} else if latest := q.conf.Dao.FindEmailTask(task.ID); latest.Status == entity.EmailTaskStatusSending {
+			q.resumeSending(latest) // claimed again
+		}
+	})
+}
+
+// Queue the email at the time (immediately if nil or passed)
+func (q *EmailQueue) enqueueAt(email *Email, at *time.Time) {
+	if at == nil || !at.After(time.Now()) {
+		go q.enqueue(email)
+		return
+	}
+
+	time.AfterFunc(time.Until(*at), func() {
+		q.enqueue(email)
+	})
+}
+
 // Add an email to the sending queue
+//
+// The email is persisted before queued if the dao is given.
 func (q *EmailQueue) Push(email *Email) {
+	if q.conf.Dao != nil && email.TaskID == 0 {
+		task := entity.EmailTask{
+			FromAddr: email.FromAddr,
+			FromName: email.FromName,
+			ToAddr:   email.ToAddr,
+			Subject:  email.Subject,
+			Body:     email.Body,
+			Status:   entity.EmailTaskStatusPending,
+		}
+		if email.LinkedNotify != nil {
+			task.NotifyID = email.LinkedNotify.ID
+		}
+		if err := q.conf.Dao.SaveEmailTask(&task); err != nil {
+			log.Error("[Email] Save email task failed: ", err)
+		} else {
+			email.TaskID = task.ID
+		}
+	}
+
+	q.enqueue(email)
+}
+
+// Send the persisted email again (the attempts are reset)
+func (q *EmailQueue) Resend(task *entity.EmailTask) error {
+	if q.conf.Dao == nil {
+		return fmt.Errorf("the email queue is not persisted")
+	}
+
+	task.Status = entity.EmailTaskStatusPending
+	task.Attempts = 0
+	task.NextAttemptAt = nil
+	task.Error = ""
+	if err := q.conf.Dao.SaveEmailTask(task); err != nil {
+		return err
+	}
+
+	q.enqueue(q.taskToEmail(task))
+	return nil
+}
+
+func (q *EmailQueue) enqueue(email *Email) {
+	q.mux.Lock()
+	defer q.mux.Unlock()
+
 	if q.closed {
 		log.Error("[Email] Queue closed, dropping email")
 		return
@@ -81,6 +251,23 @@ func (q *EmailQueue) Push(email *Email) {
 	q.ch <- email
 }
 
+func (q *EmailQueue) taskToEmail(task *entity.EmailTask) *Email {
+	email := &Email{
+		FromAddr: task.FromAddr,
+		FromName: task.FromName,
+		ToAddr:   task.ToAddr,
+		Subject:  task.Subject,
+		Body:     task.Body,
+		TaskID:   task.ID,
+	}
+	if task.NotifyID != 0 {
+		if notify := q.conf.Dao.FindNotifyByID(task.NotifyID); !notify.IsEmpty() {
+			email.LinkedNotify = &notify
+		}
+	}
+	return email
+}
+
 func (q *EmailQueue) Close() {
 	q.mux.Lock()
 	defer q.mux.Unlock()
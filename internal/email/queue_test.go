@@ -5,7 +5,11 @@ import (
 	"time"
 
 	"github.com/artalkjs/artalk/v2/internal/config"
+	"github.com/artalkjs/artalk/v2/internal/dao"
+	"github.com/artalkjs/artalk/v2/internal/db"
+	"github.com/artalkjs/artalk/v2/internal/entity"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestQueue(t *testing.T) {
@@ -59,6 +63,106 @@ func TestQueue(t *testing.T) {
 		assert.False(t, sendSuccessHookTriggered, "Send success hook should not be triggered")
 	})
 
+	t.Run("Persist and retry", func(t *testing.T) {
+		ddb, err := db.NewTestDB()
+		require.NoError(t, err)
+		defer db.CloseDB(ddb)
+		d := dao.NewDao(ddb)
+
+		defer func(unit time.Duration) { retryDelayUnit = unit }(retryDelayUnit)
+		retryDelayUnit = time.Millisecond
+
+		notify := entity.Notify{UserID: 1, CommentID: 1}
+		require.NoError(t, d.DB().Create(&notify).Error)
+
+		sender := &mockSender{SendResult: false}
+		sentNotifyIDs := make(chan uint, 1)
+		q := NewQueue(EmailConf{
+			EmailConf: config.EmailConf{Queue: config.EmailQueueConf{MaxRetries: 1, RetryDelay: 300}},
+			Sender:    sender,
+			Dao:       d,
+			OnSendSuccess: func(email *Email) {
+				sentNotifyIDs <- email.LinkedNotify.ID
+			},
+		})
+		defer q.Close()
+
+		email := testEmail
+		email.LinkedNotify = &notify
+		q.Push(&email)
+		require.NotZero(t, email.TaskID, "email should be persisted")
+
+		waitStatus := func(t *testing.T, attempts int) entity.EmailTask {
+			var task entity.EmailTask
+			require.Eventually(t, func() bool {
+				task = d.FindEmailTask(email.TaskID)
+				return task.Attempts == attempts && task.Status != entity.EmailTaskStatusSending
+			}, 2*time.Second, 10*time.Millisecond)
+			return task
+		}
+
+		// retry later with backoff
+		task := waitStatus(t, 1)
+		assert.Equal(t, entity.EmailTaskStatusPending, task.Status)
+		assert.Equal(t, notify.ID, task.NotifyID)
+		require.NotNil(t, task.NextAttemptAt)
+		assert.WithinDuration(t, time.Now().Add(300*time.Millisecond), *task.NextAttemptAt, 300*time.Millisecond)
+
+		// failed after the max retries is exceeded
+		task = waitStatus(t, 2)
+		assert.Equal(t, entity.EmailTaskStatusFailed, task.Status)
+		assert.NotEmpty(t, task.Error)
+
+		// resume the emails interrupted while sending after restart
+		defer func(lease time.Duration) { sendingLease = lease }(sendingLease)
+		sendingLease = 500 * time.Millisecond
+
+		pending := entity.EmailTask{ToAddr: testEmail.ToAddr, Status: entity.EmailTaskStatusSending}
+		require.NoError(t, d.SaveEmailTask(&pending))
+		require.NoError(t, d.DB().Model(&pending).UpdateColumn("updated_at", time.Now().Add(-time.Minute)).Error)
+		sending := entity.EmailTask{ToAddr: testEmail.ToAddr, Status: entity.EmailTaskStatusSending}
+		require.NoError(t, d.SaveEmailTask(&sending))
+		q2 := NewQueue(EmailConf{Sender: &mockSender{SendResult: true}, Dao: d})
+		defer q2.Close()
+		require.Eventually(t, func() bool {
+			return d.FindEmailTask(pending.ID).Status == entity.EmailTaskStatusSent
+		}, 2*time.Second, 10*time.Millisecond)
+		assert.Equal(t, entity.EmailTaskStatusSending, d.FindEmailTask(sending.ID).Status, "the lease is not expired (may be still being sent)")
+		require.Eventually(t, func() bool {
+			return d.FindEmailTask(sending.ID).Status == entity.EmailTaskStatusSent
+		}, 2*time.Second, 10*time.Millisecond, "sent after the lease is expired")
+
+		// resend the failed email
+		sender.SendResult = true
+		require.NoError(t, q.Resend(&task))
+		task = waitStatus(t, 1)
+		assert.Equal(t, entity.EmailTaskStatusSent, task.Status)
+		assert.NotNil(t, task.SentAt)
+		assert.Equal(t, notify.ID, <-sentNotifyIDs, "the linked notify should be passed to the success hook")
+	})
+
+	t.Run("Default max retries", func(t *testing.T) {
+		ddb, err := db.NewTestDB()
+		require.NoError(t, err)
+		defer db.CloseDB(ddb)
+		d := dao.NewDao(ddb)
+
+		defer func(unit time.Duration) { retryDelayUnit = unit }(retryDelayUnit)
+		retryDelayUnit = time.Millisecond
+
+		q := NewQueue(EmailConf{Sender: &mockSender{SendResult: false}, Dao: d})
+		defer q.Close()
+
+		email := testEmail
+		q.Push(&email)
+		require.NotZero(t, email.TaskID)
+
+		require.Eventually(t, func() bool {
+			return d.FindEmailTask(email.TaskID).Status == entity.EmailTaskStatusFailed
+		}, 3*time.Second, 10*time.Millisecond)
+		assert.Equal(t, defaultMaxRetries+1, d.FindEmailTask(email.TaskID).Attempts, "retried if the max retries is not set")
+	})
+
 	t.Run("Close", func(t *testing.T) {
 		q := NewQueue(EmailConf{
 			EmailConf: config.EmailConf{},
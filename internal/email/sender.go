@@ -14,6 +14,7 @@ type Email struct {
 	Subject      string
 	Body         string
 	LinkedNotify *entity.Notify
+	TaskID       uint // The ID of the persisted email task (0 if not persisted)
 }
 
 // Sender is an interface for sending email.
@@ -0,0 +1,30 @@
+package entity
+
+import (
+	"gorm.io/gorm"
+)
+
+// The verdict of the anti-spam check of a comment
+//
+// Only the latest verdict is kept for each comment.
+type AntiSpamVerdict struct {
+	gorm.Model
+	CommentID uint    `gorm:"uniqueIndex"`
+	Pass      bool    `gorm:"default:false"`
+	Checker   string  `gorm:"size:255"` // The checker names (joined by comma if multiple checkers passed)
+	Score     float64 `gorm:"default:0"`
+	Reason    string  `gorm:"type:text"`
+	Matched   string  `gorm:"type:text"` // The matched keywords or rules
+	Response  string  `gorm:"type:text"` // The summary of the raw API response
+
+	// The request metadata at check time (for reporting the moderation decision to the services)
+	UserIP    string `gorm:"size:255"`
+	UserAgent string `gorm:"type:text"`
+	Referrer  string `gorm:"type:text"`
+
+	Feedback string `gorm:"size:32"` // The moderation decision reported to the services (spam or ham), empty if not reported
+}
+
+func (v AntiSpamVerdict) IsEmpty() bool {
+	return v.ID == 0
+}
@@ -0,0 +1,11 @@
+package entity
+
+type CookedAntiSpamVerdict struct {
+	Pass     bool    `json:"pass"`
+	Checker  string  `json:"checker"`
+	Score    float64 `json:"score"`
+	Reason   string  `json:"reason"`
+	Matched  string  `json:"matched"`
+	Response string  `json:"response"`
+	Date     string  `json:"date"`
+}
@@ -18,6 +18,9 @@ type Artran struct {
 	VoteUp   string `json:"vote_up"`
 	VoteDown string `json:"vote_down"`
 
+	// reaction
+	Reactions string `json:"reactions,omitempty"` // JSON object of the count of each emoji reaction, e.g. {"👍":2}
+
 	// date
 	CreatedAt string `json:"created_at"`
 	UpdatedAt string `json:"updated_at"`
@@ -0,0 +1,37 @@
+package entity
+
+import (
+	"gorm.io/gorm"
+)
+
+// The token of the naive Bayes spam classifier
+//
+// The counts are the numbers of the trained documents (comments) containing the token,
+// and the token `BayesTotalToken` holds the total numbers of the trained documents.
+type BayesToken struct {
+	gorm.Model
+	Token string `gorm:"uniqueIndex;size:191"`
+	Spam  int64  `gorm:"default:0"`
+	Ham   int64  `gorm:"default:0"`
+}
+
+const BayesTotalToken = ""
+
+func (t BayesToken) IsEmpty() bool {
+	return t.ID == 0
+}
+
+// The comment which has been trained into the naive Bayes spam classifier
+//
+// The tokens are kept to untrain the comment when the label is changed
+// (the comment content may be edited after training).
+type BayesDocument struct {
+	gorm.Model
+	CommentID uint     `gorm:"uniqueIndex"`
+	IsSpam    bool     `gorm:"default:false"`
+	Tokens    []string `gorm:"type:text;serializer:json"`
+}
+
+func (d BayesDocument) IsEmpty() bool {
+	return d.ID == 0
+}
@@ -0,0 +1,140 @@
+package entity
+
+import (
+	"fmt"
+	"net/netip"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type BlocklistType string
+
+const (
+	BlocklistTypeIP          BlocklistType = "ip"           // The IP address or the CIDR range
+	BlocklistTypeEmail       BlocklistType = "email"        // The email address
+	BlocklistTypeEmailDomain BlocklistType = "email_domain" // The domain of the email address (including the subdomains)
+	BlocklistTypeUserID      BlocklistType = "user_id"      // The user ID
+	BlocklistTypeUA          BlocklistType = "ua"           // The regular expression of the user agent
+)
+
+var BlocklistTypes = []BlocklistType{BlocklistTypeIP, BlocklistTypeEmail,
+	BlocklistTypeEmailDomain, BlocklistTypeUserID, BlocklistTypeUA}
+
+func (t BlocklistType) IsValid() bool {
+	return slices.Contains(BlocklistTypes, t)
+}
+
+// The item of the blocklist
+//
+// The requests matching any active (not expired) item are rejected.
+type BlocklistItem struct {
+	gorm.Model
+	Type      BlocklistType `gorm:"index;size:32"`
+	Value     string        `gorm:"size:255"`
+	Reason    string        `gorm:"type:text"`
+	ExpiresAt *time.Time    `gorm:"index"` // Never expires if nil
+}
+
+func (b BlocklistItem) IsEmpty() bool {
+	return b.ID == 0
+}
+
+func (b BlocklistItem) IsExpired() bool {
+	return b.ExpiresAt != nil && time.Now().After(*b.ExpiresAt)
+}
+
+// Check the value is valid for the type
+func (b BlocklistItem) Validate() error {
+	if !b.Type.IsValid() {
+		return fmt.Errorf("invalid type: %s", b.Type)
+	}
+	if strings.TrimSpace(b.Value) == "" {
+		return fmt.Errorf("empty value")
+	}
+
+	var err error
+	switch b.Type {
+	case BlocklistTypeIP:
+		if strings.Contains(b.Value, "/") {
+			_, err = netip.ParsePrefix(b.Value)
+		} else {
+			_, err = netip.ParseAddr(b.Value)
+		}
+	case BlocklistTypeEmail:
+		if !strings.Contains(b.Value, "@") {
+			err = fmt.Errorf("missing @")
+		}
+	case BlocklistTypeUserID:
+		_, err = strconv.ParseUint(b.Value, 10, 64)
+	case BlocklistTypeUA:
+		_, err = regexp.Compile(b.Value)
+	}
+	if err != nil {
+		return fmt.Errorf("invalid %s: %w", b.Type, err)
+	}
+	return nil
+}
+
+// The request data checked by the blocklist, the empty fields are ignored
+type BlocklistTarget struct {
+	IP      string
+	Email   string
+	UserIDs []uint // The user IDs of the request (the users with the same email if not login)
+	UA      string
+}
+
+// Check the target matches the item (the expiry is not checked)
+func (b BlocklistItem) Match(t BlocklistTarget) bool {
+	value := strings.TrimSpace(b.Value)
+
+	switch b.Type {
+	case BlocklistTypeIP:
+		addr, err := netip.ParseAddr(t.IP)
+		if err != nil {
+			return false
+		}
+		addr = addr.Unmap()
+		if prefix, err := netip.ParsePrefix(value); err == nil {
+			return prefix.Contains(addr)
+		}
+		target, err := netip.ParseAddr(value)
+		return err == nil && target.Unmap() == addr
+	case BlocklistTypeEmail:
+		return t.Email != "" && strings.EqualFold(t.Email, value)
+	case BlocklistTypeEmailDomain:
+		_, domain, ok := strings.Cut(strings.ToLower(t.Email), "@")
+		value = strings.ToLower(strings.TrimPrefix(value, "@"))
+		return ok && value != "" && (domain == value || strings.HasSuffix(domain, "."+value))
+	case BlocklistTypeUserID:
+		return slices.ContainsFunc(t.UserIDs, func(id uint) bool {
+			return id != 0 && strconv.FormatUint(uint64(id), 10) == value
+		})
+	case BlocklistTypeUA:
+		re, err := compileBlocklistRegexp(value)
+		return t.UA != "" && err == nil && re.MatchString(t.UA)
+	}
+	return false
+}
+
+// The compiled regular expressions of the blocklist items (by the pattern)
+var blocklistRegexps sync.Map
+
+// Compile the regular expression once, as the items are checked on every request
+func compileBlocklistRegexp(pattern string) (*regexp.Regexp, error) {
+	if re, ok := blocklistRegexps.Load(pattern); ok {
+		return re.(*regexp.Regexp), nil
+	}
+
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, err
+	}
+	blocklistRegexps.Store(pattern, re)
+	return re, nil
+}
@@ -0,0 +1,11 @@
+package entity
+
+type CookedBlocklistItem struct {
+	ID        uint          `json:"id"`
+	Type      BlocklistType `json:"type"`
+	Value     string        `json:"value"`
+	Reason    string        `json:"reason"`
+	ExpiresAt string        `json:"expires_at"` // Empty if never expires
+	IsExpired bool          `json:"is_expired"`
+	Date      string        `json:"date"`
+}
@@ -1,9 +1,14 @@
 package entity
 
 import (
+	"time"
+
 	"gorm.io/gorm"
 )
 
+// The content placeholder of a withdrawn comment
+const CommentTombstoneContent = "[deleted]"
+
 type Comment struct {
 	gorm.Model
 
@@ -26,8 +31,16 @@ type Comment struct {
 	VoteUp   int
 	VoteDown int
 
+	Reactions map[string]int `gorm:"type:text;serializer:json"` // The count of each emoji reaction (synced from the votes)
+
 	RootID uint `gorm:"index"` // Root Node ID (can be derived from `Rid`)
 
+	EditedAt *time.Time // The last time the content was edited (nil if never edited)
+
+	IsTombstone bool `gorm:"default:false"` // Withdrawn by the author, but kept as a placeholder because of its replies
+
+	MentionUserIDs []uint `gorm:"type:text;serializer:json"` // The users mentioned by `@name` in the content
+
 	// Associated Page
 	//
 	// Use Composite Foreign Keys for multiple-site support.
@@ -41,6 +54,13 @@ func (c Comment) IsEmpty() bool {
 	return c.ID == 0
 }
 
+func (c Comment) IsEdited() bool {
+	return c.EditedAt != nil
+}
+
+// Whether the comment can be replied to
+//
+// If the page is preloaded, the replies are not allowed when the comments of the page are closed.
 func (c Comment) IsAllowReply() bool {
-	return !c.IsCollapsed && !c.IsPending
+	return !c.IsCollapsed && !c.IsPending && !c.IsTombstone && (c.Page == nil || !c.Page.IsClosed())
 }
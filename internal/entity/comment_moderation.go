@@ -0,0 +1,27 @@
+package entity
+
+import (
+	"gorm.io/gorm"
+)
+
+type CommentModerationDecision string
+
+const (
+	CommentModerationApproved CommentModerationDecision = "approved" // Approved by the moderators
+	CommentModerationRejected CommentModerationDecision = "rejected" // Rejected by the moderators or the anti-spam rules
+)
+
+// The moderation decision of a comment
+//
+// Only the latest decision is kept for each comment, and it is kept after the comment is purged,
+// so the moderation history of the user is not lost (see `dao.GetUserCommentStats`).
+type CommentModeration struct {
+	gorm.Model
+	CommentID uint                      `gorm:"uniqueIndex"`
+	UserID    uint                      `gorm:"index"` // The author of the comment
+	Decision  CommentModerationDecision `gorm:"size:16"`
+}
+
+func (m CommentModeration) IsEmpty() bool {
+	return m.ID == 0
+}
@@ -0,0 +1,39 @@
+package entity
+
+import (
+	"slices"
+
+	"gorm.io/gorm"
+)
+
+type CommentReportStatus string
+
+const (
+	CommentReportStatusOpen      CommentReportStatus = "open"      // Waiting for the moderators
+	CommentReportStatusDismissed CommentReportStatus = "dismissed" // The moderators consider the comment fine
+	CommentReportStatusResolved  CommentReportStatus = "resolved"  // The moderators took action on the comment
+)
+
+var CommentReportStatuses = []CommentReportStatus{CommentReportStatusOpen,
+	CommentReportStatusDismissed, CommentReportStatusResolved}
+
+func (s CommentReportStatus) IsValid() bool {
+	return slices.Contains(CommentReportStatuses, s)
+}
+
+// The report of an abusive comment submitted by a reader
+//
+// Each comment can be reported once by the same user or the same IP.
+type CommentReport struct {
+	gorm.Model
+	CommentID uint                `gorm:"index"`
+	UserID    uint                `gorm:"index"` // The reporter, 0 if not logged in
+	IP        string              `gorm:"index;size:255"`
+	UA        string              `gorm:"type:text"`
+	Reason    string              `gorm:"type:text"`
+	Status    CommentReportStatus `gorm:"index;size:32;default:open"`
+}
+
+func (r CommentReport) IsEmpty() bool {
+	return r.ID == 0
+}
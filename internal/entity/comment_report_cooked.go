@@ -0,0 +1,13 @@
+package entity
+
+type CookedCommentReport struct {
+	ID        uint                `json:"id"`
+	CommentID uint                `json:"comment_id"`
+	UserID    uint                `json:"user_id"`
+	UserName  string              `json:"user_name"`
+	IP        string              `json:"ip"`
+	UA        string              `json:"ua"`
+	Reason    string              `json:"reason"`
+	Status    CommentReportStatus `json:"status" enums:"open,dismissed,resolved"`
+	Date      string              `json:"date"`
+}
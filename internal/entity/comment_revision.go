@@ -0,0 +1,19 @@
+package entity
+
+import (
+	"gorm.io/gorm"
+)
+
+// The history content of a comment before being edited
+type CommentRevision struct {
+	gorm.Model
+
+	CommentID uint `gorm:"index"` // 被编辑的评论
+	UserID    uint `gorm:"index"` // 编辑者
+
+	Content string // 编辑前的评论内容
+}
+
+func (r CommentRevision) IsEmpty() bool {
+	return r.ID == 0
+}
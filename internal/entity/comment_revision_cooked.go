@@ -0,0 +1,11 @@
+package entity
+
+type CookedCommentRevision struct {
+	ID            uint   `json:"id"`
+	CommentID     uint   `json:"comment_id"`
+	UserID        uint   `json:"user_id"`
+	Nick          string `json:"nick"`
+	Content       string `json:"content"`
+	ContentMarked string `json:"content_marked"`
+	Date          string `json:"date"`
+}
@@ -0,0 +1,35 @@
+package entity
+
+import (
+	"slices"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type EmailDigestFrequency string
+
+const (
+	EmailDigestImmediate EmailDigestFrequency = "immediate" // Send one email per notification
+	EmailDigestDaily     EmailDigestFrequency = "daily"     // Send a summary of the unread notifications every day
+	EmailDigestWeekly    EmailDigestFrequency = "weekly"    // Send a summary of the unread notifications every week
+)
+
+var EmailDigestFrequencies = []EmailDigestFrequency{EmailDigestImmediate, EmailDigestDaily, EmailDigestWeekly}
+
+func (f EmailDigestFrequency) IsValid() bool {
+	return slices.Contains(EmailDigestFrequencies, f)
+}
+
+// The last run of the digests of the frequency
+//
+// The next run summarizes the notifies since then, so the notifies are not lost if some runs are missed.
+type EmailDigestRun struct {
+	gorm.Model
+	Frequency EmailDigestFrequency `gorm:"uniqueIndex;size:16"`
+	RanAt     time.Time
+}
+
+func (r EmailDigestRun) IsEmpty() bool {
+	return r.ID == 0
+}
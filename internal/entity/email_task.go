@@ -0,0 +1,47 @@
+package entity
+
+import (
+	"slices"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type EmailTaskStatus string
+
+const (
+	EmailTaskStatusPending EmailTaskStatus = "pending" // Waiting to be sent (including the retries)
+	EmailTaskStatusSending EmailTaskStatus = "sending"
+	EmailTaskStatusSent    EmailTaskStatus = "sent"
+	EmailTaskStatusFailed  EmailTaskStatus = "failed" // All the attempts are failed (dead letter)
+)
+
+var EmailTaskStatuses = []EmailTaskStatus{EmailTaskStatusPending, EmailTaskStatusSending,
+	EmailTaskStatusSent, EmailTaskStatusFailed}
+
+func (s EmailTaskStatus) IsValid() bool {
+	return slices.Contains(EmailTaskStatuses, s)
+}
+
+// The email in the sending queue
+//
+// The emails are persisted so they are not lost on restart,
+// and the failed ones are retried until the max retries is exceeded.
+type EmailTask struct {
+	gorm.Model
+	FromAddr      string
+	FromName      string
+	ToAddr        string          `gorm:"index"`
+	Subject       string          `gorm:"type:text"`
+	Body          string          `gorm:"type:text"`
+	NotifyID      uint            `gorm:"index"` // The linked notify which is flagged as emailed after sent (0 if not linked)
+	Status        EmailTaskStatus `gorm:"index;size:16"`
+	Attempts      int
+	NextAttemptAt *time.Time `gorm:"index"` // The time to retry the pending email
+	SentAt        *time.Time
+	Error         string `gorm:"type:text"` // The error of the last attempt
+}
+
+func (t EmailTask) IsEmpty() bool {
+	return t.ID == 0
+}
@@ -0,0 +1,18 @@
+package entity
+
+type CookedEmailTask struct {
+	ID            uint            `json:"id"`
+	FromAddr      string          `json:"from_addr"`
+	FromName      string          `json:"from_name"`
+	ToAddr        string          `json:"to_addr"`
+	Subject       string          `json:"subject"`
+	Body          string          `json:"body"`
+	NotifyID      uint            `json:"notify_id"`
+	Status        EmailTaskStatus `json:"status" enums:"pending,sending,sent,failed"`
+	Attempts      int             `json:"attempts"`
+	NextAttemptAt string          `json:"next_attempt_at"`
+	SentAt        string          `json:"sent_at"`
+	Error         string          `json:"error"`
+	Date          string          `json:"date"`
+	UpdatedAt     string          `json:"updated_at"`
+}
@@ -19,6 +19,8 @@ type Notify struct {
 	EmailAt   *time.Time
 
 	Key string `gorm:"index;size:255"`
+
+	IsMention bool `gorm:"default:false"` // 是否为 @ 提及通知
 }
 
 func (n Notify) IsEmpty() bool {
@@ -7,4 +7,5 @@ type CookedNotify struct {
 	IsRead    bool   `json:"is_read"`
 	IsEmailed bool   `json:"is_emailed"`
 	ReadLink  string `json:"read_link"`
+	IsMention bool   `json:"is_mention"`
 }
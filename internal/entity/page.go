@@ -1,6 +1,8 @@
 package entity
 
 import (
+	"time"
+
 	"gorm.io/gorm"
 )
 
@@ -21,6 +23,11 @@ type Page struct {
 	Title     string
 	AdminOnly bool
 
+	IsLocked       bool `gorm:"default:false"` // Comments are closed manually
+	CloseAfterDays int  `gorm:"default:0"`     // Comments are closed automatically after N days since the page created (0 means never)
+
+	Settings PageSettings `gorm:"type:text;serializer:json"` // The page-level settings to override the global config
+
 	SiteName string `gorm:"index;size:255"`
 
 	AccessibleURL string `gorm:"-"`
@@ -33,6 +40,34 @@ type Page struct {
 	Site *Site `gorm:"foreignKey:site_name;references:name"`
 }
 
+// The page-level settings to override the global config (nil or empty means follow the global config)
+type PageSettings struct {
+	PendingDefault *bool  `json:"pending_default,omitempty"` // Override `moderator.pending_default`
+	CaptchaAlways  *bool  `json:"captcha_always,omitempty"`  // Override `captcha.always`
+	SortRule       string `json:"sort_rule,omitempty"`       // The default sort rule of the comment list (date_asc, date_desc, vote)
+	FlatMode       *bool  `json:"flat_mode,omitempty"`       // The default flat mode of the comment list
+}
+
 func (p Page) IsEmpty() bool {
 	return p.ID == 0
 }
+
+// Get the time when the comments are closed automatically (nil if never)
+func (p Page) AutoCloseAt() *time.Time {
+	if p.CloseAfterDays <= 0 || p.CreatedAt.IsZero() {
+		return nil
+	}
+	t := p.CreatedAt.AddDate(0, 0, p.CloseAfterDays)
+	return &t
+}
+
+// Whether the comments of the page are closed (locked manually or closed automatically)
+func (p Page) IsClosed() bool {
+	if p.IsLocked {
+		return true
+	}
+	if closeAt := p.AutoCloseAt(); closeAt != nil && time.Now().After(*closeAt) {
+		return true
+	}
+	return false
+}
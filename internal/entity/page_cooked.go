@@ -11,4 +11,11 @@ type CookedPage struct {
 	VoteDown  int    `json:"vote_down"`
 	PV        int    `json:"pv"`
 	Date      string `json:"date"`
+
+	IsLocked       bool         `json:"is_locked"`            // Comments are closed manually
+	CloseAfterDays int          `json:"close_after_days"`     // Comments are closed automatically after N days since the page created (0 means never)
+	CloseAt        string       `json:"close_at,omitempty"`   // The time when the comments are closed automatically
+	IsClosed       bool         `json:"is_closed"`            // Whether the comments are closed now (no more comments or replies)
+	Settings       PageSettings `json:"settings"`             // The page-level settings to override the global config
+	DeletedAt      string       `json:"deleted_at,omitempty"` // The time moved into the trash
 }
@@ -8,6 +8,10 @@ type Site struct {
 	gorm.Model
 	Name string `gorm:"uniqueIndex;size:255"`
 	Urls string
+
+	ReactionTypes string // The available emoji reactions separated by commas (empty means using the default of config)
+
+	ConfigOverlay string `gorm:"type:text"` // The site config overlay (JSON object) merged over the global config
 }
 
 func (s Site) IsEmpty() bool {
@@ -1,9 +1,11 @@
 package entity
 
 type CookedSite struct {
-	ID       uint     `json:"id"`
-	Name     string   `json:"name"`
-	Urls     []string `json:"urls"`
-	UrlsRaw  string   `json:"urls_raw"`
-	FirstUrl string   `json:"first_url"`
+	ID            uint     `json:"id"`
+	Name          string   `json:"name"`
+	Urls          []string `json:"urls"`
+	UrlsRaw       string   `json:"urls_raw"`
+	FirstUrl      string   `json:"first_url"`
+	ReactionTypes []string `json:"reaction_types"`       // The available emoji reactions of the site (empty means using the default of config)
+	DeletedAt     string   `json:"deleted_at,omitempty"` // The time moved into the trash
 }
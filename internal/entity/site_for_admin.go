@@ -0,0 +1,6 @@
+package entity
+
+type CookedSiteForAdmin struct {
+	CookedSite
+	ConfigOverlay map[string]any `json:"config_overlay"` // The site config overlay merged over the global config
+}
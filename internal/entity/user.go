@@ -25,6 +25,16 @@ type User struct {
 	ReceiveEmail   bool `gorm:"default:true"`
 	TokenValidFrom sql.NullTime
 
+	// The comments of the shadow-banned user are only visible to the user and the admins,
+	// and never trigger notifications
+	IsShadowBanned bool `gorm:"default:false"`
+
+	// The comments of the trusted user skip the default pending (see `dao.IsUserTrusted`)
+	TrustLevel UserTrustLevel `gorm:"size:16;default:auto"`
+
+	// The frequency of the notification emails (the config default is used if empty)
+	EmailDigest EmailDigestFrequency `gorm:"size:16"`
+
 	// 配置文件中添加的
 	IsInConf bool
 }
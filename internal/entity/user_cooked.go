@@ -9,4 +9,7 @@ type CookedUser struct {
 	BadgeColor   string `json:"badge_color"`
 	IsAdmin      bool   `json:"is_admin"`
 	ReceiveEmail bool   `json:"receive_email"`
+	EmailDigest  string `json:"email_digest"` // The frequency of the notification emails (empty if the config default is used)
+
+	SiteRoles map[string]SiteRole `json:"site_roles"` // The roles on the sites (site name => role)
 }
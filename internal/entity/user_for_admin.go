@@ -2,8 +2,11 @@ package entity
 
 type CookedUserForAdmin struct {
 	CookedUser
-	LastIP       string `json:"last_ip"`
-	LastUA       string `json:"last_ua"`
-	IsInConf     bool   `json:"is_in_conf"`
-	CommentCount int64  `json:"comment_count"`
+	LastIP         string `json:"last_ip"`
+	LastUA         string `json:"last_ua"`
+	IsInConf       bool   `json:"is_in_conf"`
+	IsShadowBanned bool   `json:"is_shadow_banned"`
+	TrustLevel     string `json:"trust_level" enums:"auto,trusted,untrusted"`
+	CommentCount   int64  `json:"comment_count"`
+	DeletedAt      string `json:"deleted_at,omitempty"` // The time moved into the trash
 }
@@ -0,0 +1,48 @@
+package entity
+
+import (
+	"slices"
+
+	"gorm.io/gorm"
+)
+
+type SiteRole string
+
+const (
+	SiteRoleAdmin     SiteRole = "admin"     // Manage the comments and pages of the site
+	SiteRoleModerator SiteRole = "moderator" // Approve, collapse and delete the comments of the site
+)
+
+var SiteRoles = []SiteRole{SiteRoleAdmin, SiteRoleModerator}
+
+func (r SiteRole) IsValid() bool {
+	return slices.Contains(SiteRoles, r)
+}
+
+// Check the role has the permissions of the other role
+//
+// The site admin has all the permissions of the site moderator.
+func (r SiteRole) Includes(role SiteRole) bool {
+	switch r {
+	case SiteRoleAdmin:
+		return role == SiteRoleAdmin || role == SiteRoleModerator
+	case SiteRoleModerator:
+		return role == SiteRoleModerator
+	}
+	return false
+}
+
+// The role of a user on a specific site
+//
+// The global admins (`User.IsAdmin`) have all the permissions of all the sites,
+// so they are not required to be assigned.
+type UserSiteRole struct {
+	gorm.Model
+	UserID   uint     `gorm:"index"`
+	SiteName string   `gorm:"index;size:255"`
+	Role     SiteRole `gorm:"size:32"`
+}
+
+func (r UserSiteRole) IsEmpty() bool {
+	return r.ID == 0
+}
@@ -0,0 +1,17 @@
+package entity
+
+import "slices"
+
+type UserTrustLevel string
+
+const (
+	UserTrustLevelAuto      UserTrustLevel = "auto"      // Trusted after enough approved comments with no rejected ones
+	UserTrustLevelTrusted   UserTrustLevel = "trusted"   // Always trusted
+	UserTrustLevelUntrusted UserTrustLevel = "untrusted" // Never trusted
+)
+
+var UserTrustLevels = []UserTrustLevel{UserTrustLevelAuto, UserTrustLevelTrusted, UserTrustLevelUntrusted}
+
+func (l UserTrustLevel) IsValid() bool {
+	return slices.Contains(UserTrustLevels, l)
+}
@@ -13,6 +13,8 @@ const (
 	VoteTypeCommentDown VoteType = "comment_down"
 	VoteTypePageUp      VoteType = "page_up"
 	VoteTypePageDown    VoteType = "page_down"
+
+	VoteTypeCommentReaction VoteType = "comment_reaction" // Emoji reaction, the emoji is kept in `Reaction`
 )
 
 type Vote struct {
@@ -20,6 +22,7 @@ type Vote struct {
 
 	TargetID uint     `gorm:"index"` // 投票对象
 	Type     VoteType `gorm:"index"`
+	Reaction string   `gorm:"size:64"` // 表情回应 (only for the reaction type)
 
 	UserID uint `gorm:"index"` // 投票者
 	UA     string
@@ -0,0 +1,10 @@
+package entity
+
+type CookedVote struct {
+	ID       uint     `json:"id"`
+	TargetID uint     `json:"target_id"` // The comment or page ID
+	Type     VoteType `json:"type" enums:"comment_up,comment_down,page_up,page_down,comment_reaction"`
+	Reaction string   `json:"reaction,omitempty"`
+	UserID   uint     `json:"user_id"`
+	Date     string   `json:"date"`
+}
@@ -0,0 +1,43 @@
+package entity
+
+import (
+	"slices"
+
+	"gorm.io/gorm"
+)
+
+type WebhookDeliveryStatus string
+
+const (
+	WebhookDeliveryStatusPending WebhookDeliveryStatus = "pending" // Being delivered (including the retries)
+	WebhookDeliveryStatusSuccess WebhookDeliveryStatus = "success"
+	WebhookDeliveryStatusFailed  WebhookDeliveryStatus = "failed" // All the attempts are failed
+)
+
+var WebhookDeliveryStatuses = []WebhookDeliveryStatus{WebhookDeliveryStatusPending,
+	WebhookDeliveryStatusSuccess, WebhookDeliveryStatusFailed}
+
+func (s WebhookDeliveryStatus) IsValid() bool {
+	return slices.Contains(WebhookDeliveryStatuses, s)
+}
+
+// The delivery log of the webhook
+//
+// The payload is stored for inspecting and redelivering,
+// and the result of the last attempt is recorded.
+type WebhookDelivery struct {
+	gorm.Model
+	SiteName   string                `gorm:"index"`
+	Event      string                `gorm:"size:64"`
+	URL        string                `gorm:"type:text"`
+	Payload    string                `gorm:"type:text"`
+	Status     WebhookDeliveryStatus `gorm:"index;size:16"`
+	Attempts   int
+	StatusCode int    // The response status code of the last attempt (0 if no response)
+	Response   string `gorm:"type:text"` // The response body of the last attempt (truncated)
+	Error      string `gorm:"type:text"` // The error of the last attempt
+}
+
+func (d WebhookDelivery) IsEmpty() bool {
+	return d.ID == 0
+}
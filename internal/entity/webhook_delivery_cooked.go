@@ -0,0 +1,16 @@
+package entity
+
+type CookedWebhookDelivery struct {
+	ID         uint                  `json:"id"`
+	SiteName   string                `json:"site_name"`
+	Event      string                `json:"event"`
+	URL        string                `json:"url"`
+	Payload    string                `json:"payload"`
+	Status     WebhookDeliveryStatus `json:"status" enums:"pending,success,failed"`
+	Attempts   int                   `json:"attempts"`
+	StatusCode int                   `json:"status_code"`
+	Response   string                `json:"response"`
+	Error      string                `json:"error"`
+	Date       string                `json:"date"`
+	UpdatedAt  string                `json:"updated_at"`
+}
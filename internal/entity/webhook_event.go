@@ -0,0 +1,47 @@
+package entity
+
+import (
+	"slices"
+)
+
+type WebhookEvent string
+
+const (
+	WebhookEventCommentCreated  WebhookEvent = "comment.created"
+	WebhookEventCommentApproved WebhookEvent = "comment.approved" // The pending comment is approved by the moderators
+	WebhookEventCommentDeleted  WebhookEvent = "comment.deleted"  // The comment is moved into the trash
+	WebhookEventVoteCreated     WebhookEvent = "vote.created"
+	WebhookEventPageCreated     WebhookEvent = "page.created"
+	WebhookEventUserRegistered  WebhookEvent = "user.registered" // The user is registered by email, or signs in with a social account for the first time
+)
+
+var WebhookEvents = []WebhookEvent{WebhookEventCommentCreated, WebhookEventCommentApproved, WebhookEventCommentDeleted,
+	WebhookEventVoteCreated, WebhookEventPageCreated, WebhookEventUserRegistered}
+
+func (e WebhookEvent) IsValid() bool {
+	return slices.Contains(WebhookEvents, e)
+}
+
+// The version of the webhook payload
+//
+// The fields may be added within the same version,
+// and the version is increased when the existing fields are changed or removed.
+const WebhookPayloadVersion = 1
+
+// The payload sent to the webhook subscriptions
+type WebhookPayload struct {
+	Version  int                `json:"version"`
+	Event    WebhookEvent       `json:"event"`
+	SiteName string             `json:"site_name"`
+	Date     string             `json:"date"` // The time when the event occurred
+	Data     WebhookPayloadData `json:"data"`
+}
+
+// The data of the event, only the fields related to the event are set
+type WebhookPayloadData struct {
+	Comment       *CookedComment `json:"comment,omitempty"`        // comment.*
+	ParentComment *CookedComment `json:"parent_comment,omitempty"` // comment.created (only for the replies)
+	Vote          *CookedVote    `json:"vote,omitempty"`           // vote.created
+	Page          *CookedPage    `json:"page,omitempty"`           // page.created
+	User          *CookedUser    `json:"user,omitempty"`           // user.registered
+}
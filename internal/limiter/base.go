@@ -46,10 +46,17 @@ func NewLimiter(conf *LimiterConf) *Limiter {
 //
 // Notice: call IsPass will trigger a write operation.
 func (l *Limiter) IsPass(ip string) bool {
+	return l.IsPassWithAlwaysMode(ip, l.conf.AlwaysMode)
+}
+
+// 请求是否需要验证码 (覆盖配置中的总是需要验证码模式，例如页面级设置)
+//
+// Notice: call IsPassWithAlwaysMode will trigger a write operation.
+func (l *Limiter) IsPassWithAlwaysMode(ip string, alwaysMode bool) bool {
 	// =======================
 	//  总是需要验证码模式
 	// =======================
-	if l.conf.AlwaysMode {
+	if alwaysMode || l.conf.MaxActionDuringTime <= 0 {
 		return l.isVerified(ip)
 	}
 
@@ -20,6 +20,9 @@ type NotifyPusherConf struct {
 
 	// Provide a custom function to bridge the gap between Notify pusher and Email pusher
 	EmailPush func(notify *entity.Notify) error
+
+	// Send the email which is not related to a notify (e.g. the report of a comment)
+	EmailSendTo func(subject string, body string, toAddr string) error
 }
 
 type NotifyPusher struct {
@@ -50,16 +50,15 @@ func (pusher *NotifyPusher) checkNeedSendEmailToAdmin(comment *entity.Comment, p
 		return false
 	}
 
-	// 管理员评论不回复给其他管理员
-	if pusher.dao.FetchUserForComment(comment).IsAdmin {
+	// 管理员评论不回复给其他管理员 (包括站点管理员和版主)
+	if pusher.isSiteManager(pusher.dao.FetchUserForComment(comment), comment.SiteName) {
 		return false
 	}
 
-	// 只发送给对应站点管理员
-	// TODO admin.SiteNames had removed, so temporarily disabled
-	// if admin.SiteNames != "" && !slices.Contains(pusher.dao.CookUser(admin).SiteNames, comment.SiteName) {
-	// 	return false
-	// }
+	// 只发送给对应站点的管理员和版主
+	if !pusher.isSiteManager(*admin, comment.SiteName) {
+		return false
+	}
 
 	// 该管理员单独设定关闭接收邮件
 	if !admin.ReceiveEmail {
@@ -72,9 +71,9 @@ func (pusher *NotifyPusher) checkNeedSendEmailToAdmin(comment *entity.Comment, p
 func (pusher *NotifyPusher) checkNeedMultiPush(comment *entity.Comment, pComment *entity.Comment) bool {
 	isRootComment := pComment == nil || pComment.IsEmpty()
 
-	// 忽略来自管理员的评论
+	// 忽略来自管理员的评论 (包括站点管理员和版主)
 	coUser := pusher.dao.FetchUserForComment(comment)
-	if coUser.IsAdmin {
+	if pusher.isSiteManager(coUser, comment.SiteName) {
 		return false
 	}
 
@@ -93,3 +92,8 @@ func (pusher *NotifyPusher) checkNeedMultiPush(comment *entity.Comment, pComment
 
 	return true
 }
+
+// Check the user is a global admin, or a site admin or moderator of the site
+func (pusher *NotifyPusher) isSiteManager(user entity.User, siteName string) bool {
+	return pusher.dao.GetUserSiteRole(&user, siteName) != ""
+}
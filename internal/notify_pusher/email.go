@@ -27,7 +27,7 @@ func (pusher *NotifyPusher) emailToUser(comment *entity.Comment, pComment *entit
 
 func (pusher *NotifyPusher) emailToAdmins(comment *entity.Comment, pComment *entity.Comment) {
 	toAddrSent := []string{} // 记录已发送的收件人地址（避免重复发送）
-	for _, admin := range pusher.dao.GetAllAdmins() {
+	for _, admin := range pusher.dao.GetSiteManagers(comment.SiteName) {
 		// 该管理员地址已曾发送，避免重复发送
 		if slices.Contains(toAddrSent, admin.Email) {
 			continue
@@ -0,0 +1,55 @@
+package notify_pusher
+
+import (
+	"github.com/artalkjs/artalk/v2/internal/entity"
+	"github.com/artalkjs/artalk/v2/internal/log"
+)
+
+// 通知被 @ 提及的用户
+func (pusher *NotifyPusher) PushMentions(comment *entity.Comment, pComment *entity.Comment) {
+	// 待审状态评论不通知 (管理员审核通过后才发送)
+	if comment.IsPending {
+		return
+	}
+
+	for _, userID := range comment.MentionUserIDs {
+		// 自己提及自己，不提醒
+		if userID == comment.UserID {
+			continue
+		}
+
+		// 被回复者已收到回复通知，不重复提醒
+		if pComment != nil && !pComment.IsEmpty() && userID == pComment.UserID {
+			continue
+		}
+
+		user := pusher.dao.FindUserByID(userID)
+		if user.IsEmpty() {
+			continue
+		}
+
+		// 已有该评论的通知 (例如发送给管理员的通知)，仅标记为提及，不重复发送邮件
+		if notify := pusher.dao.FindNotify(userID, comment.ID); !notify.IsEmpty() {
+			if !notify.IsMention {
+				notify.IsMention = true
+				if err := pusher.dao.DB().Save(&notify).Error; err != nil {
+					log.Error("[Notify] Mark notify as mention error: ", err)
+				}
+			}
+			continue
+		}
+
+		notify := pusher.dao.FindCreateNotify(userID, comment.ID)
+		notify.IsMention = true
+		pusher.dao.NotifySetInitial(&notify)
+
+		// 对方个人设定关闭邮件接收
+		if !user.ReceiveEmail {
+			log.Debug("ignore mention email notify because the user disabled receiving email")
+			continue
+		}
+
+		// 邮件通知
+		pusher.sendEmail(&notify)
+	}
+}
@@ -45,7 +45,7 @@ func (pusher *NotifyPusher) multiPush(comment *entity.Comment, pComment *entity.
 
 	// WebHook
 	if pusher.conf.WebHook.Enabled {
-		pusher.sendWebhook(subject, body, comment, pComment)
+		pusher.sendWebhook(WebhookEventNewComment, subject, body, comment, pComment)
 	}
 }
 
@@ -71,17 +71,3 @@ func (pusher *NotifyPusher) getAdminNotifySubjectBody(comment *entity.Comment, t
 
 	return subject, body
 }
-
-func (pusher *NotifyPusher) sendWebhook(subject string, body string, comment *entity.Comment, pComment *entity.Comment) {
-	var pCommentCooked entity.CookedComment
-	if pComment != nil && !pComment.IsEmpty() {
-		pCommentCooked = pusher.dao.CookComment(pComment)
-	}
-
-	sender.SendWebHook(pusher.conf.WebHook.URL, &sender.NotifyWebHookReqBody{
-		NotifySubject: subject,
-		NotifyBody:    body,
-		Comment:       pusher.dao.CookComment(comment),
-		ParentComment: pCommentCooked,
-	})
-}
@@ -0,0 +1,91 @@
+package notify_pusher
+
+import (
+	"fmt"
+	"html"
+	"slices"
+	"strings"
+
+	"github.com/artalkjs/artalk/v2/internal/config"
+	"github.com/artalkjs/artalk/v2/internal/entity"
+	"github.com/artalkjs/artalk/v2/internal/i18n"
+	"github.com/artalkjs/artalk/v2/internal/log"
+	"github.com/artalkjs/artalk/v2/internal/notify_pusher/sender"
+	"github.com/artalkjs/artalk/v2/internal/utils"
+)
+
+// 通知管理员评论被举报 (邮件和多元推送)
+//
+// The `action` is the action taken automatically when the report threshold is reached, empty if not.
+func (pusher *NotifyPusher) PushReport(comment *entity.Comment, report *entity.CommentReport, reportCount int64, action config.CommentReportAction) {
+	cookedComment := pusher.dao.CookComment(comment)
+	subject := fmt.Sprintf("[%s] %s", i18n.T("Comment reported"), cookedComment.SiteName)
+
+	lines := []string{
+		fmt.Sprintf("%s: %s", i18n.T("User"), cookedComment.Nick),
+		fmt.Sprintf("%s: %s", i18n.T("Report reason"), report.Reason),
+		fmt.Sprintf("%s: %d", i18n.T("Report count"), reportCount),
+	}
+	switch action {
+	case config.CommentReportActionCollapse:
+		lines = append(lines, i18n.T("The comment has been collapsed automatically"))
+	case config.CommentReportActionPending:
+		lines = append(lines, i18n.T("The comment has been set to pending automatically"))
+	}
+	body := strings.Join(lines, "\n") + "\n\n" + utils.TruncateString(comment.Content, 280) + "\n\n" + cookedComment.PageURL
+
+	// 邮件通知
+	pusher.emailReportToAdmins(comment, subject, body)
+
+	if !pusher.isMultiPushEnabled() {
+		return
+	}
+
+	// 使用 Notify 库发送
+	if err := pusher.helper.Send(pusher.ctx, subject, html.EscapeString(body)); err != nil {
+		log.Error("[Notify]", err)
+	}
+
+	// 飞书
+	if pusher.conf.Lark.Enabled {
+		sender.SendLark(pusher.conf.Lark.WebhookURL, subject, body, pusher.conf.Lark.MsgType == "card")
+	}
+
+	// Bark
+	if pusher.conf.Bark.Enabled {
+		sender.SendBark(pusher.conf.Bark.Server, subject, body)
+	}
+
+	// WebHook
+	if pusher.conf.WebHook.Enabled {
+		pusher.sendWebhook(WebhookEventCommentReported, subject, body, comment, nil)
+	}
+}
+
+// Send the report email to the admins and moderators of the site
+func (pusher *NotifyPusher) emailReportToAdmins(comment *entity.Comment, subject string, body string) {
+	if !pusher.conf.Email.Enabled || pusher.conf.EmailSendTo == nil {
+		return
+	}
+
+	htmlBody := strings.ReplaceAll(html.EscapeString(body), "\n", "<br>")
+
+	toAddrSent := []string{} // 记录已发送的收件人地址（避免重复发送）
+	for _, admin := range pusher.dao.GetSiteManagers(comment.SiteName) {
+		if !admin.ReceiveEmail || admin.Email == "" || slices.Contains(toAddrSent, admin.Email) {
+			continue
+		}
+		toAddrSent = append(toAddrSent, admin.Email)
+
+		if err := pusher.conf.EmailSendTo(subject, htmlBody, admin.Email); err != nil {
+			log.Error("[Notify] Failed to send the report email: ", err)
+		}
+	}
+}
+
+// Check any service of the multi push is enabled
+func (pusher *NotifyPusher) isMultiPushEnabled() bool {
+	conf := pusher.conf
+	return conf.Telegram.Enabled || conf.DingTalk.Enabled || conf.Slack.Enabled || conf.LINE.Enabled ||
+		conf.Lark.Enabled || conf.Bark.Enabled || conf.WebHook.Enabled
+}
@@ -0,0 +1,47 @@
+package notify_pusher_test
+
+import (
+	"testing"
+
+	"github.com/artalkjs/artalk/v2/internal/config"
+	"github.com/artalkjs/artalk/v2/internal/entity"
+	"github.com/artalkjs/artalk/v2/internal/notify_pusher"
+	"github.com/artalkjs/artalk/v2/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPushReport(t *testing.T) {
+	app, _ := test.NewTestApp()
+	defer app.Cleanup()
+
+	comment := app.Dao().FindComment(1000)
+	require.False(t, comment.IsEmpty())
+
+	pushReport := func(emailEnabled bool) map[string]string {
+		sent := map[string]string{}
+		pusher := notify_pusher.NewNotifyPusher(&notify_pusher.NotifyPusherConf{
+			AdminNotifyConf: config.AdminNotifyConf{
+				Email: &config.AdminEmailConf{Enabled: emailEnabled},
+			},
+			Dao: app.Dao(),
+			EmailSendTo: func(subject string, body string, toAddr string) error {
+				sent[toAddr] = body
+				return nil
+			},
+		})
+		pusher.PushReport(&comment, &entity.CommentReport{Reason: "<spam>"}, 3, config.CommentReportActionPending)
+		return sent
+	}
+
+	t.Run("Email to the admins without multi push", func(t *testing.T) {
+		sent := pushReport(true)
+		require.Contains(t, sent, "admin@qwqaq.com")
+		assert.Contains(t, sent["admin@qwqaq.com"], "&lt;spam&gt;")
+		assert.NotContains(t, sent, "user_b@qwqaq.com", "not a manager of the site")
+	})
+
+	t.Run("Email disabled", func(t *testing.T) {
+		assert.Empty(t, pushReport(false))
+	})
+}
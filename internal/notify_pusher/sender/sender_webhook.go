@@ -2,13 +2,18 @@ package sender
 
 import (
 	"bytes"
-	"encoding/json"
+	"fmt"
 	"io"
 	"net/http"
+	"strconv"
+	"time"
 
-	"github.com/artalkjs/artalk/v2/internal/log"
+	"github.com/artalkjs/artalk/v2/internal/utils"
 )
 
+// The payload of the WebHook notify
+//
+// It is kept for compatibility, the webhook subscriptions use the versioned `entity.WebhookPayload`.
 type NotifyWebHookReqBody struct {
 	NotifySubject string      `json:"notify_subject"`
 	NotifyBody    string      `json:"notify_body"`
@@ -16,19 +21,60 @@ type NotifyWebHookReqBody struct {
 	ParentComment interface{} `json:"parent_comment"`
 }
 
+// The request timeout of the WebHook if not set
+var DefaultWebHookTimeout = 5 * time.Second
+
+type WebHookOptions struct {
+	URL        string
+	Secret     string        // The secret for signing the request (not signed if empty)
+	Timeout    time.Duration // The request timeout (`DefaultWebHookTimeout` if not positive)
+	Event      string
+	DeliveryID uint
+}
+
+type WebHookResult struct {
+	StatusCode int
+	Body       string // The response body (at most 64KB)
+}
+
 // WebHook 发送
-func SendWebHook(url string, reqData *NotifyWebHookReqBody) {
-	jsonByte, _ := json.Marshal(reqData)
-	result, err := http.Post(url, "application/json", bytes.NewReader(jsonByte))
+//
+// The request is sent once, and the error is returned if no response or the status code is not 2xx.
+// The signature header is `sha256=HMAC_SHA256(timestamp + "." + body, secret)`.
+func SendWebHook(opts WebHookOptions, payload []byte) (*WebHookResult, error) {
+	req, err := http.NewRequest("POST", opts.URL, bytes.NewReader(payload))
 	if err != nil {
-		log.Error("[WebHook Push] ", "Failed to send msg:", err)
-		return
+		return nil, err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "Artalk")
+	req.Header.Set("X-Artalk-Event", opts.Event)
+	req.Header.Set("X-Artalk-Delivery", strconv.FormatUint(uint64(opts.DeliveryID), 10))
+	if opts.Secret != "" {
+		timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+		req.Header.Set("X-Artalk-Timestamp", timestamp)
+		req.Header.Set("X-Artalk-Signature", "sha256="+utils.GetHmacSha256(timestamp+"."+string(payload), opts.Secret))
 	}
 
-	if result.StatusCode != 200 {
-		body, _ := io.ReadAll(result.Body)
-		log.Error("[WebHook Push] Failed to send msg:", string(body))
+	timeout := opts.Timeout
+	if timeout <= 0 {
+		timeout = DefaultWebHookTimeout
+	}
+
+	client := &http.Client{Timeout: timeout}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	defer resp.Body.Close()
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, 64<<10))
+
+	result := &WebHookResult{StatusCode: resp.StatusCode, Body: string(body)}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return result, fmt.Errorf("unexpected status code %d", resp.StatusCode)
 	}
 
-	defer result.Body.Close()
+	return result, nil
 }
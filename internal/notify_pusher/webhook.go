@@ -0,0 +1,152 @@
+package notify_pusher
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/artalkjs/artalk/v2/internal/dao"
+	"github.com/artalkjs/artalk/v2/internal/entity"
+	"github.com/artalkjs/artalk/v2/internal/log"
+	"github.com/artalkjs/artalk/v2/internal/notify_pusher/sender"
+	"github.com/artalkjs/artalk/v2/internal/utils"
+)
+
+// The events of the WebHook notify (see the `X-Artalk-Event` header)
+const (
+	WebhookEventNewComment      = "new_comment"
+	WebhookEventCommentReported = "comment_reported"
+)
+
+// The delay before the first retry, it is doubled for each retry
+var WebhookRetryDelay = time.Second
+
+func (pusher *NotifyPusher) sendWebhook(event string, subject string, body string, comment *entity.Comment, pComment *entity.Comment) {
+	var pCommentCooked entity.CookedComment
+	if pComment != nil && !pComment.IsEmpty() {
+		pCommentCooked = pusher.dao.CookComment(pComment)
+	}
+
+	payload, _ := json.Marshal(&sender.NotifyWebHookReqBody{
+		NotifySubject: subject,
+		NotifyBody:    body,
+		Comment:       pusher.dao.CookComment(comment),
+		ParentComment: pCommentCooked,
+	})
+
+	delivery := entity.WebhookDelivery{
+		SiteName: comment.SiteName,
+		Event:    event,
+		URL:      pusher.conf.WebHook.URL,
+		Payload:  string(payload),
+		Status:   entity.WebhookDeliveryStatusPending,
+	}
+	if err := pusher.dao.SaveWebhookDelivery(&delivery); err != nil {
+		log.Error("[WebHook Push] Failed to save the delivery: ", err)
+		return
+	}
+
+	pusher.DeliverWebhook(&delivery, pusher.conf.WebHook.MaxRetries)
+}
+
+// Deliver the webhook with the secret of the WebHook config
+func (pusher *NotifyPusher) DeliverWebhook(delivery *entity.WebhookDelivery, maxRetries int) {
+	conf := pusher.conf.WebHook
+	DeliverWebhook(pusher.dao, delivery, conf.Secret, time.Duration(conf.Timeout)*time.Second, maxRetries)
+}
+
+// Deliver the webhook again with the secret of the WebHook config (see `RedeliverWebhook`)
+func (pusher *NotifyPusher) RedeliverWebhook(delivery *entity.WebhookDelivery) {
+	conf := pusher.conf.WebHook
+	RedeliverWebhook(pusher.dao, delivery, conf.Secret, time.Duration(conf.Timeout)*time.Second)
+}
+
+// Check the pending delivery is interrupted with the retry window of the WebHook config
+func (pusher *NotifyPusher) IsWebhookDeliveryStale(delivery *entity.WebhookDelivery) bool {
+	conf := pusher.conf.WebHook
+	return IsWebhookDeliveryStale(delivery, time.Duration(conf.Timeout)*time.Second, conf.MaxRetries)
+}
+
+// Check the pending delivery is interrupted (e.g. by a restart of the server)
+//
+// The delivery is updated after each attempt, so it is interrupted
+// if not updated within the longest retry window between two attempts.
+func IsWebhookDeliveryStale(delivery *entity.WebhookDelivery, timeout time.Duration, maxRetries int) bool {
+	if delivery.Status != entity.WebhookDeliveryStatusPending {
+		return false
+	}
+
+	window := timeout
+	if window <= 0 {
+		window = sender.DefaultWebHookTimeout
+	}
+	if maxRetries > 0 {
+		window += WebhookRetryDelay << min(maxRetries-1, 30) // avoid overflow
+	}
+
+	return time.Since(delivery.UpdatedAt) > window
+}
+
+// Deliver the webhook again in the background (without retries)
+//
+// The delivery is marked as pending before returning, so it is not redelivered twice at the same time.
+func RedeliverWebhook(d *dao.Dao, delivery *entity.WebhookDelivery, secret string, timeout time.Duration) {
+	delivery.Status = entity.WebhookDeliveryStatusPending
+	if err := d.SaveWebhookDelivery(delivery); err != nil {
+		log.Error("[WebHook Push] Failed to save the delivery: ", err)
+		return
+	}
+
+	pending := *delivery
+	go DeliverWebhook(d, &pending, secret, timeout, 0)
+}
+
+// Deliver the webhook and record the result of each attempt in the delivery log
+//
+// It is retried with exponential backoff on failure, and blocks until succeeded or all the attempts are failed.
+func DeliverWebhook(d *dao.Dao, delivery *entity.WebhookDelivery, secret string, timeout time.Duration, maxRetries int) {
+	opts := sender.WebHookOptions{
+		URL:        delivery.URL,
+		Secret:     secret,
+		Timeout:    timeout,
+		Event:      delivery.Event,
+		DeliveryID: delivery.ID,
+	}
+
+	delay := WebhookRetryDelay
+	for retries := 0; ; retries++ {
+		result, err := sender.SendWebHook(opts, []byte(delivery.Payload))
+
+		delivery.Attempts++
+		delivery.StatusCode, delivery.Response, delivery.Error = 0, "", ""
+		if result != nil {
+			delivery.StatusCode = result.StatusCode
+			delivery.Response = utils.TruncateString(result.Body, 2000)
+		}
+		if err != nil {
+			delivery.Error = err.Error()
+		}
+
+		finished := err == nil || retries >= maxRetries
+		switch {
+		case err == nil:
+			delivery.Status = entity.WebhookDeliveryStatusSuccess
+		case finished:
+			delivery.Status = entity.WebhookDeliveryStatusFailed
+		default:
+			delivery.Status = entity.WebhookDeliveryStatusPending
+		}
+		if err := d.SaveWebhookDelivery(delivery); err != nil {
+			log.Error("[WebHook Push] Failed to save the delivery: ", err)
+		}
+
+		if finished {
+			if err != nil {
+				log.Error("[WebHook Push] Failed to send msg: ", delivery.Error, " ", delivery.Response)
+			}
+			return
+		}
+
+		time.Sleep(delay)
+		delay *= 2
+	}
+}
@@ -0,0 +1,120 @@
+package notify_pusher_test
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/artalkjs/artalk/v2/internal/config"
+	"github.com/artalkjs/artalk/v2/internal/entity"
+	"github.com/artalkjs/artalk/v2/internal/notify_pusher"
+	"github.com/artalkjs/artalk/v2/internal/utils"
+	"github.com/artalkjs/artalk/v2/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeliverWebhook(t *testing.T) {
+	app, _ := test.NewTestApp()
+	defer app.Cleanup()
+
+	notify_pusher.WebhookRetryDelay = time.Millisecond
+
+	var (
+		attempts   atomic.Int32
+		failures   int32
+		reqHeader  http.Header
+		reqPayload []byte
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		reqHeader = r.Header
+		reqPayload, _ = io.ReadAll(r.Body)
+		if attempts.Add(1) <= failures {
+			w.WriteHeader(500)
+			w.Write([]byte("internal error"))
+			return
+		}
+		w.Write([]byte("ok"))
+	}))
+	defer server.Close()
+
+	pusher := notify_pusher.NewNotifyPusher(&notify_pusher.NotifyPusherConf{
+		AdminNotifyConf: config.AdminNotifyConf{
+			WebHook: config.NotifyWebHookConf{Enabled: true, URL: server.URL, Secret: "secret", Timeout: 5},
+		},
+		Dao: app.Dao(),
+	})
+
+	newDelivery := func(t *testing.T) *entity.WebhookDelivery {
+		delivery := &entity.WebhookDelivery{
+			Event:   notify_pusher.WebhookEventNewComment,
+			URL:     server.URL,
+			Payload: `{"notify_subject":"test"}`,
+			Status:  entity.WebhookDeliveryStatusPending,
+		}
+		require.NoError(t, app.Dao().SaveWebhookDelivery(delivery))
+		attempts.Store(0)
+		return delivery
+	}
+
+	t.Run("Signed", func(t *testing.T) {
+		failures = 0
+		delivery := newDelivery(t)
+		pusher.DeliverWebhook(delivery, 3)
+
+		assert.Equal(t, `{"notify_subject":"test"}`, string(reqPayload))
+		assert.Equal(t, notify_pusher.WebhookEventNewComment, reqHeader.Get("X-Artalk-Event"))
+		timestamp := reqHeader.Get("X-Artalk-Timestamp")
+		assert.Equal(t, "sha256="+utils.GetHmacSha256(timestamp+"."+string(reqPayload), "secret"), reqHeader.Get("X-Artalk-Signature"))
+
+		saved := app.Dao().FindWebhookDelivery(delivery.ID)
+		assert.Equal(t, entity.WebhookDeliveryStatusSuccess, saved.Status)
+		assert.Equal(t, 1, saved.Attempts)
+		assert.Equal(t, 200, saved.StatusCode)
+		assert.Equal(t, "ok", saved.Response)
+	})
+
+	t.Run("Retry until succeeded", func(t *testing.T) {
+		failures = 2
+		delivery := newDelivery(t)
+		pusher.DeliverWebhook(delivery, 3)
+
+		saved := app.Dao().FindWebhookDelivery(delivery.ID)
+		assert.Equal(t, entity.WebhookDeliveryStatusSuccess, saved.Status)
+		assert.Equal(t, 3, saved.Attempts)
+		assert.Empty(t, saved.Error)
+	})
+
+	t.Run("All attempts failed", func(t *testing.T) {
+		failures = 10
+		delivery := newDelivery(t)
+		pusher.DeliverWebhook(delivery, 2)
+
+		saved := app.Dao().FindWebhookDelivery(delivery.ID)
+		assert.Equal(t, entity.WebhookDeliveryStatusFailed, saved.Status)
+		assert.Equal(t, 3, saved.Attempts)
+		assert.Equal(t, 500, saved.StatusCode)
+		assert.Equal(t, "internal error", saved.Response)
+		assert.NotEmpty(t, saved.Error)
+	})
+}
+
+func TestIsWebhookDeliveryStale(t *testing.T) {
+	delay := notify_pusher.WebhookRetryDelay
+	notify_pusher.WebhookRetryDelay = time.Minute
+	defer func() { notify_pusher.WebhookRetryDelay = delay }()
+
+	delivery := entity.WebhookDelivery{Status: entity.WebhookDeliveryStatusPending}
+	delivery.UpdatedAt = time.Now().Add(-10 * time.Minute)
+
+	// the window is the timeout and the delay before the last retry (4 minutes for 3 retries)
+	assert.True(t, notify_pusher.IsWebhookDeliveryStale(&delivery, 5*time.Second, 3))
+	assert.False(t, notify_pusher.IsWebhookDeliveryStale(&delivery, 5*time.Second, 5), "waiting for the last retry")
+	assert.True(t, notify_pusher.IsWebhookDeliveryStale(&delivery, 0, 4), "the default timeout is used without the timeout")
+
+	delivery.Status = entity.WebhookDeliveryStatusFailed
+	assert.False(t, notify_pusher.IsWebhookDeliveryStale(&delivery, 5*time.Second, 0), "not pending")
+}
@@ -10,6 +10,7 @@ type RenderType string
 const (
 	TYPE_EMAIL  RenderType = "email"
 	TYPE_NOTIFY RenderType = "notify"
+	TYPE_DIGEST RenderType = "digest"
 )
 
 type RenderStrategy interface {
@@ -0,0 +1,76 @@
+package template
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/artalkjs/artalk/v2/internal/entity"
+	"github.com/artalkjs/artalk/v2/internal/utils"
+)
+
+// The params of the digest template
+//
+// The `{{#items}}...{{/items}}` block is repeated for each notify,
+// and the params of the email template are available in the block.
+type digestTplParams struct {
+	Nick     string `json:"nick"`
+	Count    int    `json:"count"`
+	SiteName string `json:"site_name"` // The site of the latest notify
+	SiteURL  string `json:"site_url"`
+}
+
+var digestItemsBlockRegexp = regexp.MustCompile(`(?s){{\s*#items\s*}}(.*?){{\s*/items\s*}}`)
+
+// Render the summary of the notifies which are received by the same user
+func (r *Renderer) RenderDigest(notifies []entity.Notify, customTpl ...string) string {
+	if len(notifies) == 0 {
+		return ""
+	}
+
+	var tpl string
+	if len(customTpl) > 0 {
+		tpl = customTpl[0]
+	} else {
+		tpl = r.defaultTpl
+	}
+
+	items := make([]tplParams, 0, len(notifies))
+	for i := range notifies {
+		extra := getNotifyExtraData(r.dao, &notifies[i])
+		items = append(items, getCommonParams(r.dao, &notifies[i], extra))
+	}
+
+	latest := items[len(items)-1]
+	params := digestTplParams{
+		Nick:     latest.Nick,
+		Count:    len(items),
+		SiteName: latest.SiteName,
+		SiteURL:  latest.SiteURL,
+	}
+
+	return renderDigest(tpl, params, items)
+}
+
+// The parts out of the items block are rendered with the digest params,
+// they are rendered separately so the comment contents are never rendered again.
+func renderDigest(tpl string, params digestTplParams, items []tplParams) string {
+	flat := utils.StructToFlatDotMap(&params)
+
+	var (
+		buf  strings.Builder
+		last int
+	)
+	for _, loc := range digestItemsBlockRegexp.FindAllStringSubmatchIndex(tpl, -1) {
+		buf.WriteString(replaceAllMustache(tpl[last:loc[0]], flat))
+
+		block := tpl[loc[2]:loc[3]]
+		for _, item := range items {
+			buf.WriteString(renderCommon(block, item))
+		}
+
+		last = loc[1]
+	}
+	buf.WriteString(replaceAllMustache(tpl[last:], flat))
+
+	return buf.String()
+}
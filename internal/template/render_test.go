@@ -6,6 +6,7 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/artalkjs/artalk/v2/internal/entity"
 	"github.com/artalkjs/artalk/v2/internal/template"
 	"github.com/artalkjs/artalk/v2/test"
 	"github.com/stretchr/testify/assert"
@@ -96,3 +97,35 @@ func TestNewRenderer(t *testing.T) {
 		}
 	})
 }
+
+func TestRenderDigest(t *testing.T) {
+	app, _ := test.NewTestApp()
+	defer app.Cleanup()
+
+	notifies := []entity.Notify{app.Dao().FindNotify(1000, 1000), app.Dao().FindNotify(1000, 1001)}
+
+	t.Run("CustomTpl", func(t *testing.T) {
+		renderer := template.NewRenderer(app.Dao(), template.TYPE_DIGEST, nil)
+		result := renderer.RenderDigest(notifies, "Hi, {{nick}} ({{count}}):{{#items}} [{{page_title}}] @{{reply_nick}}: {{reply_content}}{{/items}} -- {{site_name}}")
+		assert.Equal(t, "Hi, admin (2): [测试页面标题 1000] @admin: <p>Hello Artalk, 你好 Artalk!</p>\n [测试页面标题 1000] @userA: <p>回复测试 1001</p>\n -- Site A", result)
+	})
+
+	t.Run("DefaultTpl", func(t *testing.T) {
+		renderer := template.NewRenderer(app.Dao(), template.TYPE_DIGEST, template.NewFileLoader(""))
+		result := renderer.RenderDigest(notifies)
+		assert.Contains(t, result, "您有 2 条未读的回复")
+		assert.NotContains(t, result, "{{")
+	})
+
+	t.Run("AdminTpl", func(t *testing.T) {
+		renderer := template.NewRenderer(app.Dao(), template.TYPE_DIGEST, template.NewFileLoader("admin"))
+		result := renderer.RenderDigest(notifies)
+		assert.Contains(t, result, "您有 2 条新的评论通知")
+		assert.NotContains(t, result, "{{")
+	})
+
+	t.Run("NoNotifies", func(t *testing.T) {
+		renderer := template.NewRenderer(app.Dao(), template.TYPE_DIGEST, template.NewFileLoader(""))
+		assert.Empty(t, renderer.RenderDigest(nil))
+	})
+}
@@ -48,12 +48,14 @@ func (l *DefaultTemplateLoader) Load(tplType RenderType) string {
 
 //go:embed email_tpl/*
 //go:embed notify_tpl/*
+//go:embed digest_tpl/*
 var internalTpl embed.FS
 
 // Template base path for different render type
 var tplType2BasePath = map[RenderType]string{
 	TYPE_EMAIL:  "email_tpl",
 	TYPE_NOTIFY: "notify_tpl",
+	TYPE_DIGEST: "digest_tpl",
 }
 
 // Get internal template
@@ -32,6 +32,7 @@ type tplParams struct {
 	SiteURL   string `json:"site_url"`
 
 	LinkToReply string `json:"link_to_reply"`
+	IsMention   bool   `json:"is_mention"` // The receiver is mentioned by `@name` in the comment
 }
 
 type notifyExtraData struct {
@@ -70,6 +71,7 @@ func getCommonParams(dao *dao.Dao, notify *entity.Notify, atd notifyExtraData) t
 		SiteURL:      atd.from.Site.FirstUrl,
 
 		LinkToReply: dao.GetReadLinkByNotify(notify),
+		IsMention:   notify.IsMention,
 	}
 }
 
@@ -37,3 +37,19 @@ func TestGetSha256Hash(t *testing.T) {
 		assert.Equal(t, test.expected, result)
 	}
 }
+
+func TestGetHmacSha256(t *testing.T) {
+	tests := []struct {
+		input    string
+		key      string
+		expected string
+	}{
+		{"hello", "secret", "88aab3ede8d3adf94d26ab90d3bafd4a2083070c3bcce9c014ee04a443847c0b"},
+		{"", "key", "5d5d139563c95b5967b9bd9a8c9b233a9dedb45072794cd232dc1b74832607d0"},
+	}
+
+	for _, test := range tests {
+		result := GetHmacSha256(test.input, test.key)
+		assert.Equal(t, test.expected, result)
+	}
+}
@@ -0,0 +1,87 @@
+package utils
+
+import (
+	"regexp"
+	"slices"
+	"strings"
+)
+
+var (
+	// The mention syntax `@name`
+	//
+	// The `@` should not follow a letter or digit (to skip the email address),
+	// and the name is ended by a whitespace or a punctuation (except `_`, `-` and the `.` inside the name).
+	mentionRegexp = regexp.MustCompile(`(^|[^\p{L}\p{N}_@/])@([\p{L}\p{N}_\-]+(?:\.[\p{L}\p{N}_\-]+)*)`)
+
+	htmlTagRegexp = regexp.MustCompile(`<[^>]*>`)
+
+	// The mentions inside these elements are ignored
+	mentionSkipTags = []string{"a", "code", "pre"}
+)
+
+// Find the mentioned names in the HTML (the names are unique and in order of appearance)
+func FindMentions(html string) []string {
+	names := []string{}
+	ReplaceMentions(html, func(name string) string {
+		names = append(names, name)
+		return ""
+	})
+	return RemoveDuplicates(names)
+}
+
+// Replace the mentions in the text of the HTML
+//
+// The `replace` function receives the mentioned name (without `@`),
+// and returns the HTML to replace the whole `@name` (keep the original if an empty string is returned).
+func ReplaceMentions(html string, replace func(name string) string) string {
+	var (
+		buf       strings.Builder
+		skipDepth = 0
+		last      = 0
+	)
+
+	replaceText := func(text string) string {
+		if skipDepth > 0 || !strings.Contains(text, "@") {
+			return text
+		}
+		return mentionRegexp.ReplaceAllStringFunc(text, func(s string) string {
+			m := mentionRegexp.FindStringSubmatch(s)
+			if r := replace(m[2]); r != "" {
+				return m[1] + r
+			}
+			return s
+		})
+	}
+
+	for _, loc := range htmlTagRegexp.FindAllStringIndex(html, -1) {
+		buf.WriteString(replaceText(html[last:loc[0]]))
+
+		tag := html[loc[0]:loc[1]]
+		buf.WriteString(tag)
+		last = loc[1]
+
+		if name, isClosing := getHTMLTagName(tag); slices.Contains(mentionSkipTags, name) && !strings.HasSuffix(tag, "/>") {
+			if isClosing {
+				skipDepth = max(skipDepth-1, 0)
+			} else {
+				skipDepth++
+			}
+		}
+	}
+	buf.WriteString(replaceText(html[last:]))
+
+	return buf.String()
+}
+
+func getHTMLTagName(tag string) (name string, isClosing bool) {
+	tag = strings.TrimPrefix(tag, "<")
+	if strings.HasPrefix(tag, "/") {
+		isClosing = true
+		tag = tag[1:]
+	}
+	end := strings.IndexAny(tag, " \t\n/>")
+	if end == -1 {
+		end = len(tag)
+	}
+	return strings.ToLower(tag[:end]), isClosing
+}
@@ -0,0 +1,47 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFindMentions(t *testing.T) {
+	tests := []struct {
+		name string
+		html string
+		want []string
+	}{
+		{"Simple", "<p>@alice hello</p>", []string{"alice"}},
+		{"Multiple and unique", "<p>@alice @bob, @alice</p>", []string{"alice", "bob"}},
+		{"Unicode name", "<p>你好 @小明。</p>", []string{"小明"}},
+		{"Name with dot", "<p>cc @john.doe.</p>", []string{"john.doe"}},
+		{"Email is not mention", "<p>mail me at abc@example.com</p>", []string{}},
+		{"Inside link", `<p><a href="https://example.com">@alice</a></p>`, []string{}},
+		{"Inside code", "<p><code>@alice</code> @bob</p>", []string{"bob"}},
+		{"Inside pre", "<pre><code>@alice\n</code></pre><p>@bob</p>", []string{"bob"}},
+		{"No mention", "<p>hello world</p>", []string{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, FindMentions(tt.html))
+		})
+	}
+}
+
+func TestReplaceMentions(t *testing.T) {
+	replace := func(name string) string {
+		if name == "alice" {
+			return `<a class="atk-mention">@alice</a>`
+		}
+		return ""
+	}
+
+	assert.Equal(t,
+		`<p>hi <a class="atk-mention">@alice</a> and @bob</p>`,
+		ReplaceMentions("<p>hi @alice and @bob</p>", replace))
+
+	assert.Equal(t,
+		`<p><code>@alice</code><br/><a class="atk-mention">@alice</a></p>`,
+		ReplaceMentions("<p><code>@alice</code><br/>@alice</p>", replace))
+}
@@ -21,7 +21,7 @@ func GetLimiter(c *fiber.Ctx) (lmt *limiter.Limiter, err error) {
 func NewCaptchaChecker(app *core.App, c *fiber.Ctx) captcha.Checker {
 	user, _ := GetUserByReq(app, c)
 	return captcha.NewCaptchaChecker(&captcha.CheckerConf{
-		CaptchaConf: app.Conf().Captcha,
+		CaptchaConf: GetSiteConf(app, c).Captcha,
 		User: captcha.User{
 			ID: fmt.Sprint(user.ID),
 			IP: c.IP(),
@@ -37,7 +37,7 @@ func LimiterGuard(app *core.App, handler fiber.Handler) fiber.Handler {
 		}
 
 		// 关闭验证码功能，直接 Skip
-		if !app.Conf().Captcha.Enabled {
+		if !GetSiteConf(app, c).Captcha.Enabled {
 			return handler(c)
 		}
 
@@ -46,9 +46,9 @@ func LimiterGuard(app *core.App, handler fiber.Handler) fiber.Handler {
 			return handler(c)
 		}
 
-		// 检测是否需要验证码
+		// 检测是否需要验证码 (页面级设置可覆盖总是需要验证码模式)
 		ip := c.IP()
-		if limiter.IsPass(ip) {
+		if limiter.IsPassWithAlwaysMode(ip, GetCaptchaAlwaysMode(app, c)) {
 			// 无需验证码
 			err := handler(c)
 
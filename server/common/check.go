@@ -2,6 +2,7 @@ package common
 
 import (
 	"errors"
+	"slices"
 
 	"github.com/artalkjs/artalk/v2/internal/core"
 	"github.com/artalkjs/artalk/v2/internal/entity"
@@ -19,6 +20,20 @@ func AdminGuard(app *core.App, handler fiber.Handler) fiber.Handler {
 	}
 }
 
+// Guard the handler for the global admins and the users who have a role on any site
+//
+// The handler should check the role on the site of the target resource via `CheckSiteRoleReq`.
+func SiteRoleGuard(app *core.App, handler fiber.Handler) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		user, err := GetUserByReq(app, c)
+		if err != nil || user.IsEmpty() || (!user.IsAdmin && len(app.Dao().FindUserSiteRoles(user.ID)) == 0) {
+			return RespError(c, 403, i18n.T("Admin access required"), Map{"need_login": true})
+		}
+
+		return handler(c)
+	}
+}
+
 func LoginGuard(app *core.App, handler func(*fiber.Ctx, entity.User) error) fiber.Handler {
 	return func(c *fiber.Ctx) error {
 		user, err := GetUserByReq(app, c)
@@ -40,3 +55,66 @@ func CheckIsAdminReq(app *core.App, c *fiber.Ctx) bool {
 	}
 	return !user.IsEmpty() && user.IsAdmin
 }
+
+// Check the request user has the role (or a higher one) on the site
+func CheckSiteRoleReq(app *core.App, c *fiber.Ctx, siteName string, role entity.SiteRole) bool {
+	user, err := GetUserByReq(app, c)
+	if err != nil {
+		return false
+	}
+	return app.Dao().GetUserSiteRole(&user, siteName).Includes(role)
+}
+
+// Get the names of the sites which the request user has the role (or a higher one) on
+//
+// `isAll` is true if the user is a global admin, who has all the roles on all the sites.
+func GetRoleSiteNamesReq(app *core.App, c *fiber.Ctx, role entity.SiteRole) (siteNames []string, isAll bool) {
+	user, err := GetUserByReq(app, c)
+	if err != nil {
+		return []string{}, false
+	}
+	if user.IsAdmin {
+		return nil, true
+	}
+
+	siteNames = []string{}
+	for siteName, r := range app.Dao().GetUserSiteRoles(user.ID) {
+		if r.Includes(role) {
+			siteNames = append(siteNames, siteName)
+		}
+	}
+	slices.Sort(siteNames)
+	return siteNames, false
+}
+
+func RespSiteRoleRequired(c *fiber.Ctx) error {
+	return RespError(c, 403, i18n.T("No permission for this site"))
+}
+
+// Check the request is not blocked by the blocklist
+//
+// The IP and the user agent of the request are checked, and the user of the request
+// (the login user, or the users with the email if not login). The login admins are never blocked.
+func CheckBlocklistReq(app *core.App, c *fiber.Ctx, email string) (bool, error) {
+	target := entity.BlocklistTarget{
+		IP:    c.IP(),
+		Email: email,
+		UA:    string(c.Request().Header.UserAgent()),
+	}
+
+	if user, err := GetUserByReq(app, c); err == nil {
+		if user.IsAdmin {
+			return true, nil
+		}
+		target.UserIDs = []uint{user.ID}
+		target.Email = user.Email
+	} else if email != "" {
+		target.UserIDs = app.Dao().FindUserIdsByEmail(email)
+	}
+
+	if item := app.Dao().MatchBlocklist(target); !item.IsEmpty() {
+		return false, RespError(c, 403, i18n.T("You have been blocked"))
+	}
+
+	return true, nil
+}
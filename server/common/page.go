@@ -0,0 +1,46 @@
+package common
+
+import (
+	"cmp"
+
+	"github.com/artalkjs/artalk/v2/internal/core"
+	"github.com/artalkjs/artalk/v2/internal/entity"
+	"github.com/gofiber/fiber/v2"
+	"github.com/samber/lo"
+)
+
+type pageReqParams struct {
+	PageKey  string `query:"page_key" json:"page_key" form:"page_key"`
+	SiteName string `query:"site_name" json:"site_name" form:"site_name"`
+}
+
+// Get the `page_key` and `site_name` in the request query or body
+func getPageReqParams(c *fiber.Ctx) pageReqParams {
+	var p pageReqParams
+	_ = c.QueryParser(&p)
+	if (p.PageKey == "" || p.SiteName == "") && (c.Method() == fiber.MethodPost || c.Method() == fiber.MethodPut) {
+		var body pageReqParams
+		_ = c.BodyParser(&body)
+		p.PageKey = cmp.Or(p.PageKey, body.PageKey)
+		p.SiteName = cmp.Or(p.SiteName, body.SiteName)
+	}
+	return p
+}
+
+// Find the page by the `page_key` and `site_name` in the request query or body
+//
+// An empty page is returned if the request is not related to a page or the page is not found.
+func FindPageByReq(app *core.App, c *fiber.Ctx) entity.Page {
+	p := getPageReqParams(c)
+	if p.PageKey == "" {
+		return entity.Page{}
+	}
+
+	return app.Dao().FindPage(p.PageKey, p.SiteName)
+}
+
+// Get the captcha always mode of the request (the page-level settings override the site config)
+func GetCaptchaAlwaysMode(app *core.App, c *fiber.Ctx) bool {
+	page := FindPageByReq(app, c)
+	return lo.FromPtrOr(page.Settings.CaptchaAlways, GetSiteConf(app, c).Captcha.Always)
+}
@@ -3,6 +3,7 @@ package common
 import (
 	"strings"
 
+	"github.com/artalkjs/artalk/v2/internal/config"
 	"github.com/artalkjs/artalk/v2/internal/core"
 	"github.com/artalkjs/artalk/v2/internal/entity"
 	"github.com/artalkjs/artalk/v2/internal/i18n"
@@ -33,5 +34,28 @@ func CheckSiteExist(app *core.App, c *fiber.Ctx, siteName string) (entity.Cooked
 		)
 	}
 
+	// merge the site config overlay for the request
+	c.Locals(SiteConfLocalKey, app.SiteConf(findSite.Name))
+
 	return app.Dao().CookSite(&findSite), true, nil
 }
+
+const SiteConfLocalKey = "site_conf"
+
+// Get the config of the site in the request (the site config overlay is merged over the global config)
+//
+// The site is determined by `CheckSiteExist` or the `site_name` in the request query or body.
+func GetSiteConf(app *core.App, c *fiber.Ctx) *config.Config {
+	if conf, ok := c.Locals(SiteConfLocalKey).(*config.Config); ok && conf != nil {
+		return conf
+	}
+
+	siteName := strings.TrimSpace(getPageReqParams(c).SiteName)
+	if siteName == "" {
+		return app.Conf()
+	}
+
+	conf := app.SiteConf(siteName)
+	c.Locals(SiteConfLocalKey, conf)
+	return conf
+}
@@ -0,0 +1,79 @@
+package handler_test
+
+import (
+	"encoding/json"
+	"io"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/artalkjs/artalk/v2/internal/entity"
+	"github.com/artalkjs/artalk/v2/server/handler"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCommentListAntiSpamVerdict(t *testing.T) {
+	app, fiber := NewApiTestApp()
+	defer app.Cleanup()
+
+	handler.CommentList(app.App, fiber)
+
+	require.NoError(t, app.Dao().SaveAntiSpamVerdict(&entity.AntiSpamVerdict{
+		CommentID: 1000, Pass: true, Checker: "keywords",
+	}))
+
+	// the previous verdict is replaced
+	require.NoError(t, app.Dao().SaveAntiSpamVerdict(&entity.AntiSpamVerdict{
+		CommentID: 1000, Pass: false, Checker: "akismet", Score: 0.5, Reason: "spam", Response: "body=true",
+	}))
+
+	listComments := func(userID uint) map[uint]entity.CookedComment {
+		req := httptest.NewRequest("GET", "/comments?limit=100&page_key=/test/1000.html&site_name=Site+A", nil)
+		if userID != 0 {
+			req.Header.Set("Authorization", "Bearer "+getUserToken(t, app, userID))
+		}
+		resp, err := fiber.Test(req)
+		require.NoError(t, err)
+		body, _ := io.ReadAll(resp.Body)
+		require.Equal(t, 200, resp.StatusCode, string(body))
+
+		var result handler.ResponseCommentList
+		require.NoError(t, json.Unmarshal(body, &result))
+		return lo.KeyBy(result.Comments, func(c entity.CookedComment) uint { return c.ID })
+	}
+
+	t.Run("Admin", func(t *testing.T) {
+		comments := listComments(1000)
+		require.Contains(t, comments, uint(1000))
+		if assert.NotNil(t, comments[1000].AntiSpamVerdict) {
+			assert.Equal(t, entity.CookedAntiSpamVerdict{
+				Pass: false, Checker: "akismet", Score: 0.5, Reason: "spam", Response: "body=true",
+				Date: comments[1000].AntiSpamVerdict.Date,
+			}, *comments[1000].AntiSpamVerdict)
+		}
+		assert.Nil(t, comments[1001].AntiSpamVerdict, "no verdict")
+	})
+
+	t.Run("Site moderator", func(t *testing.T) {
+		require.NoError(t, app.Dao().SetUserSiteRoles(1002, map[string]entity.SiteRole{"Site B": entity.SiteRoleModerator}))
+		comments := listComments(1002)
+		assert.Nil(t, comments[1000].AntiSpamVerdict, "not the moderator of the site")
+
+		require.NoError(t, app.Dao().SetUserSiteRoles(1002, map[string]entity.SiteRole{"Site A": entity.SiteRoleModerator}))
+		comments = listComments(1002)
+		assert.NotNil(t, comments[1000].AntiSpamVerdict)
+	})
+
+	t.Run("Visitor", func(t *testing.T) {
+		comments := listComments(0)
+		require.Contains(t, comments, uint(1000))
+		assert.Nil(t, comments[1000].AntiSpamVerdict)
+	})
+
+	t.Run("Purge", func(t *testing.T) {
+		comment := app.Dao().FindComment(1000)
+		require.NoError(t, app.Dao().PurgeComment(&comment))
+		assert.True(t, app.Dao().FindAntiSpamVerdict(1000).IsEmpty())
+	})
+}
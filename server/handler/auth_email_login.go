@@ -77,6 +77,8 @@ func AuthEmailLogin(app *core.App, router fiber.Router) {
 			return common.RespError(c, 500, i18n.T("Login failed"))
 		}
 
+		triggerUserLogin(app, &user)
+
 		return common.RespData(c, ResponseUserLogin{
 			Token: jwtToken,
 			User:  app.Dao().CookUser(&user),
@@ -4,6 +4,7 @@ import (
 	"strings"
 
 	"github.com/artalkjs/artalk/v2/internal/core"
+	"github.com/artalkjs/artalk/v2/internal/log"
 	"github.com/artalkjs/artalk/v2/internal/utils"
 	"github.com/artalkjs/artalk/v2/server/common"
 	"github.com/gofiber/fiber/v2"
@@ -81,7 +82,8 @@ func AuthEmailRegister(app *core.App, router fiber.Router) {
 			return common.RespError(c, 500, "Failed to create user")
 		}
 
-		// Update user
+		// Update user (the user without password is registered, otherwise the password is changed)
+		isRegistered := user.Password == ""
 		if err := user.SetPasswordEncrypt(p.Password); err != nil {
 			return common.RespError(c, 500, "Failed to encrypt password")
 		}
@@ -91,6 +93,11 @@ func AuthEmailRegister(app *core.App, router fiber.Router) {
 		if err := app.Dao().UpdateUser(&user); err != nil {
 			return common.RespError(c, 500, "Failed to update user")
 		}
+		if isRegistered {
+			if err := app.OnUserRegistered().Trigger(&core.UserRegisteredEvent{App: app, User: &user}); err != nil {
+				log.Error("[UserRegistered] hook err: ", err)
+			}
+		}
 
 		// Login
 		jwtToken, err := common.LoginGetUserToken(user, app.Conf().AppKey, app.Conf().LoginTimeout)
@@ -98,6 +105,8 @@ func AuthEmailRegister(app *core.App, router fiber.Router) {
 			return common.RespError(c, 500, err.Error())
 		}
 
+		triggerUserLogin(app, &user)
+
 		return common.RespData(c, ResponseUserLogin{
 			Token: jwtToken,
 			User:  app.Dao().CookUser(&user),
@@ -83,7 +83,8 @@ func AuthSocialLogin(app *core.App, router fiber.Router) {
 		authIdentity := app.Dao().FindAuthIdentityByRemoteUID(provider, socialUser.RemoteUID)
 
 		// No auth identity record, register user
-		if authIdentity.IsEmpty() {
+		isRegistered := authIdentity.IsEmpty()
+		if isRegistered {
 			authIdentity, err = auth.RegisterSocialUser(app.Dao(), socialUser)
 			if err != nil {
 				log.Error("[SocialLogin] ", err)
@@ -100,12 +101,20 @@ func AuthSocialLogin(app *core.App, router fiber.Router) {
 			return common.RespError(c, 500, "Failed to find user")
 		}
 
+		if isRegistered {
+			if err := app.OnUserRegistered().Trigger(&core.UserRegisteredEvent{App: app, User: &user}); err != nil {
+				log.Error("[UserRegistered] hook err: ", err)
+			}
+		}
+
 		// Get user token
 		jwtToken, err := common.LoginGetUserToken(user, app.Conf().AppKey, app.Conf().LoginTimeout)
 		if err != nil {
 			return common.RespError(c, 500, err.Error())
 		}
 
+		triggerUserLogin(app, &user)
+
 		// Render response
 		return auth.ResponseCallbackPage(c, jwtToken)
 	}))
@@ -0,0 +1,230 @@
+package handler
+
+import (
+	"strings"
+	"time"
+
+	"github.com/artalkjs/artalk/v2/internal/core"
+	"github.com/artalkjs/artalk/v2/internal/dao"
+	"github.com/artalkjs/artalk/v2/internal/entity"
+	"github.com/artalkjs/artalk/v2/internal/i18n"
+	"github.com/artalkjs/artalk/v2/server/common"
+	"github.com/gofiber/fiber/v2"
+)
+
+type ParamsBlocklistList struct {
+	Type   string `query:"type" json:"type" enums:"ip,email,email_domain,user_id,ua" validate:"optional"` // Filter by type
+	Search string `query:"search" json:"search" validate:"optional"`                                      // Search the value and the reason
+	Limit  int    `query:"limit" json:"limit" validate:"optional"`                                        // The limit for pagination
+	Offset int    `query:"offset" json:"offset" validate:"optional"`                                      // The offset for pagination
+}
+
+type ResponseBlocklistList struct {
+	Items []entity.CookedBlocklistItem `json:"items"`
+	Count int64                        `json:"count"`
+}
+
+// @Id           GetBlocklist
+// @Summary      Get Blocklist
+// @Description  Get the items of the blocklist (latest first)
+// @Tags         Blocklist
+// @Param        options  query  ParamsBlocklistList  true  "The options"
+// @Security     ApiKeyAuth
+// @Produce      json
+// @Success      200  {object}  ResponseBlocklistList
+// @Failure      403  {object}  Map{msg=string}
+// @Router       /blocklist  [get]
+func BlocklistList(app *core.App, router fiber.Router) {
+	router.Get("/blocklist", common.AdminGuard(app, func(c *fiber.Ctx) error {
+		var p ParamsBlocklistList
+		if isOK, resp := common.ParamsDecode(c, &p); !isOK {
+			return resp
+		}
+
+		q := app.Dao().DB().Model(&entity.BlocklistItem{}).Order("id DESC")
+		if p.Type != "" {
+			q = q.Where("type = ?", p.Type)
+		}
+		if p.Search != "" {
+			q = q.Where("value LIKE ? OR reason LIKE ?", "%"+p.Search+"%", "%"+p.Search+"%")
+		}
+
+		var total int64
+		q.Count(&total)
+
+		var items []entity.BlocklistItem
+		q.Scopes(Paginate(p.Offset, p.Limit)).Find(&items)
+
+		cookedItems := []entity.CookedBlocklistItem{}
+		for _, item := range items {
+			cookedItems = append(cookedItems, app.Dao().CookBlocklistItem(&item))
+		}
+
+		return common.RespData(c, ResponseBlocklistList{
+			Items: cookedItems,
+			Count: total,
+		})
+	}))
+}
+
+type ParamsBlocklistCreate struct {
+	Type      entity.BlocklistType `json:"type" enums:"ip,email,email_domain,user_id,ua" validate:"required"` // The item type
+	Value     string               `json:"value" validate:"required"`                                         // The IP or CIDR, email, email domain, user ID or UA regular expression
+	Reason    string               `json:"reason" validate:"optional"`                                        // The reason for blocking
+	ExpiresAt string               `json:"expires_at" validate:"optional"`                                    // The expiry time (format: 2006-01-02 15:04:05), never expires if empty
+}
+
+type ResponseBlocklistCreate struct {
+	entity.CookedBlocklistItem
+}
+
+// @Id           CreateBlocklistItem
+// @Summary      Create Blocklist Item
+// @Description  Add an item to the blocklist, the existing item with the same type and value is replaced
+// @Tags         Blocklist
+// @Param        item  body  ParamsBlocklistCreate  true  "The item data"
+// @Security     ApiKeyAuth
+// @Accept       json
+// @Produce      json
+// @Success      200  {object}  ResponseBlocklistCreate
+// @Failure      400  {object}  Map{msg=string}
+// @Failure      403  {object}  Map{msg=string}
+// @Failure      500  {object}  Map{msg=string}
+// @Router       /blocklist  [post]
+func BlocklistCreate(app *core.App, router fiber.Router) {
+	router.Post("/blocklist", common.AdminGuard(app, func(c *fiber.Ctx) error {
+		var p ParamsBlocklistCreate
+		if isOK, resp := common.ParamsDecode(c, &p); !isOK {
+			return resp
+		}
+
+		item := entity.BlocklistItem{}
+		if ok, resp := fillBlocklistItem(c, &item, p); !ok {
+			return resp
+		}
+
+		// the existing item with the same type and value is replaced
+		items := []entity.BlocklistItem{item}
+		if err := app.Dao().SaveBlocklistItems(items); err != nil {
+			return common.RespError(c, 500, i18n.T("{{name}} save failed", Map{"name": i18n.T("Blocklist")}))
+		}
+
+		return common.RespData(c, ResponseBlocklistCreate{
+			CookedBlocklistItem: app.Dao().CookBlocklistItem(&items[0]),
+		})
+	}))
+}
+
+type ParamsBlocklistUpdate struct {
+	ParamsBlocklistCreate
+}
+
+type ResponseBlocklistUpdate struct {
+	entity.CookedBlocklistItem
+}
+
+// @Id           UpdateBlocklistItem
+// @Summary      Update Blocklist Item
+// @Description  Update an item of the blocklist
+// @Tags         Blocklist
+// @Param        id    path  int                    true  "The item ID"
+// @Param        item  body  ParamsBlocklistUpdate  true  "The item data"
+// @Security     ApiKeyAuth
+// @Accept       json
+// @Produce      json
+// @Success      200  {object}  ResponseBlocklistUpdate
+// @Failure      400  {object}  Map{msg=string}
+// @Failure      403  {object}  Map{msg=string}
+// @Failure      404  {object}  Map{msg=string}
+// @Failure      500  {object}  Map{msg=string}
+// @Router       /blocklist/{id}  [put]
+func BlocklistUpdate(app *core.App, router fiber.Router) {
+	router.Put("/blocklist/:id", common.AdminGuard(app, func(c *fiber.Ctx) error {
+		id, _ := c.ParamsInt("id")
+
+		var p ParamsBlocklistUpdate
+		if isOK, resp := common.ParamsDecode(c, &p); !isOK {
+			return resp
+		}
+
+		item := app.Dao().FindBlocklistItem(uint(id))
+		if item.IsEmpty() {
+			return common.RespError(c, 404, i18n.T("{{name}} not found", Map{"name": i18n.T("Blocklist")}))
+		}
+
+		if ok, resp := fillBlocklistItem(c, &item, p.ParamsBlocklistCreate); !ok {
+			return resp
+		}
+
+		if err := app.Dao().UpdateBlocklistItem(&item); err != nil {
+			return common.RespError(c, 500, i18n.T("{{name}} save failed", Map{"name": i18n.T("Blocklist")}))
+		}
+
+		return common.RespData(c, ResponseBlocklistUpdate{
+			CookedBlocklistItem: app.Dao().CookBlocklistItem(&item),
+		})
+	}))
+}
+
+// @Id           DeleteBlocklistItem
+// @Summary      Delete Blocklist Item
+// @Description  Remove an item from the blocklist
+// @Tags         Blocklist
+// @Param        id  path  int  true  "The item ID"
+// @Security     ApiKeyAuth
+// @Produce      json
+// @Success      200  {object}  Map{}
+// @Failure      403  {object}  Map{msg=string}
+// @Failure      404  {object}  Map{msg=string}
+// @Failure      500  {object}  Map{msg=string}
+// @Router       /blocklist/{id}  [delete]
+func BlocklistDelete(app *core.App, router fiber.Router) {
+	router.Delete("/blocklist/:id", common.AdminGuard(app, func(c *fiber.Ctx) error {
+		id, _ := c.ParamsInt("id")
+
+		item := app.Dao().FindBlocklistItem(uint(id))
+		if item.IsEmpty() {
+			return common.RespError(c, 404, i18n.T("{{name}} not found", Map{"name": i18n.T("Blocklist")}))
+		}
+
+		if err := app.Dao().DelBlocklistItem(&item); err != nil {
+			return common.RespError(c, 500, i18n.T("{{name}} deletion failed", Map{"name": i18n.T("Blocklist")}))
+		}
+
+		return common.RespSuccess(c)
+	}))
+}
+
+// Validate the params and fill them into the blocklist item
+func fillBlocklistItem(c *fiber.Ctx, item *entity.BlocklistItem, p ParamsBlocklistCreate) (bool, error) {
+	expiresAt, ok, resp := parseBlocklistExpiresAt(c, p.ExpiresAt)
+	if !ok {
+		return false, resp
+	}
+
+	item.Type = p.Type
+	item.Value = strings.TrimSpace(p.Value)
+	item.Reason = p.Reason
+	item.ExpiresAt = expiresAt
+
+	if err := item.Validate(); err != nil {
+		return false, common.RespError(c, 400, i18n.T("Invalid {{name}}", Map{"name": i18n.T("Blocklist")}), Map{
+			"detail": err.Error(),
+		})
+	}
+
+	return true, nil
+}
+
+func parseBlocklistExpiresAt(c *fiber.Ctx, expiresAt string) (*time.Time, bool, error) {
+	if expiresAt == "" {
+		return nil, true, nil
+	}
+
+	t, err := time.ParseInLocation(dao.CommonDateTimeFormat, expiresAt, time.Local)
+	if err != nil {
+		return nil, false, common.RespError(c, 400, i18n.T("Invalid {{name}}", Map{"name": "expires_at"}))
+	}
+
+	return &t, true, nil
+}
@@ -0,0 +1,163 @@
+package handler_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/artalkjs/artalk/v2/internal/entity"
+	"github.com/artalkjs/artalk/v2/server/handler"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBlocklist(t *testing.T) {
+	app, fiber := NewApiTestApp()
+	defer app.Cleanup()
+
+	handler.BlocklistList(app.App, fiber)
+	handler.BlocklistCreate(app.App, fiber)
+	handler.BlocklistUpdate(app.App, fiber)
+	handler.BlocklistDelete(app.App, fiber)
+	handler.CommentBan(app.App, fiber)
+	handler.CommentCreate(app.App, fiber)
+	handler.VoteCreate(app.App, fiber)
+
+	request := func(userID uint, method, target string, data map[string]any) (int, []byte) {
+		body, _ := json.Marshal(data)
+		req := httptest.NewRequest(method, target, bytes.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("User-Agent", "test-agent")
+		if userID != 0 {
+			req.Header.Set("Authorization", "Bearer "+getUserToken(t, app, userID))
+		}
+		resp, err := fiber.Test(req)
+		require.NoError(t, err)
+		respBody, _ := io.ReadAll(resp.Body)
+		return resp.StatusCode, respBody
+	}
+
+	createComment := func(userID uint, email string) int {
+		code, _ := request(userID, "POST", "/comments", map[string]any{
+			"name": "troll", "email": email, "content": "Hello",
+			"page_key": "/test/1000.html", "site_name": "Site A",
+		})
+		return code
+	}
+
+	t.Run("CRUD", func(t *testing.T) {
+		code, _ := request(1001, "POST", "/blocklist", map[string]any{"type": "ip", "value": "1.2.3.4"})
+		assert.Equal(t, 403, code, "admin only")
+
+		code, _ = request(1000, "POST", "/blocklist", map[string]any{"type": "ip", "value": "1.2.3"})
+		assert.Equal(t, 400, code, "invalid value")
+
+		code, _ = request(1000, "POST", "/blocklist", map[string]any{"type": "ip", "value": "1.2.3.4", "expires_at": "tomorrow"})
+		assert.Equal(t, 400, code, "invalid expires_at")
+
+		code, body := request(1000, "POST", "/blocklist", map[string]any{
+			"type": "email_domain", "value": "spam.com", "reason": "spammer", "expires_at": "2099-01-01 00:00:00",
+		})
+		require.Equal(t, 200, code, string(body))
+
+		var created handler.ResponseBlocklistCreate
+		require.NoError(t, json.Unmarshal(body, &created))
+		assert.Equal(t, entity.BlocklistTypeEmailDomain, created.Type)
+		assert.Equal(t, "2099-01-01 00:00:00", created.ExpiresAt)
+		assert.False(t, created.IsExpired)
+
+		code, body = request(1000, "PUT", fmt.Sprintf("/blocklist/%d", created.ID), map[string]any{
+			"type": "email_domain", "value": "spam.com", "reason": "updated",
+		})
+		require.Equal(t, 200, code, string(body))
+
+		var updated handler.ResponseBlocklistUpdate
+		require.NoError(t, json.Unmarshal(body, &updated))
+		assert.Equal(t, "updated", updated.Reason)
+		assert.Empty(t, updated.ExpiresAt)
+
+		code, body = request(1000, "POST", "/blocklist", map[string]any{"type": "email_domain", "value": "spam.com", "reason": "duplicated"})
+		require.Equal(t, 200, code, string(body))
+
+		var duplicated handler.ResponseBlocklistCreate
+		require.NoError(t, json.Unmarshal(body, &duplicated))
+		assert.Equal(t, created.ID, duplicated.ID, "the existing item is replaced")
+		assert.Equal(t, "duplicated", duplicated.Reason)
+
+		code, body = request(1000, "GET", "/blocklist?type=email_domain", nil)
+		require.Equal(t, 200, code, string(body))
+
+		var list handler.ResponseBlocklistList
+		require.NoError(t, json.Unmarshal(body, &list))
+		assert.Equal(t, int64(1), list.Count)
+		assert.Equal(t, "spam.com", list.Items[0].Value)
+
+		// blocked by the email domain
+		assert.Equal(t, 403, createComment(0, "troll@spam.com"))
+		code, _ = request(0, "POST", "/votes/comment/1000/up", map[string]any{"name": "troll", "email": "troll@spam.com"})
+		assert.Equal(t, 403, code)
+
+		code, _ = request(1000, "DELETE", fmt.Sprintf("/blocklist/%d", created.ID), nil)
+		assert.Equal(t, 200, code)
+		assert.True(t, app.Dao().FindBlocklistItem(created.ID).IsEmpty())
+
+		code, _ = request(0, "POST", "/votes/comment/1000/up", map[string]any{"name": "troll", "email": "troll@spam.com"})
+		assert.Equal(t, 200, code, "not blocked after deletion")
+	})
+
+	t.Run("Ban comment author", func(t *testing.T) {
+		code, _ := request(1000, "POST", "/comments/1000/ban", map[string]any{"types": []string{"unknown"}})
+		assert.Equal(t, 400, code)
+
+		code, body := request(1000, "POST", "/comments/1005/ban", map[string]any{"reason": "troll"})
+		require.Equal(t, 200, code, string(body))
+
+		var result handler.ResponseCommentBan
+		require.NoError(t, json.Unmarshal(body, &result))
+		assert.Equal(t, map[entity.BlocklistType]string{
+			entity.BlocklistTypeIP:     "10.90.2.102",
+			entity.BlocklistTypeUserID: "1002",
+		}, lo.SliceToMap(result.Items, func(item entity.CookedBlocklistItem) (entity.BlocklistType, string) {
+			return item.Type, item.Value
+		}))
+
+		// ban again does not duplicate the items
+		code, _ = request(1000, "POST", "/comments/1005/ban", map[string]any{"reason": "troll"})
+		require.Equal(t, 200, code)
+		var count int64
+		app.Dao().DB().Model(&entity.BlocklistItem{}).Count(&count)
+		assert.Equal(t, int64(2), count)
+
+		// the user is blocked (by the email of the user if not login)
+		assert.Equal(t, 403, createComment(0, "user_b@qwqaq.com"))
+		assert.Equal(t, 403, createComment(1002, "user_b@qwqaq.com"))
+
+		// the UA of the author
+		code, body = request(1000, "POST", "/comments/1004/ban", map[string]any{"types": []string{"ua"}})
+		require.Equal(t, 200, code, string(body))
+		require.NoError(t, json.Unmarshal(body, &result))
+		assert.Equal(t, `^Mozilla/5\.0 \(X11; Linux x86_64\) AppleWebKit/537\.36 \(KHTML, like Gecko\) Chrome/51\.0\.2704\.103 Safari/537\.36$`, result.Items[0].Value)
+	})
+
+	t.Run("Admin is never blocked", func(t *testing.T) {
+		code, body := request(1000, "POST", "/blocklist", map[string]any{"type": "ua", "value": "^test-agent$"})
+		require.Equal(t, 200, code, string(body))
+
+		code, _ = request(1000, "POST", "/votes/comment/1000/up", nil)
+		assert.Equal(t, 200, code)
+		code, _ = request(0, "POST", "/votes/comment/1000/up", nil)
+		assert.Equal(t, 403, code)
+	})
+
+	t.Run("Cannot ban admin", func(t *testing.T) {
+		comment := entity.Comment{Content: "admin", PageKey: "/test/1000.html", SiteName: "Site A", UserID: 1000}
+		require.NoError(t, app.Dao().CreateComment(&comment))
+
+		code, _ := request(1000, "POST", fmt.Sprintf("/comments/%d/ban", comment.ID), nil)
+		assert.Equal(t, 400, code)
+	})
+}
@@ -8,7 +8,7 @@ import (
 
 func Captcha(app *core.App, router fiber.Router) {
 	router.Group("/captcha", func(c *fiber.Ctx) error {
-		if !app.Conf().Captcha.Enabled {
+		if !common.GetSiteConf(app, c).Captcha.Enabled {
 			return common.RespError(c, 404, "Captcha disabled")
 		}
 		return c.Next()
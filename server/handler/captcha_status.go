@@ -14,6 +14,8 @@ type ResponseCaptchaStatus struct {
 // @Summary      Get Captcha Status
 // @Description  Get the status of the user's captcha verification
 // @Tags         Captcha
+// @Param        page_key   query  string  false  "The page key (to apply the page-level captcha settings)"
+// @Param        site_name  query  string  false  "The site name of the page (to apply the site config overlay)"
 // @Produce      json
 // @Success      200  {object}  ResponseCaptchaStatus
 // @Router       /captcha/status  [get]
@@ -25,7 +27,7 @@ func CaptchaStatus(app *core.App, router fiber.Router) {
 		}
 
 		return common.RespData(c, ResponseCaptchaStatus{
-			IsPass: limiter.IsPass(c.IP()),
+			IsPass: limiter.IsPassWithAlwaysMode(c.IP(), common.GetCaptchaAlwaysMode(app, c)),
 		})
 	})
 }
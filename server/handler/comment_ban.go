@@ -0,0 +1,120 @@
+package handler
+
+import (
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/artalkjs/artalk/v2/internal/core"
+	"github.com/artalkjs/artalk/v2/internal/entity"
+	"github.com/artalkjs/artalk/v2/internal/i18n"
+	"github.com/artalkjs/artalk/v2/server/common"
+	"github.com/gofiber/fiber/v2"
+)
+
+type ParamsCommentBan struct {
+	Types     []entity.BlocklistType `json:"types" validate:"optional"`      // The item types to block (default: ip and user_id)
+	Reason    string                 `json:"reason" validate:"optional"`     // The reason for blocking
+	ExpiresAt string                 `json:"expires_at" validate:"optional"` // The expiry time (format: 2006-01-02 15:04:05), never expires if empty
+}
+
+type ResponseCommentBan struct {
+	Items []entity.CookedBlocklistItem `json:"items"` // The blocklist items of the author
+}
+
+// @Id           BanCommentAuthor
+// @Summary      Ban Comment Author
+// @Description  Add the author of the comment to the blocklist, the values (such as the IP and the user ID) are filled from the comment
+// @Tags         Blocklist
+// @Param        id       path  int               true  "The comment ID"
+// @Param        options  body  ParamsCommentBan  true  "The options"
+// @Security     ApiKeyAuth
+// @Accept       json
+// @Produce      json
+// @Success      200  {object}  ResponseCommentBan
+// @Failure      400  {object}  Map{msg=string}
+// @Failure      403  {object}  Map{msg=string}
+// @Failure      404  {object}  Map{msg=string}
+// @Failure      500  {object}  Map{msg=string}
+// @Router       /comments/{id}/ban  [post]
+func CommentBan(app *core.App, router fiber.Router) {
+	router.Post("/comments/:id/ban", common.AdminGuard(app, func(c *fiber.Ctx) error {
+		id, _ := c.ParamsInt("id")
+
+		var p ParamsCommentBan
+		if isOK, resp := common.ParamsDecode(c, &p); !isOK {
+			return resp
+		}
+
+		comment := app.Dao().FindComment(uint(id))
+		if comment.IsEmpty() {
+			return common.RespError(c, 404, i18n.T("{{name}} not found", Map{"name": i18n.T("Comment")}))
+		}
+
+		user := app.Dao().FindUserByID(comment.UserID)
+		if user.IsAdmin {
+			return common.RespError(c, 400, i18n.T("Cannot block an admin"))
+		}
+
+		expiresAt, ok, resp := parseBlocklistExpiresAt(c, p.ExpiresAt)
+		if !ok {
+			return resp
+		}
+
+		types := p.Types
+		if len(types) == 0 {
+			types = []entity.BlocklistType{entity.BlocklistTypeIP, entity.BlocklistTypeUserID}
+		}
+
+		// The values filled from the comment (the empty ones are skipped)
+		values := map[entity.BlocklistType]string{
+			entity.BlocklistTypeIP:     comment.IP,
+			entity.BlocklistTypeUserID: strconv.FormatUint(uint64(comment.UserID), 10),
+			entity.BlocklistTypeEmail:  user.Email,
+			entity.BlocklistTypeUA:     "^" + regexp.QuoteMeta(comment.UA) + "$",
+		}
+		if _, domain, ok := strings.Cut(user.Email, "@"); ok {
+			values[entity.BlocklistTypeEmailDomain] = domain
+		}
+		if comment.UserID == 0 {
+			values[entity.BlocklistTypeUserID] = ""
+		}
+		if comment.UA == "" {
+			values[entity.BlocklistTypeUA] = ""
+		}
+
+		items := []entity.BlocklistItem{}
+		for _, t := range types {
+			if !t.IsValid() {
+				return common.RespError(c, 400, i18n.T("Invalid {{name}}", Map{"name": i18n.T("Type")}))
+			}
+			if values[t] == "" || slices.ContainsFunc(items, func(item entity.BlocklistItem) bool { return item.Type == t }) {
+				continue
+			}
+			items = append(items, entity.BlocklistItem{
+				Type:      t,
+				Value:     values[t],
+				Reason:    p.Reason,
+				ExpiresAt: expiresAt,
+			})
+		}
+		if len(items) == 0 {
+			return common.RespError(c, 400, i18n.T("Nothing to block"))
+		}
+
+		// Save the items (the existing ones with the same value are replaced)
+		if err := app.Dao().SaveBlocklistItems(items); err != nil {
+			return common.RespError(c, 500, i18n.T("{{name}} save failed", Map{"name": i18n.T("Blocklist")}))
+		}
+
+		cookedItems := []entity.CookedBlocklistItem{}
+		for _, item := range items {
+			cookedItems = append(cookedItems, app.Dao().CookBlocklistItem(&item))
+		}
+
+		return common.RespData(c, ResponseCommentBan{
+			Items: cookedItems,
+		})
+	}))
+}
@@ -0,0 +1,220 @@
+package handler
+
+import (
+	"github.com/artalkjs/artalk/v2/internal/core"
+	"github.com/artalkjs/artalk/v2/internal/dao"
+	"github.com/artalkjs/artalk/v2/internal/entity"
+	"github.com/artalkjs/artalk/v2/internal/i18n"
+	"github.com/artalkjs/artalk/v2/internal/log"
+	"github.com/artalkjs/artalk/v2/server/common"
+	cog "github.com/artalkjs/artalk/v2/server/handler/comments_get"
+	"github.com/gofiber/fiber/v2"
+	"github.com/samber/lo"
+)
+
+// The maximum number of the comments moderated in one batch
+const commentBatchLimit = 1000
+
+type CommentBatchAction string
+
+const (
+	CommentBatchApprove  CommentBatchAction = "approve"
+	CommentBatchPending  CommentBatchAction = "pending"
+	CommentBatchCollapse CommentBatchAction = "collapse"
+	CommentBatchPin      CommentBatchAction = "pin"
+	CommentBatchDelete   CommentBatchAction = "delete"
+)
+
+type ParamsCommentBatch struct {
+	Action CommentBatchAction        `json:"action" enums:"approve,pending,collapse,pin,delete" validate:"required"` // The action for the comments
+	IDs    []uint                    `json:"ids" validate:"optional"`                                                // The comment IDs (either the ids or the filter is required)
+	Filter *ParamsCommentBatchFilter `json:"filter" validate:"optional"`                                             // The filter of the comments (at most 1000 comments are matched, the earliest first)
+}
+
+type ParamsCommentBatchFilter struct {
+	SiteName string `json:"site_name" validate:"optional"`                // Filter by site name
+	Type     string `json:"type" enums:"all,pending" validate:"optional"` // Filter by the pending status
+	Search   string `json:"search" validate:"optional"`                   // Search keywords
+	DateFrom string `json:"date_from" validate:"optional"`                // Only the comments created after the time (format: 2006-01-02 15:04:05)
+	DateTo   string `json:"date_to" validate:"optional"`                  // Only the comments created before the time (format: 2006-01-02 15:04:05)
+}
+
+type CommentBatchResult struct {
+	ID      uint   `json:"id"`
+	Success bool   `json:"success"`
+	Error   string `json:"error,omitempty"` // The reason of the failure
+}
+
+type ResponseCommentBatch struct {
+	Results   []CommentBatchResult `json:"results"`
+	Succeeded int                  `json:"succeeded"`
+	Failed    int                  `json:"failed"`
+	Total     int64                `json:"total"`     // The number of the comments matched by the filter (may exceed the batch limit)
+	Truncated bool                 `json:"truncated"` // Whether the filter matches more comments than the batch limit (only the earliest ones are moderated)
+}
+
+// @Id           BatchComments
+// @Summary      Batch Moderate Comments
+// @Description  Approve, set pending, collapse, pin or delete the comments in one transaction, the comments are specified by the ids or a filter. All the comments are failed if the transaction is rolled back
+// @Tags         Comment
+// @Param        options  body  ParamsCommentBatch  true  "The options"
+// @Security     ApiKeyAuth
+// @Accept       json
+// @Produce      json
+// @Success      200  {object}  ResponseCommentBatch
+// @Failure      400  {object}  Map{msg=string}
+// @Failure      403  {object}  Map{msg=string}
+// @Router       /comments/batch  [post]
+func CommentBatch(app *core.App, router fiber.Router) {
+	router.Post("/comments/batch", common.SiteRoleGuard(app, func(c *fiber.Ctx) error {
+		var p ParamsCommentBatch
+		if isOK, resp := common.ParamsDecode(c, &p); !isOK {
+			return resp
+		}
+
+		if !lo.Contains([]CommentBatchAction{CommentBatchApprove, CommentBatchPending,
+			CommentBatchCollapse, CommentBatchPin, CommentBatchDelete}, p.Action) {
+			return common.RespError(c, 400, i18n.T("Invalid {{name}}", Map{"name": "action"}))
+		}
+		if (len(p.IDs) == 0) == (p.Filter == nil) {
+			return common.RespError(c, 400, "Either ids or filter is required")
+		}
+
+		user, _ := common.GetUserByReq(app, c)
+
+		// Resolve the ids of the comments
+		ids := lo.Uniq(p.IDs)
+		total := int64(len(ids))
+		if p.Filter != nil {
+			dateFrom, dateTo, ok, resp := parseDateRangeParams(c, p.Filter.DateFrom, p.Filter.DateTo)
+			if !ok {
+				return resp
+			}
+			ids, total = cog.FindCommentIDs(app.Dao(), cog.QueryOptions{
+				User:          user,
+				RoleSiteNames: app.Dao().GetUserRoleSiteNames(user.ID),
+				Scope:         cog.ScopeSite,
+				SitePayload: cog.SitePayload{
+					Type:     cog.SiteScopeType(p.Filter.Type),
+					SiteName: p.Filter.SiteName,
+				},
+				Search:        p.Filter.Search,
+				CreatedAfter:  dateFrom,
+				CreatedBefore: dateTo,
+			}, commentBatchLimit)
+		}
+		if len(ids) > commentBatchLimit {
+			return common.RespError(c, 400, i18n.T("Too many comments, at most {{count}} comments in one batch", Map{"count": commentBatchLimit}))
+		}
+
+		// Check the comments (the moderators can approve, collapse and delete the comments of their sites,
+		// and pinning requires the site admin role)
+		requiredRole := lo.Ternary(p.Action == CommentBatchPin, entity.SiteRoleAdmin, entity.SiteRoleModerator)
+		checkErrs := map[uint]string{}
+		comments := []entity.Comment{}
+		for _, id := range ids {
+			comment := app.Dao().FindComment(id)
+			switch {
+			case comment.IsEmpty():
+				checkErrs[id] = i18n.T("{{name}} not found", Map{"name": i18n.T("Comment")})
+			case !common.CheckSiteRoleReq(app, c, comment.SiteName, requiredRole):
+				checkErrs[id] = i18n.T("No permission for this site")
+			default:
+				comments = append(comments, comment)
+			}
+		}
+
+		// The comments whose status is changed by the action
+		changed := lo.Filter(comments, func(comment entity.Comment, _ int) bool {
+			switch p.Action {
+			case CommentBatchApprove:
+				return comment.IsPending
+			case CommentBatchPending:
+				return !comment.IsPending
+			case CommentBatchCollapse:
+				return !comment.IsCollapsed
+			case CommentBatchPin:
+				return !comment.IsPinned
+			}
+			return true
+		})
+
+		err := app.Dao().Transaction(func(tx *dao.Dao) error {
+			switch p.Action {
+			case CommentBatchApprove:
+				return tx.UpdateCommentsColumn(changed, "is_pending", false)
+			case CommentBatchPending:
+				return tx.UpdateCommentsColumn(changed, "is_pending", true)
+			case CommentBatchCollapse:
+				return tx.UpdateCommentsColumn(changed, "is_collapsed", true)
+			case CommentBatchPin:
+				return tx.UpdateCommentsColumn(changed, "is_pinned", true)
+			case CommentBatchDelete:
+				for _, comment := range changed {
+					if err := tx.DelComment(&comment); err != nil {
+						return err
+					}
+					// the pending comment is rejected by the moderators
+					if comment.IsPending {
+						if err := tx.SaveCommentModeration(&comment, entity.CommentModerationRejected); err != nil {
+							return err
+						}
+					}
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			log.Error("[CommentBatch] err: ", err)
+		}
+
+		// The results are known after the transaction, all the comments are failed if rolled back
+		results := make([]CommentBatchResult, 0, len(ids))
+		for _, id := range ids {
+			switch {
+			case checkErrs[id] != "":
+				results = append(results, CommentBatchResult{ID: id, Error: checkErrs[id]})
+			case err != nil:
+				results = append(results, CommentBatchResult{ID: id, Error: i18n.T("{{name}} save failed", Map{"name": i18n.T("Comment")})})
+			default:
+				results = append(results, CommentBatchResult{ID: id, Success: true})
+			}
+		}
+		succeeded := lo.CountBy(results, func(r CommentBatchResult) bool { return r.Success })
+		resp := ResponseCommentBatch{
+			Results:   results,
+			Succeeded: succeeded,
+			Failed:    len(results) - succeeded,
+			Total:     total,
+			Truncated: total > int64(len(ids)),
+		}
+		if err != nil {
+			return common.RespData(c, resp)
+		}
+
+		// The deleted hooks are triggered by the dao
+		if p.Action != CommentBatchDelete {
+			// Learn from the moderation decisions and notify the users of the approved comments
+			isModerated := p.Action == CommentBatchApprove || p.Action == CommentBatchPending
+			for _, comment := range changed {
+				switch p.Action {
+				case CommentBatchApprove:
+					comment.IsPending = false
+					if err := renotifyWhenPendingModified(app, &comment); err != nil {
+						log.Error("[RenotifyWhenPendingModified] error: ", err)
+					}
+					pushCommentMentions(app, &comment)
+				case CommentBatchPending:
+					comment.IsPending = true
+				case CommentBatchCollapse:
+					comment.IsCollapsed = true
+				case CommentBatchPin:
+					comment.IsPinned = true
+				}
+				triggerCommentUpdated(app, &comment, isModerated)
+			}
+		}
+
+		return common.RespData(c, resp)
+	}))
+}
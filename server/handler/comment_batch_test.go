@@ -0,0 +1,127 @@
+package handler_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/artalkjs/artalk/v2/internal/entity"
+	"github.com/artalkjs/artalk/v2/server/handler"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCommentBatch(t *testing.T) {
+	app, fiber := NewApiTestApp()
+	defer app.Cleanup()
+
+	handler.CommentBatch(app.App, fiber)
+
+	batch := func(userID uint, data map[string]any) (int, []byte) {
+		body, _ := json.Marshal(data)
+		req := httptest.NewRequest("POST", "/comments/batch", bytes.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("Authorization", "Bearer "+getUserToken(t, app, userID))
+		resp, err := fiber.Test(req)
+		require.NoError(t, err)
+		respBody, _ := io.ReadAll(resp.Body)
+		return resp.StatusCode, respBody
+	}
+
+	batchOK := func(t *testing.T, userID uint, data map[string]any) handler.ResponseCommentBatch {
+		code, body := batch(userID, data)
+		require.Equal(t, 200, code, string(body))
+		var result handler.ResponseCommentBatch
+		require.NoError(t, json.Unmarshal(body, &result))
+		return result
+	}
+
+	t.Run("Invalid", func(t *testing.T) {
+		code, _ := batch(1000, map[string]any{"action": "unknown", "ids": []uint{1000}})
+		assert.Equal(t, 400, code, "invalid action")
+
+		code, _ = batch(1000, map[string]any{"action": "approve"})
+		assert.Equal(t, 400, code, "neither ids nor filter")
+
+		code, _ = batch(1000, map[string]any{"action": "approve", "ids": []uint{1000}, "filter": map[string]any{}})
+		assert.Equal(t, 400, code, "both ids and filter")
+
+		code, _ = batch(1000, map[string]any{"action": "approve", "filter": map[string]any{"date_from": "yesterday"}})
+		assert.Equal(t, 400, code, "invalid date")
+
+		code, _ = batch(1001, map[string]any{"action": "approve", "ids": []uint{1000}})
+		assert.Equal(t, 403, code, "not a site manager")
+	})
+
+	t.Run("Approve by ids", func(t *testing.T) {
+		result := batchOK(t, 1000, map[string]any{"action": "approve", "ids": []uint{1007, 99999}})
+		assert.Equal(t, 1, result.Succeeded)
+		assert.Equal(t, 1, result.Failed)
+		assert.True(t, result.Results[0].Success)
+		assert.False(t, result.Results[1].Success)
+		assert.NotEmpty(t, result.Results[1].Error)
+
+		comment := app.Dao().FindComment(1007)
+		assert.False(t, comment.IsPending)
+	})
+
+	t.Run("Collapse by filter", func(t *testing.T) {
+		result := batchOK(t, 1000, map[string]any{"action": "collapse", "filter": map[string]any{
+			"site_name": "Site A",
+			"date_from": "2022-04-29 16:00:00",
+			"date_to":   "2022-04-29 17:00:00",
+		}})
+		assert.Equal(t, int64(3), result.Total)
+		assert.False(t, result.Truncated)
+		assert.ElementsMatch(t, []uint{1002, 1003, 1004}, lo.Map(result.Results, func(r handler.CommentBatchResult, _ int) uint { return r.ID }))
+
+		for _, id := range []uint{1002, 1003, 1004} {
+			assert.True(t, app.Dao().FindComment(id).IsCollapsed, id)
+		}
+		assert.False(t, app.Dao().FindComment(1000).IsCollapsed)
+	})
+
+	t.Run("Truncated by the batch limit", func(t *testing.T) {
+		comments := make([]entity.Comment, 1001)
+		for i := range comments {
+			comments[i] = entity.Comment{Content: "batch", PageKey: "/batch.html", SiteName: "Site Batch"}
+		}
+		require.NoError(t, app.Dao().DB().CreateInBatches(comments, 100).Error)
+
+		result := batchOK(t, 1000, map[string]any{"action": "collapse", "filter": map[string]any{"site_name": "Site Batch"}})
+		assert.Equal(t, int64(1001), result.Total)
+		assert.True(t, result.Truncated)
+		assert.Len(t, result.Results, 1000)
+		assert.Equal(t, 1000, result.Succeeded)
+	})
+
+	t.Run("Site moderator", func(t *testing.T) {
+		require.NoError(t, app.Dao().SetUserSiteRoles(1002, map[string]entity.SiteRole{"Site A": entity.SiteRoleModerator}))
+
+		result := batchOK(t, 1002, map[string]any{"action": "pending", "ids": []uint{1001, 1006}})
+		assert.True(t, result.Results[0].Success)
+		assert.False(t, result.Results[1].Success, "not the moderator of Site B")
+		assert.True(t, app.Dao().FindComment(1001).IsPending)
+		assert.False(t, app.Dao().FindComment(1006).IsPending)
+
+		result = batchOK(t, 1002, map[string]any{"action": "pin", "ids": []uint{1001}})
+		assert.Equal(t, 0, result.Succeeded, "pinning requires the site admin role")
+		assert.False(t, app.Dao().FindComment(1001).IsPinned)
+	})
+
+	t.Run("Delete", func(t *testing.T) {
+		result := batchOK(t, 1000, map[string]any{"action": "delete", "ids": []uint{1001, 1002}})
+		assert.Equal(t, 2, result.Succeeded)
+		assert.True(t, app.Dao().FindComment(1001).IsEmpty())
+		assert.True(t, app.Dao().FindComment(1002).IsEmpty())
+
+		var decisions []entity.CommentModeration
+		app.Dao().DB().Where("comment_id IN ?", []uint{1001, 1002}).Find(&decisions)
+		require.Len(t, decisions, 1, "the deletion of the approved comment is not a rejection")
+		assert.Equal(t, uint(1001), decisions[0].CommentID)
+		assert.Equal(t, entity.CommentModerationRejected, decisions[0].Decision)
+	})
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/artalkjs/artalk/v2/internal/utils"
 	"github.com/artalkjs/artalk/v2/server/common"
 	"github.com/gofiber/fiber/v2"
+	"github.com/samber/lo"
 )
 
 type ParamsCommentCreate struct {
@@ -40,6 +41,7 @@ type ResponseCommentCreate struct {
 // @Security     ApiKeyAuth
 // @Success      200  {object}  ResponseCommentCreate
 // @Failure      400  {object}  Map{msg=string}
+// @Failure      403  {object}  Map{msg=string}
 // @Failure      500  {object}  Map{msg=string}
 // @Accept       json
 // @Produce      json
@@ -58,6 +60,10 @@ func CommentCreate(app *core.App, router fiber.Router) {
 			return common.RespError(c, 400, i18n.T("Invalid {{name}}", Map{"name": i18n.T("Link")}))
 		}
 
+		if ok, resp := common.CheckBlocklistReq(app, c, p.Email); !ok {
+			return resp
+		}
+
 		if _, ok, resp := common.CheckSiteExist(app, c, p.SiteName); !ok {
 			return resp
 		}
@@ -83,6 +89,11 @@ func CommentCreate(app *core.App, router fiber.Router) {
 			return resp
 		}
 
+		// Check the comments of the page are not closed (except for admin)
+		if page.IsClosed() && !isAdmin {
+			return common.RespError(c, 403, i18n.T("Comments are closed"))
+		}
+
 		// Check parent comment (reply a comment)
 		var parentComment entity.Comment
 		if p.Rid != 0 {
@@ -132,21 +143,38 @@ func CommentCreate(app *core.App, router fiber.Router) {
 		}
 
 		// Set the default pending status
-		// (if not admin and the `PendingDefault` is enabled)
-		if !isAdmin && app.Conf().Moderator.PendingDefault {
+		// (if not admin and the `PendingDefault` is enabled, which can be overridden by the site config and page settings,
+		// the trusted users are skipped, but only if logged in, since anyone can comment with the name and email of others)
+		moderatorConf := common.GetSiteConf(app, c).Moderator
+		if !isAdmin && lo.FromPtrOr(page.Settings.PendingDefault, moderatorConf.PendingDefault) &&
+			!(isVerified && app.Dao().IsUserTrusted(user, lo.Ternary(moderatorConf.Trust.Enabled, moderatorConf.Trust.MinApproved, 0))) {
 			comment.IsPending = true
 		}
 
+		// Resolve the `@name` mentions
+		resolveCommentMentions(app, &comment)
+
 		// Save the comment
 		if err := app.Dao().CreateComment(&comment); err != nil {
 			log.Error("Save Comment error: ", err)
 			return common.RespError(c, 500, i18n.T("Comment failed"))
 		}
 
-		// Async jobs after comment created
-		go commentCreatedJobs(app, comment, parentComment, commentCreatedJobsArguments{
-			ip, ua, referer, isAdmin, isVerified, page,
-		})
+		// Async jobs after comment created (page update, anti-spam check, notify, etc.)
+		go func(comment entity.Comment, parentComment entity.Comment, page entity.Page) {
+			if err := app.OnCommentCreated().Trigger(&core.CommentCreatedEvent{
+				App:           app,
+				Comment:       &comment,
+				ParentComment: &parentComment,
+				Page:          &page,
+				ReqIP:         ip,
+				ReqUserAgent:  ua,
+				ReqReferer:    referer,
+				IsAdmin:       isAdmin,
+			}); err != nil {
+				log.Error("[CommentCreated] hook err: ", err)
+			}
+		}(comment, parentComment, page)
 
 		// Response the comment data
 		cookedComment := app.Dao().CookComment(&comment)
@@ -225,47 +253,24 @@ func getUpdateAnonymousUser(app *core.App, name string, email string, link strin
 	return user, nil
 }
 
-type commentCreatedJobsArguments struct {
-	IP         string
-	UA         string
-	Referer    string
-	IsAdmin    bool
-	IsVerified bool
-	Page       entity.Page
-}
-
-// The jobs after comment created (should call in async)
-//
-// It will do the following jobs:
-//  1. Page Update
-//  2. AntiSpam Check
-//  3. Send Notify (email, webhook, telegram, etc.)
-func commentCreatedJobs(app *core.App, comment entity.Comment, parentComment entity.Comment, args commentCreatedJobsArguments) {
-	// Page Update (if the original page title is empty and the URL is given)
-	if app.Dao().CookPage(&args.Page).URL != "" && args.Page.Title == "" {
-		go app.Dao().FetchPageFromURL(&args.Page)
+// Resolve the `@name` mentions in the comment content (the comment should be saved after)
+func resolveCommentMentions(app *core.App, comment *entity.Comment) {
+	if notifyService, err := core.AppService[*core.NotifyService](app); err == nil {
+		notifyService.ResolveMentions(comment)
+	} else {
+		log.Error("[NotifyService] err: ", err)
 	}
+}
 
-	// AntiSpam Check
-	if !args.IsAdmin { // if the user is an admin, skip the anti-spam check
-		if antiSpamService, err := core.AppService[*core.AntiSpamService](app); err == nil {
-			// The check and block is sync, if comment is blocked, the message will not be sent
-			antiSpamService.CheckAndBlock(&core.AntiSpamCheckPayload{
-				Comment:      &comment,
-				ReqReferer:   args.Referer,
-				ReqIP:        args.IP,
-				ReqUserAgent: args.UA,
-			})
-		} else {
-			log.Error("[AntiSpamService] err: ", err)
-		}
+// Notify the users mentioned in the comment (the notified users are skipped)
+func pushCommentMentions(app *core.App, comment *entity.Comment) {
+	var parentComment entity.Comment
+	if comment.Rid != 0 {
+		parentComment = app.Dao().FindComment(comment.Rid)
 	}
 
-	// Send Notify (email, webhook, telegram, etc.)
 	if notifyService, err := core.AppService[*core.NotifyService](app); err == nil {
-		if err := notifyService.Push(&comment, &parentComment); err != nil {
-			log.Error("[NotifyService] notify push err: ", err)
-		}
+		notifyService.PushMentions(comment, &parentComment)
 	} else {
 		log.Error("[NotifyService] err: ", err)
 	}
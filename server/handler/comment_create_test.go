@@ -0,0 +1,74 @@
+package handler_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/artalkjs/artalk/v2/internal/core"
+	"github.com/artalkjs/artalk/v2/internal/entity"
+	"github.com/artalkjs/artalk/v2/internal/hook"
+	"github.com/artalkjs/artalk/v2/server/handler"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCommentCreateTrust(t *testing.T) {
+	app, fiber := NewApiTestApp()
+	defer app.Cleanup()
+
+	handler.CommentCreate(app.App, fiber)
+
+	// wait for the async jobs after the comment created
+	created := make(chan uint, 1)
+	app.OnCommentCreated().Add(func(e *core.CommentCreatedEvent) error {
+		created <- e.Comment.ID
+		return nil
+	})
+
+	// skip pushing the notifies
+	app.OnNotify().Add(func(e *core.NotifyEvent) error {
+		return hook.ErrStopPropagation
+	})
+
+	app.Conf().Moderator.PendingDefault = true
+	require.NoError(t, app.Dao().DB().Model(&entity.User{}).Where("id = ?", 1002).Update("trust_level", entity.UserTrustLevelTrusted).Error)
+
+	create := func(t *testing.T, userID uint) handler.ResponseCommentCreate {
+		body, _ := json.Marshal(map[string]any{
+			"name": "userB", "email": "user_b@qwqaq.com", "content": "new comment",
+			"page_key": "/test/1000.html", "site_name": "Site A", "rid": 0,
+		})
+		req := httptest.NewRequest("POST", "/comments", bytes.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		if userID != 0 {
+			req.Header.Set("Authorization", "Bearer "+getUserToken(t, app, userID))
+		}
+		resp, err := fiber.Test(req)
+		require.NoError(t, err)
+		respBody, _ := io.ReadAll(resp.Body)
+		require.Equal(t, 200, resp.StatusCode, string(respBody))
+
+		var result handler.ResponseCommentCreate
+		require.NoError(t, json.Unmarshal(respBody, &result))
+
+		select {
+		case <-created:
+		case <-time.After(5 * time.Second):
+			t.Fatal("the comment created hook is not triggered")
+		}
+
+		return result
+	}
+
+	t.Run("Logged in", func(t *testing.T) {
+		assert.False(t, create(t, 1002).IsPending, "the trusted user skips the default pending")
+	})
+
+	t.Run("Anonymous", func(t *testing.T) {
+		assert.True(t, create(t, 0).IsPending, "anyone can comment with the name and email of the trusted user")
+	})
+}
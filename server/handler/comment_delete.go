@@ -2,7 +2,9 @@ package handler
 
 import (
 	"github.com/artalkjs/artalk/v2/internal/core"
+	"github.com/artalkjs/artalk/v2/internal/entity"
 	"github.com/artalkjs/artalk/v2/internal/i18n"
+	"github.com/artalkjs/artalk/v2/internal/log"
 	"github.com/artalkjs/artalk/v2/server/common"
 	"github.com/gofiber/fiber/v2"
 )
@@ -20,7 +22,7 @@ import (
 // @Failure      500  {object}  Map{msg=string}
 // @Router       /comments/{id}  [delete]
 func CommentDelete(app *core.App, router fiber.Router) {
-	router.Delete("/comments/:id", common.AdminGuard(app, func(c *fiber.Ctx) error {
+	router.Delete("/comments/:id", common.SiteRoleGuard(app, func(c *fiber.Ctx) error {
 		id, _ := c.ParamsInt("id")
 
 		// find comment
@@ -29,14 +31,21 @@ func CommentDelete(app *core.App, router fiber.Router) {
 			return common.RespError(c, 404, i18n.T("{{name}} not found", Map{"name": i18n.T("Comment")}))
 		}
 
-		// 删除主评论
+		// the site moderators are allowed to delete comments
+		if !common.CheckSiteRoleReq(app, c, comment.SiteName, entity.SiteRoleModerator) {
+			return common.RespSiteRoleRequired(c)
+		}
+
+		// 删除评论 (子评论一并移入回收站)
 		if err := app.Dao().DelComment(&comment); err != nil {
 			return common.RespError(c, 500, i18n.T("{{name}} deletion failed", Map{"name": i18n.T("Comment")}))
 		}
 
-		// 删除子评论
-		if err := app.Dao().DelCommentChildren(comment.ID); err != nil {
-			return common.RespError(c, 500, i18n.T("{{name}} deletion failed", Map{"name": i18n.T("Sub-comment")}))
+		// the pending comment is rejected by the moderators
+		if comment.IsPending {
+			if err := app.Dao().SaveCommentModeration(&comment, entity.CommentModerationRejected); err != nil {
+				log.Error("[CommentModeration] save err: ", err)
+			}
 		}
 
 		return common.RespSuccess(c)
@@ -0,0 +1,108 @@
+package handler
+
+import (
+	"cmp"
+	"strings"
+	"time"
+
+	"github.com/artalkjs/artalk/v2/internal/core"
+	"github.com/artalkjs/artalk/v2/internal/entity"
+	"github.com/artalkjs/artalk/v2/internal/i18n"
+	"github.com/artalkjs/artalk/v2/internal/log"
+	"github.com/artalkjs/artalk/v2/server/common"
+	"github.com/gofiber/fiber/v2"
+)
+
+type ParamsCommentEdit struct {
+	Content string `json:"content" validate:"required"` // The new comment content
+}
+
+type ResponseCommentEdit struct {
+	entity.CookedComment
+}
+
+// @Id           EditComment
+// @Summary      Edit own comment
+// @Description  Edit the content of a comment posted by the current logged-in user
+// @Tags         Comment
+// @Param        id       path  int                true  "The comment ID you want to edit"
+// @Param        comment  body  ParamsCommentEdit  true  "The new comment content"
+// @Security     ApiKeyAuth
+// @Accept       json
+// @Produce      json
+// @Success      200  {object}  ResponseCommentEdit
+// @Failure      400  {object}  Map{msg=string}
+// @Failure      401  {object}  Map{msg=string}
+// @Failure      403  {object}  Map{msg=string}
+// @Failure      404  {object}  Map{msg=string}
+// @Failure      500  {object}  Map{msg=string}
+// @Router       /user/comments/{id}  [put]
+func CommentEdit(app *core.App, router fiber.Router) {
+	router.Put("/user/comments/:id", common.LoginGuard(app, func(c *fiber.Ctx, user entity.User) error {
+		var p ParamsCommentEdit
+		if isOK, resp := common.ParamsDecode(c, &p); !isOK {
+			return resp
+		}
+
+		id, _ := c.ParamsInt("id")
+
+		// Check the feature is enabled (admin is always allowed)
+		if !app.Conf().Comment.Edit.Enabled && !user.IsAdmin {
+			return common.RespError(c, 403, i18n.T("Comment editing is disabled"))
+		}
+
+		// Find comment
+		comment := app.Dao().FindComment(uint(id))
+		if comment.IsEmpty() {
+			return common.RespError(c, 404, i18n.T("{{name}} not found", Map{"name": i18n.T("Comment")}))
+		}
+
+		// Only the author can edit the comment (and the withdrawn comment cannot be edited)
+		if comment.UserID != user.ID || comment.IsTombstone {
+			return common.RespError(c, 403, i18n.T("Cannot edit this comment"))
+		}
+
+		// Check the edit time limit
+		if timeout := app.Conf().Comment.Edit.Timeout; timeout > 0 && !user.IsAdmin &&
+			time.Since(comment.CreatedAt) > time.Duration(timeout)*time.Minute {
+			return common.RespError(c, 403, i18n.T("The time limit for editing has expired"))
+		}
+
+		// Save the new content (the original content will be kept as a revision)
+		if err := app.Dao().UpdateCommentContent(&comment, strings.TrimSpace(p.Content), user.ID); err != nil {
+			return common.RespError(c, 500, i18n.T("{{name}} save failed", Map{"name": i18n.T("Comment")}))
+		}
+
+		// Resolve the mentions of the new content
+		resolveCommentMentions(app, &comment)
+		if err := app.Dao().UpdateComment(&comment); err != nil {
+			return common.RespError(c, 500, i18n.T("{{name}} save failed", Map{"name": i18n.T("Comment")}))
+		}
+
+		// AntiSpam check again for the new content
+		// (in sync, so that the response reflects the moderation result)
+		if !user.IsAdmin {
+			if antiSpamService, err := core.AppService[*core.AntiSpamService](app); err == nil {
+				antiSpamService.CheckAndBlock(&core.AntiSpamCheckPayload{
+					Comment:      &comment,
+					ReqReferer:   cmp.Or(c.Get("Referer"), c.Get("Origin")),
+					ReqIP:        c.IP(),
+					ReqUserAgent: string(c.Request().Header.UserAgent()),
+				})
+				comment = app.Dao().FindComment(comment.ID) // reload, may be blocked or modified
+			} else {
+				log.Error("[AntiSpamService] err: ", err)
+			}
+		}
+
+		// Notify the newly mentioned users
+		pushCommentMentions(app, &comment)
+
+		cookedComment := app.Dao().CookComment(&comment)
+		cookedComment = fetchIPRegionForComment(app, cookedComment)
+
+		return common.RespData(c, ResponseCommentEdit{
+			CookedComment: cookedComment,
+		})
+	}))
+}
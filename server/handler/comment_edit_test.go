@@ -0,0 +1,143 @@
+package handler_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/artalkjs/artalk/v2/internal/entity"
+	"github.com/artalkjs/artalk/v2/server/common"
+	"github.com/artalkjs/artalk/v2/server/handler"
+	"github.com/artalkjs/artalk/v2/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func getUserToken(t *testing.T, app *test.TestApp, userID uint) string {
+	token, err := common.LoginGetUserToken(app.Dao().FindUserByID(userID), app.Conf().AppKey, 3600)
+	require.NoError(t, err)
+	return token
+}
+
+func TestCommentEdit(t *testing.T) {
+	tests := []struct {
+		description  string
+		userID       uint // 0 means no token
+		commentID    string
+		enabled      bool
+		timeout      int
+		content      string
+		expectedCode int
+	}{
+		{"Edit without login", 0, "1001", true, 0, "edited", 401},
+		{"Edit when feature is disabled", 1001, "1001", false, 0, "edited", 403},
+		{"Edit other user's comment", 1002, "1001", true, 0, "edited", 403},
+		{"Edit not exists comment", 1001, "999999", true, 0, "edited", 404},
+		{"Edit with empty content", 1001, "1001", true, 0, " ", 400},
+		{"Edit after the time limit", 1001, "1001", true, 30, "edited", 403},
+		{"Edit own comment", 1001, "1001", true, 0, "edited", 200},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			app, fiber := NewApiTestApp()
+			defer app.Cleanup()
+
+			app.Conf().Comment.Edit.Enabled = tt.enabled
+			app.Conf().Comment.Edit.Timeout = tt.timeout
+
+			handler.CommentEdit(app.App, fiber)
+
+			body, _ := json.Marshal(map[string]any{"content": tt.content})
+			req := httptest.NewRequest("PUT", "/user/comments/"+tt.commentID, bytes.NewReader(body))
+			req.Header.Set("Content-Type", "application/json")
+			if tt.userID != 0 {
+				req.Header.Set("Authorization", "Bearer "+getUserToken(t, app, tt.userID))
+			}
+
+			resp, err := fiber.Test(req)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedCode, resp.StatusCode)
+
+			if tt.expectedCode != 200 {
+				return
+			}
+
+			var cooked entity.CookedComment
+			respBody, _ := io.ReadAll(resp.Body)
+			require.NoError(t, json.Unmarshal(respBody, &cooked))
+			assert.Equal(t, tt.content, cooked.Content)
+			assert.True(t, cooked.IsEdited)
+			assert.NotEmpty(t, cooked.EditedAt)
+
+			revisions := app.Dao().FindCommentRevisions(1001)
+			if assert.Len(t, revisions, 1) {
+				assert.Equal(t, "回复测试 1001", revisions[0].Content)
+				assert.Equal(t, uint(1001), revisions[0].UserID)
+			}
+		})
+	}
+}
+
+func TestCommentRevisionRestore(t *testing.T) {
+	app, fiber := NewApiTestApp()
+	defer app.Cleanup()
+
+	handler.CommentRevisionList(app.App, fiber)
+	handler.CommentRevisionRestore(app.App, fiber)
+
+	comment := app.Dao().FindComment(1001)
+	originalContent := comment.Content
+	require.NoError(t, app.Dao().UpdateCommentContent(&comment, "edited", 1001))
+	revision := app.Dao().FindCommentRevisions(1001)[0]
+
+	doRequest := func(method string, url string, userID uint) (int, []byte) {
+		req := httptest.NewRequest(method, url, nil)
+		req.Header.Set("Authorization", "Bearer "+getUserToken(t, app, userID))
+		resp, err := fiber.Test(req)
+		require.NoError(t, err)
+		body, _ := io.ReadAll(resp.Body)
+		return resp.StatusCode, body
+	}
+
+	t.Run("List revisions by non-admin", func(t *testing.T) {
+		code, _ := doRequest("GET", "/comments/1001/revisions", 1001)
+		assert.Equal(t, 403, code)
+	})
+
+	t.Run("List revisions", func(t *testing.T) {
+		code, body := doRequest("GET", "/comments/1001/revisions", 1000)
+		assert.Equal(t, 200, code)
+
+		var data handler.ResponseCommentRevisionList
+		require.NoError(t, json.Unmarshal(body, &data))
+		if assert.Len(t, data.Revisions, 1) {
+			assert.Equal(t, originalContent, data.Revisions[0].Content)
+			assert.Equal(t, "userA", data.Revisions[0].Nick)
+		}
+	})
+
+	t.Run("Restore revision of another comment", func(t *testing.T) {
+		code, _ := doRequest("POST", fmt.Sprintf("/comments/1002/revisions/%d/restore", revision.ID), 1000)
+		assert.Equal(t, 404, code)
+	})
+
+	t.Run("Restore revision", func(t *testing.T) {
+		code, body := doRequest("POST", fmt.Sprintf("/comments/1001/revisions/%d/restore", revision.ID), 1000)
+		assert.Equal(t, 200, code)
+
+		var cooked entity.CookedComment
+		require.NoError(t, json.Unmarshal(body, &cooked))
+		assert.Equal(t, originalContent, cooked.Content)
+
+		// the replaced content should be kept as a new revision
+		revisions := app.Dao().FindCommentRevisions(1001)
+		if assert.Len(t, revisions, 2) {
+			assert.Equal(t, "edited", revisions[0].Content)
+			assert.Equal(t, uint(1000), revisions[0].UserID)
+		}
+	})
+}
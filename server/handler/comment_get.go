@@ -1,6 +1,8 @@
 package handler
 
 import (
+	"slices"
+
 	"github.com/artalkjs/artalk/v2/internal/core"
 	"github.com/artalkjs/artalk/v2/internal/entity"
 	"github.com/artalkjs/artalk/v2/internal/i18n"
@@ -28,9 +30,19 @@ func CommentGet(app *core.App, router fiber.Router) {
 	router.Get("/comments/:id", func(c *fiber.Ctx) error {
 		id, _ := c.ParamsInt("id")
 
+		// The comments of the shadow-banned users are only visible to the user themselves,
+		// the admins and the moderators of the sites
+		user, _ := common.GetUserByReq(app, c)
+		roleSiteNames := app.Dao().GetUserRoleSiteNames(user.ID)
+		bannedUserIDs := app.Dao().GetShadowBannedUserIDs()
+		isVisible := func(comment *entity.Comment) bool {
+			return !slices.Contains(bannedUserIDs, comment.UserID) || comment.UserID == user.ID ||
+				user.IsAdmin || slices.Contains(roleSiteNames, comment.SiteName)
+		}
+
 		// Find comment by id
 		comment := app.Dao().FindComment(uint(id))
-		if comment.IsEmpty() {
+		if comment.IsEmpty() || !isVisible(&comment) {
 			return common.RespError(c, 404, i18n.T("{{name}} not found", Map{"name": i18n.T("Comment")}))
 		}
 
@@ -38,7 +50,7 @@ func CommentGet(app *core.App, router fiber.Router) {
 		var replyComment *entity.CookedComment
 		if comment.Rid != 0 {
 			rComment := app.Dao().FindComment(uint(comment.Rid))
-			if !comment.IsEmpty() {
+			if !rComment.IsEmpty() && isVisible(&rComment) {
 				rComment := app.Dao().CookComment(&rComment)
 				rComment.Visible = false
 				replyComment = &rComment
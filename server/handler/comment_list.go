@@ -2,6 +2,7 @@ package handler
 
 import (
 	"errors"
+	"slices"
 
 	"github.com/artalkjs/artalk/v2/internal/core"
 	"github.com/artalkjs/artalk/v2/internal/dao"
@@ -24,7 +25,9 @@ type ParamsCommentList struct {
 	SortBy        string `query:"sort_by" json:"sort_by" enums:"date_asc,date_desc,vote" validate:"optional"` // Sort by condition
 	ViewOnlyAdmin bool   `query:"view_only_admin" json:"view_only_admin" validate:"optional"`                 // Only show comments by admin
 
-	Search string `query:"search" json:"search" validate:"optional"` // Search keywords
+	Search   string `query:"search" json:"search" validate:"optional"`       // Search keywords
+	DateFrom string `query:"date_from" json:"date_from" validate:"optional"` // Only the comments created after the time (format: 2006-01-02 15:04:05)
+	DateTo   string `query:"date_to" json:"date_to" validate:"optional"`     // Only the comments created before the time (format: 2006-01-02 15:04:05)
 
 	Type  string `query:"type" json:"type" enums:"all,mentions,mine,pending" validate:"optional"` // Message center show type
 	Scope string `query:"scope" json:"scope" enums:"page,user,site" validate:"optional"`          // The scope of comments
@@ -37,6 +40,8 @@ type ResponseCommentList struct {
 	Count      int64                  `json:"count"`
 	RootsCount int64                  `json:"roots_count"`
 	Page       *entity.CookedPage     `json:"page,omitempty"`
+
+	ReactionTypes []string `json:"reaction_types,omitempty"` // The available emoji reactions of the site
 }
 
 // @Id           GetComments
@@ -64,7 +69,7 @@ func CommentList(app *core.App, router fiber.Router) {
 			user = app.Dao().FindUser(p.Name, p.Email)
 
 			// If user is admin, but not login yet, clear user
-			if user.IsAdmin {
+			if user.IsAdmin || len(app.Dao().FindUserSiteRoles(user.ID)) > 0 {
 				user = entity.User{}
 			}
 		}
@@ -82,9 +87,27 @@ func CommentList(app *core.App, router fiber.Router) {
 			}
 		}
 
+		// Apply the default sort rule and flat mode of the page settings
+		// (only when they are not specified in the request)
+		if scope == cog.ScopePage {
+			settings := app.Dao().FindPage(p.PageKey, p.SiteName).Settings
+			if p.SortBy == "" {
+				p.SortBy = settings.SortRule
+			}
+			if c.Query("flat_mode") == "" && settings.FlatMode != nil {
+				p.FlatMode = *settings.FlatMode
+			}
+		}
+
+		dateFrom, dateTo, ok, errResp := parseDateRangeParams(c, p.DateFrom, p.DateTo)
+		if !ok {
+			return errResp
+		}
+
 		// Query options
 		queryOpts := cog.QueryOptions{
-			User: user,
+			User:          user,
+			RoleSiteNames: app.Dao().GetUserRoleSiteNames(user.ID),
 
 			Scope: scope,
 
@@ -105,6 +128,9 @@ func CommentList(app *core.App, router fiber.Router) {
 
 			SortBy: cog.SortRule(p.SortBy),
 			Search: p.Search,
+
+			CreatedAfter:  dateFrom,
+			CreatedBefore: dateTo,
 		}
 
 		// Generate query by options
@@ -117,6 +143,13 @@ func CommentList(app *core.App, router fiber.Router) {
 		// Get IP region
 		comments = findIPRegionForComments(app, comments)
 
+		// Get the reactions made by the current visitor
+		comments = findMyReactionsForComments(app, c.IP(), comments)
+
+		// Get the anti-spam verdicts and mark the shadow-banned comments for the admins
+		comments = findAntiSpamVerdictsForComments(app, user, queryOpts.RoleSiteNames, comments)
+		comments = markShadowBannedComments(app, user, queryOpts.RoleSiteNames, comments)
+
 		// The response data
 		resp := ResponseCommentList{
 			Comments:   comments,
@@ -127,6 +160,7 @@ func CommentList(app *core.App, router fiber.Router) {
 		// If query scope is page, extra query page data
 		if scope == cog.ScopePage {
 			resp.Page = findPageData(app.Dao(), p.PageKey, p.SiteName)
+			resp.ReactionTypes = getReactionTypes(app, p.SiteName)
 		}
 
 		return common.RespData(c, resp)
@@ -163,3 +197,50 @@ func findIPRegionForComments(app *core.App, comments []entity.CookedComment) []e
 
 	return comments
 }
+
+// Fill the anti-spam verdicts of the comments which the user can moderate
+func findAntiSpamVerdictsForComments(app *core.App, user entity.User, roleSiteNames []string, comments []entity.CookedComment) []entity.CookedComment {
+	if !user.IsAdmin && len(roleSiteNames) == 0 {
+		return comments
+	}
+
+	ids := make([]uint, 0, len(comments))
+	for _, c := range comments {
+		if canModerateComment(user, roleSiteNames, c) {
+			ids = append(ids, c.ID)
+		}
+	}
+	if len(ids) == 0 {
+		return comments
+	}
+
+	verdicts := app.Dao().FindAntiSpamVerdicts(ids)
+	for i, c := range comments {
+		if v, ok := verdicts[c.ID]; ok && canModerateComment(user, roleSiteNames, c) {
+			cooked := app.Dao().CookAntiSpamVerdict(&v)
+			comments[i].AntiSpamVerdict = &cooked
+		}
+	}
+
+	return comments
+}
+
+// Mark the comments of the shadow-banned users which the user can moderate
+func markShadowBannedComments(app *core.App, user entity.User, roleSiteNames []string, comments []entity.CookedComment) []entity.CookedComment {
+	if !user.IsAdmin && len(roleSiteNames) == 0 {
+		return comments
+	}
+
+	bannedUserIDs := app.Dao().GetShadowBannedUserIDs()
+	for i, c := range comments {
+		if slices.Contains(bannedUserIDs, c.UserID) && canModerateComment(user, roleSiteNames, c) {
+			comments[i].IsShadowBanned = true
+		}
+	}
+
+	return comments
+}
+
+func canModerateComment(user entity.User, roleSiteNames []string, c entity.CookedComment) bool {
+	return user.IsAdmin || slices.Contains(roleSiteNames, c.SiteName)
+}
@@ -0,0 +1,92 @@
+package handler_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/artalkjs/artalk/v2/internal/entity"
+	"github.com/artalkjs/artalk/v2/server/handler"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCommentMention(t *testing.T) {
+	app, fiber := NewApiTestApp()
+	defer app.Cleanup()
+
+	app.Conf().Comment.Edit.Enabled = true
+	app.Conf().Comment.Mention.Enabled = true
+
+	handler.CommentEdit(app.App, fiber)
+
+	edit := func(t *testing.T, commentID string, content string) handler.ResponseCommentEdit {
+		body, _ := json.Marshal(map[string]any{"content": content})
+		req := httptest.NewRequest("PUT", "/user/comments/"+commentID, bytes.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("Authorization", "Bearer "+getUserToken(t, app, 1002))
+		resp, err := fiber.Test(req)
+		require.NoError(t, err)
+		require.Equal(t, 200, resp.StatusCode)
+
+		var result handler.ResponseCommentEdit
+		respBody, _ := io.ReadAll(resp.Body)
+		require.NoError(t, json.Unmarshal(respBody, &result))
+		return result
+	}
+
+	t.Run("Mention users", func(t *testing.T) {
+		result := edit(t, "1005", "Hi @userA and @Admin, @nobody @userB `@userA`")
+
+		// the author self and the users not found are ignored
+		assert.Equal(t, []uint{1001, 1000}, app.Dao().FindComment(1005).MentionUserIDs)
+		assert.Contains(t, result.ContentMarked, `<a class="atk-mention" data-user-id="1001" href="https://example.org/?user=A" target="_blank" rel="noreferrer noopener nofollow">@userA</a>`)
+		assert.Contains(t, result.ContentMarked, `@nobody`)
+		assert.Contains(t, result.ContentMarked, `<code>@userA</code>`)
+
+		for _, userID := range []uint{1000, 1001} {
+			notify := app.Dao().FindNotify(userID, 1005)
+			assert.True(t, notify.IsMention, "user %d should be notified", userID)
+			assert.False(t, notify.IsRead)
+		}
+		assert.True(t, app.Dao().FindNotify(1002, 1005).IsEmpty())
+
+		assert.Contains(t, app.Dao().GetUserMentionedCommentIDs(1001), uint(1005))
+	})
+
+	t.Run("Only the users commented on the same site", func(t *testing.T) {
+		edit(t, "1007", "@admin @userA")
+		assert.Equal(t, []uint{1001}, app.Dao().FindComment(1007).MentionUserIDs)
+	})
+
+	t.Run("Max per comment", func(t *testing.T) {
+		app.Conf().Comment.Mention.MaxPerComment = 1
+		defer func() { app.Conf().Comment.Mention.MaxPerComment = 0 }()
+
+		edit(t, "1005", "@admin @userA")
+		assert.Equal(t, []uint{1000}, app.Dao().FindComment(1005).MentionUserIDs)
+	})
+
+	t.Run("Max per day", func(t *testing.T) {
+		// the user has mentioned someone today
+		require.NoError(t, app.Dao().CreateComment(&entity.Comment{
+			Content: "@userA", PageKey: "/test/1000.html", SiteName: "Site A", UserID: 1002, MentionUserIDs: []uint{1001},
+		}))
+
+		app.Conf().Comment.Mention.MaxPerDay = 2
+		defer func() { app.Conf().Comment.Mention.MaxPerDay = 0 }()
+
+		edit(t, "1005", "@admin @userA")
+		assert.Equal(t, []uint{1000}, app.Dao().FindComment(1005).MentionUserIDs)
+	})
+
+	t.Run("Disabled", func(t *testing.T) {
+		app.Conf().Comment.Mention.Enabled = false
+
+		result := edit(t, "1005", "@admin @userA")
+		assert.Empty(t, app.Dao().FindComment(1005).MentionUserIDs)
+		assert.NotContains(t, result.ContentMarked, "atk-mention")
+	})
+}
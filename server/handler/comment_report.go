@@ -0,0 +1,266 @@
+package handler
+
+import (
+	"cmp"
+	"slices"
+	"strings"
+
+	"github.com/artalkjs/artalk/v2/internal/config"
+	"github.com/artalkjs/artalk/v2/internal/core"
+	"github.com/artalkjs/artalk/v2/internal/entity"
+	"github.com/artalkjs/artalk/v2/internal/i18n"
+	"github.com/artalkjs/artalk/v2/internal/log"
+	"github.com/artalkjs/artalk/v2/internal/utils"
+	"github.com/artalkjs/artalk/v2/server/common"
+	"github.com/gofiber/fiber/v2"
+	"github.com/samber/lo"
+)
+
+type ParamsCommentReport struct {
+	Reason string `json:"reason" validate:"required"` // The reason for reporting
+}
+
+// @Id           ReportComment
+// @Summary      Report Comment
+// @Description  Report an abusive comment to the moderators, each comment can be reported once by the same user or IP
+// @Tags         Report
+// @Param        id      path  int                  true  "The comment ID"
+// @Param        report  body  ParamsCommentReport  true  "The report data"
+// @Security     ApiKeyAuth
+// @Accept       json
+// @Produce      json
+// @Success      200  {object}  Map{}
+// @Failure      400  {object}  Map{msg=string}
+// @Failure      403  {object}  Map{msg=string}
+// @Failure      404  {object}  Map{msg=string}
+// @Failure      500  {object}  Map{msg=string}
+// @Router       /comments/{id}/report  [post]
+func CommentReport(app *core.App, router fiber.Router) {
+	router.Post("/comments/:id/report", common.LimiterGuard(app, func(c *fiber.Ctx) error {
+		id, _ := c.ParamsInt("id")
+
+		var p ParamsCommentReport
+		if isOK, resp := common.ParamsDecode(c, &p); !isOK {
+			return resp
+		}
+
+		if ok, resp := common.CheckBlocklistReq(app, c, ""); !ok {
+			return resp
+		}
+
+		// the pending comments are invisible to the readers
+		comment := app.Dao().FindComment(uint(id))
+		if comment.IsEmpty() || comment.IsPending {
+			return common.RespError(c, 404, i18n.T("{{name}} not found", Map{"name": i18n.T("Comment")}))
+		}
+
+		conf := app.SiteConf(comment.SiteName).Comment.Report
+		if !conf.Enabled {
+			return common.RespError(c, 403, i18n.T("Comment reporting is disabled"))
+		}
+
+		reason := strings.TrimSpace(p.Reason)
+		if reason == "" {
+			return common.RespError(c, 400, i18n.T("Invalid {{name}}", Map{"name": i18n.T("Report reason")}))
+		}
+
+		user, _ := common.GetUserByReq(app, c) // the reporter can be anonymous
+		if !user.IsEmpty() && user.ID == comment.UserID {
+			return common.RespError(c, 400, i18n.T("Cannot report your own comment"))
+		}
+		if app.Dao().HasReportedComment(comment.ID, user.ID, c.IP()) {
+			return common.RespError(c, 400, i18n.T("You have already reported this comment"))
+		}
+
+		report := entity.CommentReport{
+			CommentID: comment.ID,
+			UserID:    user.ID,
+			IP:        c.IP(),
+			UA:        string(c.Request().Header.UserAgent()),
+			Reason:    utils.TruncateString(reason, 1000),
+			Status:    entity.CommentReportStatusOpen,
+		}
+		if err := app.Dao().DB().Create(&report).Error; err != nil {
+			return common.RespError(c, 500, i18n.T("{{name}} save failed", Map{"name": i18n.T("Report")}))
+		}
+
+		// Take action automatically when the threshold is reached
+		// (the comments of the site managers are never affected)
+		reportCount := app.Dao().CountOpenCommentReports(comment.ID)
+		var action config.CommentReportAction
+		if conf.Threshold > 0 && reportCount >= int64(conf.Threshold) &&
+			app.Dao().GetUserSiteRole(lo.ToPtr(app.Dao().FetchUserForComment(&comment)), comment.SiteName) == "" {
+			switch cmp.Or(conf.Action, config.CommentReportActionCollapse) {
+			case config.CommentReportActionCollapse:
+				if !comment.IsCollapsed {
+					comment.IsCollapsed = true
+					action = config.CommentReportActionCollapse
+				}
+			case config.CommentReportActionPending:
+				comment.IsPending = true
+				action = config.CommentReportActionPending
+			}
+
+			if action != "" {
+				if err := app.Dao().UpdateComment(&comment); err != nil {
+					return common.RespError(c, 500, i18n.T("{{name}} save failed", Map{"name": i18n.T("Comment")}))
+				}
+			}
+		}
+
+		// Notify the admins
+		if notifyService, err := core.AppService[*core.NotifyService](app); err == nil {
+			notifyService.PushReport(&comment, &report, reportCount, action)
+		} else {
+			log.Error("[NotifyService] err: ", err)
+		}
+
+		return common.RespSuccess(c)
+	}))
+}
+
+type ParamsReportList struct {
+	Status   entity.CommentReportStatus `query:"status" json:"status" enums:"open,dismissed,resolved" validate:"optional"` // Filter by status (default: open)
+	SiteName string                     `query:"site_name" json:"site_name" validate:"optional"`                           // Filter by site name
+	Limit    int                        `query:"limit" json:"limit" validate:"optional"`                                   // The limit for pagination
+	Offset   int                        `query:"offset" json:"offset" validate:"optional"`                                 // The offset for pagination
+}
+
+type ReportQueueItem struct {
+	Comment entity.CookedComment         `json:"comment"`
+	Reports []entity.CookedCommentReport `json:"reports"` // The reports of the comment (latest first)
+}
+
+type ResponseReportList struct {
+	Items []ReportQueueItem `json:"items"`
+	Count int64             `json:"count"` // The number of the reported comments
+}
+
+// @Id           GetReports
+// @Summary      Get Report Queue
+// @Description  Get the reported comments with their reports (the latest reported first), only the sites which the user manages are included
+// @Tags         Report
+// @Param        options  query  ParamsReportList  true  "The options"
+// @Security     ApiKeyAuth
+// @Produce      json
+// @Success      200  {object}  ResponseReportList
+// @Failure      400  {object}  Map{msg=string}
+// @Failure      403  {object}  Map{msg=string}
+// @Router       /reports  [get]
+func ReportList(app *core.App, router fiber.Router) {
+	router.Get("/reports", common.SiteRoleGuard(app, func(c *fiber.Ctx) error {
+		var p ParamsReportList
+		if isOK, resp := common.ParamsDecode(c, &p); !isOK {
+			return resp
+		}
+
+		status := cmp.Or(p.Status, entity.CommentReportStatusOpen)
+		if !status.IsValid() {
+			return common.RespError(c, 400, i18n.T("Invalid {{name}}", Map{"name": "status"}))
+		}
+
+		// the sites which the user manages (nil for all the sites)
+		user, _ := common.GetUserByReq(app, c)
+		var siteNames []string
+		if !user.IsAdmin {
+			siteNames = app.Dao().GetUserRoleSiteNames(user.ID)
+		}
+		if p.SiteName != "" {
+			if siteNames != nil && !slices.Contains(siteNames, p.SiteName) {
+				return common.RespSiteRoleRequired(c)
+			}
+			siteNames = []string{p.SiteName}
+		}
+
+		commentIDs, total := app.Dao().FindReportedCommentIDs(status, siteNames, Paginate(p.Offset, p.Limit))
+		reports := app.Dao().FindCommentReports(commentIDs, status)
+
+		items := []ReportQueueItem{}
+		for _, id := range commentIDs {
+			comment := app.Dao().FindComment(id)
+			cookedReports := []entity.CookedCommentReport{}
+			for _, r := range reports[id] {
+				cookedReports = append(cookedReports, app.Dao().CookCommentReport(&r))
+			}
+			items = append(items, ReportQueueItem{
+				Comment: app.Dao().CookComment(&comment),
+				Reports: cookedReports,
+			})
+		}
+
+		return common.RespData(c, ResponseReportList{
+			Items: items,
+			Count: total,
+		})
+	}))
+}
+
+type ParamsReportResolve struct {
+	Action string `json:"action" enums:"dismiss,collapse,pending,delete" validate:"required"` // Dismiss the reports (the comment is kept as is), or take action on the comment
+}
+
+// @Id           ResolveReports
+// @Summary      Resolve Comment Reports
+// @Description  Close the open reports of the comment by dismissing them or taking action on the comment
+// @Tags         Report
+// @Param        id       path  int                  true  "The comment ID"
+// @Param        options  body  ParamsReportResolve  true  "The options"
+// @Security     ApiKeyAuth
+// @Accept       json
+// @Produce      json
+// @Success      200  {object}  Map{}
+// @Failure      400  {object}  Map{msg=string}
+// @Failure      403  {object}  Map{msg=string}
+// @Failure      404  {object}  Map{msg=string}
+// @Failure      500  {object}  Map{msg=string}
+// @Router       /comments/{id}/reports/resolve  [post]
+func ReportResolve(app *core.App, router fiber.Router) {
+	router.Post("/comments/:id/reports/resolve", common.SiteRoleGuard(app, func(c *fiber.Ctx) error {
+		id, _ := c.ParamsInt("id")
+
+		var p ParamsReportResolve
+		if isOK, resp := common.ParamsDecode(c, &p); !isOK {
+			return resp
+		}
+
+		comment := app.Dao().FindComment(uint(id))
+		if comment.IsEmpty() {
+			return common.RespError(c, 404, i18n.T("{{name}} not found", Map{"name": i18n.T("Comment")}))
+		}
+
+		// the site moderators are allowed to collapse, set pending and delete comments
+		if !common.CheckSiteRoleReq(app, c, comment.SiteName, entity.SiteRoleModerator) {
+			return common.RespSiteRoleRequired(c)
+		}
+
+		status := entity.CommentReportStatusResolved
+		switch p.Action {
+		case "dismiss":
+			status = entity.CommentReportStatusDismissed
+		case "collapse":
+			comment.IsCollapsed = true
+		case "pending":
+			comment.IsPending = true
+		case "delete":
+		default:
+			return common.RespError(c, 400, i18n.T("Invalid {{name}}", Map{"name": "action"}))
+		}
+
+		if err := app.Dao().CloseCommentReports(comment.ID, status); err != nil {
+			return common.RespError(c, 500, i18n.T("{{name}} save failed", Map{"name": i18n.T("Report")}))
+		}
+
+		switch p.Action {
+		case "collapse", "pending":
+			if err := app.Dao().UpdateComment(&comment); err != nil {
+				return common.RespError(c, 500, i18n.T("{{name}} save failed", Map{"name": i18n.T("Comment")}))
+			}
+		case "delete":
+			if err := app.Dao().DelComment(&comment); err != nil {
+				return common.RespError(c, 500, i18n.T("{{name}} deletion failed", Map{"name": i18n.T("Comment")}))
+			}
+		}
+
+		return common.RespSuccess(c)
+	}))
+}
@@ -0,0 +1,156 @@
+package handler_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/artalkjs/artalk/v2/internal/config"
+	"github.com/artalkjs/artalk/v2/internal/entity"
+	"github.com/artalkjs/artalk/v2/server/handler"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCommentReport(t *testing.T) {
+	app, fiber := NewApiTestApp()
+	defer app.Cleanup()
+
+	handler.CommentReport(app.App, fiber)
+	handler.ReportList(app.App, fiber)
+	handler.ReportResolve(app.App, fiber)
+
+	app.Conf().Comment.Report = config.CommentReportConf{Enabled: true, Threshold: 2, Action: config.CommentReportActionCollapse}
+
+	request := func(method, target string, userID uint, ip string, data map[string]any) (int, []byte) {
+		body, _ := json.Marshal(data)
+		req := httptest.NewRequest(method, target, bytes.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("X-Forwarded-For", ip)
+		if userID != 0 {
+			req.Header.Set("Authorization", "Bearer "+getUserToken(t, app, userID))
+		}
+		resp, err := fiber.Test(req)
+		require.NoError(t, err)
+		respBody, _ := io.ReadAll(resp.Body)
+		return resp.StatusCode, respBody
+	}
+
+	report := func(commentID uint, userID uint, ip string) (int, []byte) {
+		return request("POST", fmt.Sprintf("/comments/%d/report", commentID), userID, ip, map[string]any{"reason": "abusive"})
+	}
+
+	listReports := func(t *testing.T, userID uint, query string) handler.ResponseReportList {
+		code, body := request("GET", "/reports?"+query, userID, "10.0.0.1", nil)
+		require.Equal(t, 200, code, string(body))
+		var result handler.ResponseReportList
+		require.NoError(t, json.Unmarshal(body, &result))
+		return result
+	}
+
+	resolve := func(commentID uint, userID uint, action string) (int, []byte) {
+		return request("POST", fmt.Sprintf("/comments/%d/reports/resolve", commentID), userID, "10.0.0.1", map[string]any{"action": action})
+	}
+
+	t.Run("Disabled", func(t *testing.T) {
+		app.Conf().Comment.Report.Enabled = false
+		defer func() { app.Conf().Comment.Report.Enabled = true }()
+
+		code, _ := report(1004, 0, "1.1.1.1")
+		assert.Equal(t, 403, code)
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		code, _ := request("POST", "/comments/1004/report", 0, "1.1.1.1", map[string]any{"reason": "  "})
+		assert.Equal(t, 400, code, "empty reason")
+
+		code, _ = report(1007, 0, "1.1.1.1")
+		assert.Equal(t, 404, code, "pending comment")
+
+		code, _ = report(1004, 1001, "1.1.1.1")
+		assert.Equal(t, 400, code, "own comment")
+	})
+
+	t.Run("Report and collapse automatically", func(t *testing.T) {
+		code, body := report(1004, 0, "1.1.1.1")
+		require.Equal(t, 200, code, string(body))
+
+		code, _ = report(1004, 0, "1.1.1.1")
+		assert.Equal(t, 400, code, "deduplicated by IP")
+		assert.False(t, app.Dao().FindComment(1004).IsCollapsed, "below the threshold")
+
+		code, body = report(1004, 1002, "1.1.1.2")
+		require.Equal(t, 200, code, string(body))
+		assert.True(t, app.Dao().FindComment(1004).IsCollapsed)
+
+		code, _ = report(1004, 1002, "1.1.1.3")
+		assert.Equal(t, 400, code, "deduplicated by user")
+	})
+
+	t.Run("Comments of admins are not affected", func(t *testing.T) {
+		for _, ip := range []string{"2.2.2.1", "2.2.2.2"} {
+			code, body := report(1000, 0, ip)
+			require.Equal(t, 200, code, string(body))
+		}
+		assert.False(t, app.Dao().FindComment(1000).IsCollapsed)
+	})
+
+	t.Run("Queue", func(t *testing.T) {
+		result := listReports(t, 1000, "")
+		assert.Equal(t, int64(2), result.Count)
+		require.Len(t, result.Items, 2)
+		assert.Equal(t, uint(1000), result.Items[0].Comment.ID, "the latest reported first")
+		assert.Equal(t, uint(1004), result.Items[1].Comment.ID)
+		assert.Equal(t, []string{"userB", ""}, lo.Map(result.Items[1].Reports, func(r entity.CookedCommentReport, _ int) string { return r.UserName }))
+
+		code, _ := request("GET", "/reports", 0, "10.0.0.1", nil)
+		assert.Equal(t, 403, code, "visitor")
+
+		require.NoError(t, app.Dao().SetUserSiteRoles(1001, map[string]entity.SiteRole{"Site B": entity.SiteRoleModerator}))
+		assert.Empty(t, listReports(t, 1001, "").Items, "only the reports of the managed sites")
+		code, _ = request("GET", "/reports?site_name=Site+A", 1001, "10.0.0.1", nil)
+		assert.Equal(t, 403, code)
+	})
+
+	t.Run("Dismiss", func(t *testing.T) {
+		code, _ := resolve(1004, 1001, "dismiss")
+		assert.Equal(t, 403, code, "not the moderator of the site")
+
+		code, _ = resolve(1004, 1000, "ban")
+		assert.Equal(t, 400, code)
+
+		code, body := resolve(1004, 1000, "dismiss")
+		require.Equal(t, 200, code, string(body))
+
+		open := listReports(t, 1000, "")
+		assert.Equal(t, []uint{1000}, lo.Map(open.Items, func(item handler.ReportQueueItem, _ int) uint { return item.Comment.ID }))
+
+		dismissed := listReports(t, 1000, "status=dismissed")
+		require.Len(t, dismissed.Items, 1)
+		assert.Equal(t, uint(1004), dismissed.Items[0].Comment.ID)
+		assert.Len(t, dismissed.Items[0].Reports, 2)
+	})
+
+	t.Run("Set pending automatically", func(t *testing.T) {
+		app.Conf().Comment.Report = config.CommentReportConf{Enabled: true, Threshold: 1, Action: config.CommentReportActionPending}
+
+		code, body := report(1005, 0, "3.3.3.3")
+		require.Equal(t, 200, code, string(body))
+		assert.True(t, app.Dao().FindComment(1005).IsPending)
+	})
+
+	t.Run("Delete", func(t *testing.T) {
+		code, body := resolve(1005, 1000, "delete")
+		require.Equal(t, 200, code, string(body))
+
+		assert.True(t, app.Dao().FindComment(1005).IsEmpty())
+		assert.Empty(t, listReports(t, 1000, "status=resolved").Items, "the comments in the trash are excluded")
+
+		require.NoError(t, app.Dao().RestoreComment(lo.ToPtr(app.Dao().FindTrashedComment(1005))))
+		assert.Len(t, listReports(t, 1000, "status=resolved").Items, 1)
+	})
+}
@@ -0,0 +1,93 @@
+package handler
+
+import (
+	"github.com/artalkjs/artalk/v2/internal/core"
+	"github.com/artalkjs/artalk/v2/internal/entity"
+	"github.com/artalkjs/artalk/v2/internal/i18n"
+	"github.com/artalkjs/artalk/v2/server/common"
+	"github.com/gofiber/fiber/v2"
+)
+
+type ResponseCommentRevisionList struct {
+	Revisions []entity.CookedCommentRevision `json:"revisions"`
+}
+
+// @Id           GetCommentRevisions
+// @Summary      Get Comment Revisions
+// @Description  Get the edit history of a comment (latest first)
+// @Tags         Comment
+// @Param        id  path  int  true  "The comment ID"
+// @Security     ApiKeyAuth
+// @Produce      json
+// @Success      200  {object}  ResponseCommentRevisionList
+// @Failure      403  {object}  Map{msg=string}
+// @Failure      404  {object}  Map{msg=string}
+// @Router       /comments/{id}/revisions  [get]
+func CommentRevisionList(app *core.App, router fiber.Router) {
+	router.Get("/comments/:id/revisions", common.SiteRoleGuard(app, func(c *fiber.Ctx) error {
+		id, _ := c.ParamsInt("id")
+
+		comment := app.Dao().FindComment(uint(id))
+		if comment.IsEmpty() {
+			return common.RespError(c, 404, i18n.T("{{name}} not found", Map{"name": i18n.T("Comment")}))
+		}
+		if !common.CheckSiteRoleReq(app, c, comment.SiteName, entity.SiteRoleAdmin) {
+			return common.RespSiteRoleRequired(c)
+		}
+
+		revisions := app.Dao().FindCommentRevisions(comment.ID)
+
+		return common.RespData(c, ResponseCommentRevisionList{
+			Revisions: app.Dao().CookAllCommentRevisions(revisions),
+		})
+	}))
+}
+
+type ResponseCommentRevisionRestore struct {
+	entity.CookedComment
+}
+
+// @Id           RestoreCommentRevision
+// @Summary      Restore Comment Revision
+// @Description  Restore the content of a comment to a specific revision (the current content will be kept as a new revision)
+// @Tags         Comment
+// @Param        id           path  int  true  "The comment ID"
+// @Param        revision_id  path  int  true  "The revision ID to restore"
+// @Security     ApiKeyAuth
+// @Produce      json
+// @Success      200  {object}  ResponseCommentRevisionRestore
+// @Failure      403  {object}  Map{msg=string}
+// @Failure      404  {object}  Map{msg=string}
+// @Failure      500  {object}  Map{msg=string}
+// @Router       /comments/{id}/revisions/{revision_id}/restore  [post]
+func CommentRevisionRestore(app *core.App, router fiber.Router) {
+	router.Post("/comments/:id/revisions/:revision_id/restore", common.SiteRoleGuard(app, func(c *fiber.Ctx) error {
+		id, _ := c.ParamsInt("id")
+		revisionID, _ := c.ParamsInt("revision_id")
+
+		comment := app.Dao().FindComment(uint(id))
+		if comment.IsEmpty() {
+			return common.RespError(c, 404, i18n.T("{{name}} not found", Map{"name": i18n.T("Comment")}))
+		}
+		if !common.CheckSiteRoleReq(app, c, comment.SiteName, entity.SiteRoleAdmin) {
+			return common.RespSiteRoleRequired(c)
+		}
+
+		revision := app.Dao().FindCommentRevision(uint(revisionID))
+		if revision.IsEmpty() || revision.CommentID != comment.ID {
+			return common.RespError(c, 404, i18n.T("{{name}} not found", Map{"name": i18n.T("Revision")}))
+		}
+
+		admin, _ := common.GetUserByReq(app, c)
+		if err := app.Dao().UpdateCommentContent(&comment, revision.Content, admin.ID); err != nil {
+			return common.RespError(c, 500, i18n.T("{{name}} save failed", Map{"name": i18n.T("Comment")}))
+		}
+
+		cookedComment := app.Dao().CookComment(&comment)
+		cookedComment = fetchIPRegionForComment(app, cookedComment)
+
+		return common.RespData(c, ResponseCommentRevisionRestore{
+			CookedComment: cookedComment,
+		})
+	}))
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/artalkjs/artalk/v2/internal/utils"
 	"github.com/artalkjs/artalk/v2/server/common"
 	"github.com/gofiber/fiber/v2"
+	"github.com/samber/lo"
 )
 
 type ParamsCommentUpdate struct {
@@ -48,7 +49,7 @@ type ResponseCommentUpdate struct {
 // @Failure      500  {object}  Map{msg=string}
 // @Router       /comments/{id} [put]
 func CommentUpdate(app *core.App, router fiber.Router) {
-	router.Put("/comments/:id", common.AdminGuard(app, func(c *fiber.Ctx) error {
+	router.Put("/comments/:id", common.SiteRoleGuard(app, func(c *fiber.Ctx) error {
 		var p ParamsCommentUpdate
 		if isOK, resp := common.ParamsDecode(c, &p); !isOK {
 			return resp
@@ -67,6 +68,17 @@ func CommentUpdate(app *core.App, router fiber.Router) {
 			return common.RespError(c, 404, i18n.T("{{name}} not found", Map{"name": i18n.T("Comment")}))
 		}
 
+		// check the role on the sites (the comment may be moved to another site)
+		if !common.CheckSiteRoleReq(app, c, comment.SiteName, entity.SiteRoleModerator) ||
+			!common.CheckSiteRoleReq(app, c, p.SiteName, entity.SiteRoleModerator) {
+			return common.RespSiteRoleRequired(c)
+		}
+
+		// the site moderators are only allowed to approve and collapse comments
+		if !common.CheckSiteRoleReq(app, c, comment.SiteName, entity.SiteRoleAdmin) && isCommentUpdateBeyondModeration(app, &comment, &p) {
+			return common.RespError(c, 403, i18n.T("Site moderators can only approve or collapse comments"))
+		}
+
 		// check params
 		if p.Email != "" && !utils.ValidateEmail(p.Email) {
 			return common.RespError(c, 400, i18n.T("Invalid {{name}}", Map{"name": i18n.T("Email")}))
@@ -75,9 +87,13 @@ func CommentUpdate(app *core.App, router fiber.Router) {
 			return common.RespError(c, 400, i18n.T("Invalid {{name}}", Map{"name": i18n.T("Link")}))
 		}
 
-		// content
-		if p.Content != "" {
-			comment.Content = p.Content
+		// content (the original content will be kept as a revision)
+		if p.Content != "" && p.Content != comment.Content {
+			admin, _ := common.GetUserByReq(app, c)
+			if err := app.Dao().UpdateCommentContent(&comment, p.Content, admin.ID); err != nil {
+				return common.RespError(c, 500, i18n.T("{{name}} save failed", Map{"name": i18n.T("Comment")}))
+			}
+			resolveCommentMentions(app, &comment)
 		}
 
 		// merge user
@@ -117,7 +133,8 @@ func CommentUpdate(app *core.App, router fiber.Router) {
 		comment.IsCollapsed = p.IsCollapsed
 		comment.IsPinned = p.IsPinned
 
-		if p.IsPending != comment.IsPending {
+		isModerated := p.IsPending != comment.IsPending
+		if isModerated {
 			// 待审状态发生改变
 			comment.IsPending = p.IsPending
 
@@ -134,6 +151,13 @@ func CommentUpdate(app *core.App, router fiber.Router) {
 			return common.RespError(c, 500, i18n.T("{{name}} save failed", Map{"name": i18n.T("Comment")}))
 		}
 
+		// Learn from the moderation decision, etc.
+		triggerCommentUpdated(app, &comment, isModerated)
+
+		// Notify the mentioned users
+		// (the users mentioned in the new content, or all of them when the comment is approved)
+		pushCommentMentions(app, &comment)
+
 		cookedComment := app.Dao().CookComment(&comment)
 		cookedComment = fetchIPRegionForComment(app, cookedComment)
 
@@ -143,6 +167,46 @@ func CommentUpdate(app *core.App, router fiber.Router) {
 	}))
 }
 
+// Check the params change the comment other than the pending and collapsed status
+func isCommentUpdateBeyondModeration(app *core.App, comment *entity.Comment, p *ParamsCommentUpdate) bool {
+	user := app.Dao().FetchUserForComment(comment)
+	changed := func(val, original string) bool {
+		return val != "" && val != original
+	}
+
+	return changed(p.Content, comment.Content) || changed(p.PageKey, comment.PageKey) || p.SiteName != comment.SiteName ||
+		changed(p.Nick, user.Name) || changed(p.Email, user.Email) || changed(p.Link, user.Link) ||
+		changed(p.UA, comment.UA) || changed(p.IP, comment.IP) ||
+		p.IsPinned != comment.IsPinned
+}
+
+// Trigger the hooks after the comment is updated by the moderators
+//
+// The moderation decision is recorded if the pending status is changed (set to pending means rejected),
+// and the `OnCommentApproved` hook is triggered as well if the comment is approved.
+func triggerCommentUpdated(app *core.App, comment *entity.Comment, isModerated bool) {
+	if isModerated {
+		decision := lo.Ternary(comment.IsPending, entity.CommentModerationRejected, entity.CommentModerationApproved)
+		if err := app.Dao().SaveCommentModeration(comment, decision); err != nil {
+			log.Error("[CommentModeration] save err: ", err)
+		}
+	}
+
+	if err := app.OnCommentUpdated().Trigger(&core.CommentUpdatedEvent{
+		App:         app,
+		Comment:     comment,
+		IsModerated: isModerated,
+	}); err != nil {
+		log.Error("[CommentUpdated] hook err: ", err)
+	}
+
+	if isModerated && !comment.IsPending {
+		if err := app.OnCommentApproved().Trigger(&core.CommentApprovedEvent{App: app, Comment: comment}); err != nil {
+			log.Error("[CommentApproved] hook err: ", err)
+		}
+	}
+}
+
 func renotifyWhenPendingModified(app *core.App, comment *entity.Comment) (err error) {
 	if comment.Rid == 0 {
 		return // Root 评论不发送通知，因为这个评论已经被管理员看到了
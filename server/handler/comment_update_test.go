@@ -0,0 +1,70 @@
+package handler_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/artalkjs/artalk/v2/internal/core"
+	"github.com/artalkjs/artalk/v2/internal/dao"
+	"github.com/artalkjs/artalk/v2/server/handler"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCommentUpdateTrainBayes(t *testing.T) {
+	app, fiber := NewApiTestApp()
+	defer app.Cleanup()
+
+	app.Conf().Moderator.Bayes.Enabled = true
+
+	handler.CommentUpdate(app.App, fiber)
+
+	setPending := func(t *testing.T, isPending bool) {
+		comment := app.Dao().FindComment(1005)
+		body, _ := json.Marshal(map[string]any{
+			"site_name": comment.SiteName, "content": comment.Content, "page_key": comment.PageKey, "rid": comment.Rid,
+			"is_collapsed": false, "is_pending": isPending, "is_pinned": false,
+		})
+		req := httptest.NewRequest("PUT", "/comments/1005", bytes.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("Authorization", "Bearer "+getUserToken(t, app, 1000))
+		resp, err := fiber.Test(req)
+		require.NoError(t, err)
+		require.Equal(t, 200, resp.StatusCode)
+	}
+
+	t.Run("Mark as spam", func(t *testing.T) {
+		setPending(t, true)
+
+		doc := app.Dao().FindBayesDocument(1005)
+		require.False(t, doc.IsEmpty())
+		assert.True(t, doc.IsSpam)
+		assert.NotEmpty(t, doc.Tokens)
+
+		assert.Equal(t, dao.UserCommentStats{Rejected: 1}, app.Dao().GetUserCommentStats(1002), "the moderation decision is recorded")
+	})
+
+	t.Run("Approve", func(t *testing.T) {
+		setPending(t, false)
+		assert.False(t, app.Dao().FindBayesDocument(1005).IsSpam)
+		assert.Equal(t, dao.UserCommentStats{Approved: 1}, app.Dao().GetUserCommentStats(1002), "the decision is replaced")
+	})
+
+	t.Run("Train from history", func(t *testing.T) {
+		antiSpamService, err := core.AppService[*core.AntiSpamService](app.App)
+		require.NoError(t, err)
+
+		spam, ham, err := antiSpamService.TrainFromHistory(false)
+		require.NoError(t, err)
+		assert.Equal(t, 1, spam, "the pending comment 1007")
+		assert.Greater(t, ham, 0)
+		assert.True(t, app.Dao().FindBayesDocument(1007).IsSpam)
+
+		// the trained comments are skipped
+		spam, ham, err = antiSpamService.TrainFromHistory(false)
+		require.NoError(t, err)
+		assert.Zero(t, spam+ham)
+	})
+}
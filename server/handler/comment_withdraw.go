@@ -0,0 +1,57 @@
+package handler
+
+import (
+	"github.com/artalkjs/artalk/v2/internal/core"
+	"github.com/artalkjs/artalk/v2/internal/entity"
+	"github.com/artalkjs/artalk/v2/internal/i18n"
+	"github.com/artalkjs/artalk/v2/server/common"
+	"github.com/gofiber/fiber/v2"
+)
+
+type ResponseCommentWithdraw struct {
+	IsTombstone bool `json:"is_tombstone"` // The comment has replies, so it is replaced by a tombstone instead of being deleted
+}
+
+// @Id           WithdrawComment
+// @Summary      Delete own comment
+// @Description  Delete a comment posted by the current logged-in user (the comment with replies will be replaced by a tombstone)
+// @Tags         Comment
+// @Param        id  path  int  true  "The comment ID you want to delete"
+// @Security     ApiKeyAuth
+// @Produce      json
+// @Success      200  {object}  ResponseCommentWithdraw
+// @Failure      401  {object}  Map{msg=string}
+// @Failure      403  {object}  Map{msg=string}
+// @Failure      404  {object}  Map{msg=string}
+// @Failure      500  {object}  Map{msg=string}
+// @Router       /user/comments/{id}  [delete]
+func CommentWithdraw(app *core.App, router fiber.Router) {
+	router.Delete("/user/comments/:id", common.LoginGuard(app, func(c *fiber.Ctx, user entity.User) error {
+		id, _ := c.ParamsInt("id")
+
+		// Check the feature is enabled (admin is always allowed)
+		if !app.Conf().Comment.Delete.Enabled && !user.IsAdmin {
+			return common.RespError(c, 403, i18n.T("Comment deletion is disabled"))
+		}
+
+		// Find comment
+		comment := app.Dao().FindComment(uint(id))
+		if comment.IsEmpty() || comment.IsTombstone {
+			return common.RespError(c, 404, i18n.T("{{name}} not found", Map{"name": i18n.T("Comment")}))
+		}
+
+		// Only the author can delete the comment
+		if comment.UserID != user.ID {
+			return common.RespError(c, 403, i18n.T("Cannot delete this comment"))
+		}
+
+		isTombstone, err := app.Dao().WithdrawComment(&comment)
+		if err != nil {
+			return common.RespError(c, 500, i18n.T("{{name}} deletion failed", Map{"name": i18n.T("Comment")}))
+		}
+
+		return common.RespData(c, ResponseCommentWithdraw{
+			IsTombstone: isTombstone,
+		})
+	}))
+}
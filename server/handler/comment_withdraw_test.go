@@ -0,0 +1,54 @@
+package handler_test
+
+import (
+	"io"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/artalkjs/artalk/v2/server/handler"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCommentWithdraw(t *testing.T) {
+	tests := []struct {
+		description  string
+		userID       uint // 0 means no token
+		commentID    string
+		enabled      bool
+		expectedCode int
+		expectedBody string
+	}{
+		{"Delete without login", 0, "1003", true, 401, ""},
+		{"Delete when feature is disabled", 1001, "1003", false, 403, ""},
+		{"Delete other user's comment", 1002, "1003", true, 403, ""},
+		{"Delete not exists comment", 1001, "999999", true, 404, ""},
+		{"Delete own comment without replies", 1001, "1003", true, 200, `{"is_tombstone":false}`},
+		{"Delete own comment with replies", 1001, "1001", true, 200, `{"is_tombstone":true}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			app, fiber := NewApiTestApp()
+			defer app.Cleanup()
+
+			app.Conf().Comment.Delete.Enabled = tt.enabled
+
+			handler.CommentWithdraw(app.App, fiber)
+
+			req := httptest.NewRequest("DELETE", "/user/comments/"+tt.commentID, nil)
+			if tt.userID != 0 {
+				req.Header.Set("Authorization", "Bearer "+getUserToken(t, app, tt.userID))
+			}
+
+			resp, err := fiber.Test(req)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedCode, resp.StatusCode)
+
+			if tt.expectedBody != "" {
+				body, _ := io.ReadAll(resp.Body)
+				assert.Equal(t, tt.expectedBody, string(body))
+			}
+		})
+	}
+}
@@ -4,6 +4,8 @@
 package comments_get
 
 import (
+	"time"
+
 	"github.com/artalkjs/artalk/v2/internal/dao"
 	"github.com/artalkjs/artalk/v2/internal/entity"
 )
@@ -19,6 +21,9 @@ const (
 type QueryOptions struct {
 	User entity.User
 
+	// The sites which the user is a site admin or moderator of
+	RoleSiteNames []string
+
 	Scope Scope
 
 	PagePayload PageScopePayload
@@ -28,6 +33,10 @@ type QueryOptions struct {
 	SortBy SortRule
 
 	Search string
+
+	// Only the comments created within the time range (the zero time means no limit)
+	CreatedAfter  time.Time
+	CreatedBefore time.Time
 }
 
 // Get query scope by params
@@ -42,13 +51,19 @@ type QueryOptions struct {
 func GetQueryScopes(dao *dao.Dao, opts QueryOptions) func(liteDB) liteDB {
 	return func(q liteDB) liteDB {
 		// Basic scope
-		q.Scopes(CommonScope(opts.User))
+		q.Scopes(CommonScope(opts.User, opts.RoleSiteNames))
+		q.Scopes(ShadowBanScope(opts.User, opts.RoleSiteNames, dao.GetShadowBannedUserIDs()))
 
 		// Search function
 		if opts.Search != "" {
 			q.Scopes(SearchScope(dao, opts.Search))
 		}
 
+		// Date range
+		if !opts.CreatedAfter.IsZero() || !opts.CreatedBefore.IsZero() {
+			q.Scopes(DateRangeScope(opts.CreatedAfter, opts.CreatedBefore))
+		}
+
 		// Scopes
 		q.Scopes(map[Scope]func(liteDB) liteDB{
 			ScopePage: PageScopeQuery(opts.PagePayload, PageScopeOpts{
@@ -59,8 +74,11 @@ func GetQueryScopes(dao *dao.Dao, opts QueryOptions) func(liteDB) liteDB {
 				GetUserComments: func(userID uint) []uint {
 					return dao.GetUserAllCommentIDs(userID)
 				},
+				GetUserMentionedComments: func(userID uint) []uint {
+					return dao.GetUserMentionedCommentIDs(userID)
+				},
 			}),
-			ScopeSite: SiteScopeQuery(opts.SitePayload, opts.User),
+			ScopeSite: SiteScopeQuery(opts.SitePayload, opts.User, opts.RoleSiteNames),
 		}[opts.Scope])
 
 		return q
@@ -7,6 +7,8 @@ import (
 	"github.com/artalkjs/artalk/v2/test"
 	"github.com/samber/lo"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
 )
 
 func TestGetQueryScopes(t *testing.T) {
@@ -141,3 +143,41 @@ func TestGetQueryScopes(t *testing.T) {
 		})
 	}
 }
+
+func TestShadowBanScope(t *testing.T) {
+	app, _ := test.NewTestApp()
+	defer app.Cleanup()
+
+	// user 1002 is shadow-banned
+	banned := []uint{1002}
+
+	tests := []struct {
+		name    string
+		user    entity.User
+		roles   []string
+		visible func(c entity.Comment) bool
+	}{
+		{"Visitor", entity.User{}, nil, func(c entity.Comment) bool { return c.UserID != 1002 }},
+		{"Other user", entity.User{Model: gorm.Model{ID: 1001}}, nil, func(c entity.Comment) bool { return c.UserID != 1002 }},
+		{"The banned user", entity.User{Model: gorm.Model{ID: 1002}}, nil, func(c entity.Comment) bool { return true }},
+		{"Admin", entity.User{Model: gorm.Model{ID: 1000}, IsAdmin: true}, nil, func(c entity.Comment) bool { return true }},
+		{"Site moderator", entity.User{Model: gorm.Model{ID: 1001}}, []string{"Site B"}, func(c entity.Comment) bool {
+			return c.UserID != 1002 || c.SiteName == "Site B"
+		}},
+	}
+
+	var all []entity.Comment
+	app.Dao().DB().Find(&all)
+	require.NotEmpty(t, lo.Filter(all, func(c entity.Comment, _ int) bool { return c.UserID == 1002 }))
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var comments []entity.Comment
+			app.Dao().DB().Scopes(ConvertGormScopes(ShadowBanScope(tt.user, tt.roles, banned))...).Find(&comments)
+
+			ids := lo.Map(comments, func(c entity.Comment, _ int) uint { return c.ID })
+			wantIDs := lo.FilterMap(all, func(c entity.Comment, _ int) (uint, bool) { return c.ID, tt.visible(c) })
+			assert.ElementsMatch(t, wantIDs, ids)
+		})
+	}
+}
@@ -56,3 +56,18 @@ func FindComments(dao *dao.Dao, opts QueryOptions, pg FindOptions) ([]entity.Coo
 
 	return cooked, count, rootsCount
 }
+
+// Find the ids of the comments by options (the earliest first)
+//
+// At most `limit` ids are returned, and the count is the number of all the matched comments.
+func FindCommentIDs(dao *dao.Dao, opts QueryOptions, limit int) ([]uint, int64) {
+	scopes := ConvertGormScopes(GetQueryScopes(dao, opts))
+
+	ids := []uint{}
+	dao.DB().Model(&entity.Comment{}).Scopes(scopes...).Order("id ASC").Limit(limit).Pluck("id", &ids)
+
+	var count int64
+	dao.DB().Model(&entity.Comment{}).Scopes(scopes...).Count(&count)
+
+	return ids, count
+}
@@ -18,11 +18,16 @@ type SitePayload struct {
 }
 
 // Site Scope (for message center & admin)
-func SiteScopeQuery(payload SitePayload, user entity.User) func(liteDB) liteDB {
+//
+// The non-admin users can only query the sites which they are a site admin or moderator of.
+func SiteScopeQuery(payload SitePayload, user entity.User, roleSiteNames []string) func(liteDB) liteDB {
 	return func(q liteDB) liteDB {
 		if !user.IsAdmin {
-			// only admin can query sites
-			return q.Where("1 = 0")
+			if len(roleSiteNames) == 0 {
+				// only admin can query sites
+				return q.Where("1 = 0")
+			}
+			q.Where("site_name IN (?)", roleSiteNames)
 		}
 
 		if payload.SiteName != "" {
@@ -22,6 +22,7 @@ func TestSiteScopeQuery(t *testing.T) {
 		name    string
 		payload SitePayload
 		user    entity.User
+		roles   []string
 		want    func(comments []entity.Comment)
 	}{
 		{
@@ -109,11 +110,25 @@ func TestSiteScopeQuery(t *testing.T) {
 				assert.Equal(t, "Site B", comments[0].SiteName)
 			},
 		},
+		{
+			name: "Site admin can only access the sites of the roles",
+			payload: SitePayload{
+				Type: SitePending,
+			},
+			user:  normalUser,
+			roles: []string{"Site B"},
+			want: func(comments []entity.Comment) {
+				for _, c := range comments {
+					assert.Equal(t, "Site B", c.SiteName)
+					assert.True(t, c.IsPending)
+				}
+			},
+		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			scope := SiteScopeQuery(tt.payload, tt.user)
+			scope := SiteScopeQuery(tt.payload, tt.user, tt.roles)
 			var comments []entity.Comment
 			app.Dao().DB().Scopes(ConvertGormScopes(scope)...).Find(&comments)
 			tt.want(comments)
@@ -19,8 +19,9 @@ type UserScopePayload struct {
 }
 
 type UserScopeOpts struct {
-	User            entity.User
-	GetUserComments func(userID uint) []uint
+	User                     entity.User
+	GetUserComments          func(userID uint) []uint
+	GetUserMentionedComments func(userID uint) []uint
 }
 
 // User Scope (for message center)
@@ -34,12 +35,18 @@ func UserScopeQuery(payload UserScopePayload, opts UserScopeOpts) func(liteDB) l
 		// Get user all comment ids which someone could reply to him
 		userCommentIDs := opts.GetUserComments(opts.User.ID)
 
+		// Get the comment ids which mentioned the user by `@name`
+		mentionedCommentIDs := []uint{}
+		if opts.GetUserMentionedComments != nil {
+			mentionedCommentIDs = opts.GetUserMentionedComments(opts.User.ID)
+		}
+
 		scopes := map[UserScopeType]func(liteDB) liteDB{
 			UserAll: func(d liteDB) liteDB {
-				return q.Where("user_id = ? OR rid IN (?)", opts.User.ID, userCommentIDs)
+				return q.Where("user_id = ? OR rid IN (?) OR id IN (?)", opts.User.ID, userCommentIDs, mentionedCommentIDs)
 			},
 			UserMentions: func(d liteDB) liteDB {
-				return q.Where("user_id != ? AND rid IN (?)", opts.User.ID, userCommentIDs)
+				return q.Where("user_id != ? AND (rid IN (?) OR id IN (?))", opts.User.ID, userCommentIDs, mentionedCommentIDs)
 			},
 			UserMine: func(d liteDB) liteDB {
 				return q.Where("user_id = ?", opts.User.ID)
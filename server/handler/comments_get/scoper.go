@@ -1,6 +1,8 @@
 package comments_get
 
 import (
+	"time"
+
 	"github.com/artalkjs/artalk/v2/internal/dao"
 	"github.com/artalkjs/artalk/v2/internal/entity"
 	"gorm.io/gorm"
@@ -9,17 +11,38 @@ import (
 // Basic scope for all queries
 //
 // It will ignore pending comments for non-admin users
-func CommonScope(user entity.User) func(liteDB) liteDB {
+// (except the comments of the sites which the user is a site admin or moderator of)
+func CommonScope(user entity.User, roleSiteNames []string) func(liteDB) liteDB {
 	return func(d liteDB) liteDB {
 		// Ignore pending comments
 		if !user.IsAdmin { // If not admin, ignore pending comments
-			d.Scopes(NoPending(user.ID))
+			if len(roleSiteNames) > 0 {
+				d.Where("site_name IN (?) OR (user_id = ? AND is_pending = ?) OR is_pending = ?", roleSiteNames, user.ID, true, false)
+			} else {
+				d.Scopes(NoPending(user.ID))
+			}
 		}
 
 		return d
 	}
 }
 
+// Hide the comments of the shadow-banned users
+//
+// The comments are only visible to the user themselves, the admins and the moderators of the sites.
+func ShadowBanScope(user entity.User, roleSiteNames []string, bannedUserIDs []uint) func(liteDB) liteDB {
+	return func(d liteDB) liteDB {
+		if user.IsAdmin || len(bannedUserIDs) == 0 {
+			return d
+		}
+
+		if len(roleSiteNames) > 0 {
+			return d.Where("site_name IN (?) OR user_id = ? OR user_id NOT IN (?)", roleSiteNames, user.ID, bannedUserIDs)
+		}
+		return d.Where("user_id = ? OR user_id NOT IN (?)", user.ID, bannedUserIDs)
+	}
+}
+
 // Filter root comments
 func OnlyRoot() func(db *gorm.DB) *gorm.DB {
 	return func(db *gorm.DB) *gorm.DB {
@@ -50,3 +73,16 @@ func SearchScope(dao *dao.Dao, keywords string) func(d liteDB) liteDB {
 			userIds, "%"+keywords+"%", keywords, keywords, keywords)
 	}
 }
+
+// Filter by the creation time (the zero time means no limit)
+func DateRangeScope(after time.Time, before time.Time) func(d liteDB) liteDB {
+	return func(d liteDB) liteDB {
+		if !after.IsZero() {
+			d.Where("created_at >= ?", after)
+		}
+		if !before.IsZero() {
+			d.Where("created_at <= ?", before)
+		}
+		return d
+	}
+}
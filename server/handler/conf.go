@@ -10,6 +10,7 @@ import (
 // @Summary      Get System Configs
 // @Description  Get System Configs for UI
 // @Tags         System
+// @Param        site_name  query  string  false  "The site name (to apply the site config overlay)"
 // @Produce      json
 // @Success      200  {object}  common.ConfData
 // @Router       /conf  [get]
@@ -0,0 +1,148 @@
+package handler_test
+
+import (
+	"encoding/json"
+	"io"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/artalkjs/artalk/v2/internal/core"
+	"github.com/artalkjs/artalk/v2/internal/entity"
+	"github.com/artalkjs/artalk/v2/server/handler"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEmailDigest(t *testing.T) {
+	app, fiber := NewApiTestApp()
+	defer app.Cleanup()
+
+	handler.UserInfoUpdate(app.App, fiber)
+
+	emailService, err := core.AppService[*core.EmailService](app.App)
+	require.NoError(t, err)
+	digestService, err := core.AppService[*core.EmailDigestService](app.App)
+	require.NoError(t, err)
+
+	app.Conf().Email.Enabled = true
+	app.Conf().Email.Digest.Hour = 9
+	app.Conf().Email.Digest.TimeZone = "Asia/Shanghai"
+
+	updateDigest := func(t *testing.T, userID uint, frequency string) (int, []byte) {
+		body := `{"name":"userA","email":"user_a@qwqaq.com","email_digest":"` + frequency + `"}`
+		req := httptest.NewRequest("POST", "/user", strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("Authorization", "Bearer "+getUserToken(t, app, userID))
+		resp, err := fiber.Test(req)
+		require.NoError(t, err)
+		respBody, _ := io.ReadAll(resp.Body)
+		return resp.StatusCode, respBody
+	}
+
+	countEmails := func(toAddr string) int64 {
+		var count int64
+		app.Dao().DB().Model(&entity.EmailTask{}).Where("to_addr = ?", toAddr).Count(&count)
+		return count
+	}
+
+	t.Run("Update frequency", func(t *testing.T) {
+		code, _ := updateDigest(t, 1001, "hourly")
+		assert.Equal(t, 400, code)
+
+		code, body := updateDigest(t, 1001, "daily")
+		require.Equal(t, 200, code, string(body))
+
+		var result handler.ResponseUserInfoUpdate
+		require.NoError(t, json.Unmarshal(body, &result))
+		assert.Equal(t, "daily", result.User.EmailDigest)
+
+		user := app.Dao().FindUserByID(1001)
+		assert.Equal(t, entity.EmailDigestDaily, digestService.Frequency(&user))
+	})
+
+	t.Run("Default frequency", func(t *testing.T) {
+		admin := app.Dao().FindUserByID(1000)
+		assert.Equal(t, entity.EmailDigestImmediate, digestService.Frequency(&admin), "immediate by default")
+
+		app.Conf().AdminNotify.Email.Digest = "weekly"
+		defer func() { app.Conf().AdminNotify.Email.Digest = "immediate" }()
+		assert.Equal(t, entity.EmailDigestWeekly, digestService.Frequency(&admin))
+
+		userB := app.Dao().FindUserByID(1002)
+		assert.Equal(t, entity.EmailDigestImmediate, digestService.Frequency(&userB), "the admin default is not used for the users")
+
+		require.NoError(t, app.Dao().SetUserSiteRoles(1002, map[string]entity.SiteRole{"Site A": entity.SiteRoleModerator}))
+		defer app.Dao().SetUserSiteRoles(1002, nil)
+		assert.Equal(t, entity.EmailDigestWeekly, digestService.Frequency(&userB), "the admin default is used for the site moderators")
+	})
+
+	t.Run("Next run time", func(t *testing.T) {
+		shanghai, _ := time.LoadLocation("Asia/Shanghai")
+		assert.Equal(t, time.Date(2026, 1, 1, 9, 0, 0, 0, shanghai), digestService.NextRunAt(time.Date(2026, 1, 1, 8, 30, 0, 0, shanghai)))
+		assert.Equal(t, time.Date(2026, 1, 2, 9, 0, 0, 0, shanghai), digestService.NextRunAt(time.Date(2026, 1, 1, 9, 0, 0, 0, shanghai)))
+		assert.Equal(t, time.Date(2026, 1, 2, 9, 0, 0, 0, shanghai), digestService.NextRunAt(time.Date(2026, 1, 1, 2, 0, 0, 0, time.UTC)))
+	})
+
+	t.Run("Send the digest", func(t *testing.T) {
+		notify := app.Dao().FindNotify(1001, 1000)
+		require.False(t, notify.IsEmpty())
+		require.NoError(t, app.Dao().NotifySetInitial(&notify))
+
+		// the email is not sent immediately
+		emailService.AsyncSend(&notify)
+		assert.Equal(t, int64(0), countEmails("user_a@qwqaq.com"))
+
+		// the weekly digests are not sent on other days
+		now := time.Now()
+		app.Conf().Email.Digest.Weekday = (int(now.In(time.UTC).Weekday()) + 3) % 7
+		digestService.Run(now.In(time.UTC))
+		require.Equal(t, int64(1), countEmails("user_a@qwqaq.com"))
+
+		var task entity.EmailTask
+		app.Dao().DB().Where("to_addr = ?", "user_a@qwqaq.com").First(&task)
+		assert.Contains(t, task.Subject, "You have 1 unread replies")
+		assert.Contains(t, task.Body, "您有 1 条未读的回复")
+		assert.True(t, app.Dao().FindNotifyByID(notify.ID).IsEmailed)
+
+		// the notifies are only summarized once
+		digestService.Run(now.In(time.UTC))
+		assert.Equal(t, int64(1), countEmails("user_a@qwqaq.com"))
+	})
+
+	t.Run("Missed runs", func(t *testing.T) {
+		// the server is down for days after the last run
+		lastRun := app.Dao().FindEmailDigestRun(entity.EmailDigestDaily)
+		require.False(t, lastRun.IsEmpty(), "the run is recorded")
+		lastRun.RanAt = time.Now().AddDate(0, 0, -4)
+		require.NoError(t, app.Dao().SaveEmailDigestRun(&lastRun))
+
+		notify := app.Dao().FindCreateNotify(1001, 1002)
+		require.NoError(t, app.Dao().NotifySetInitial(&notify))
+		require.NoError(t, app.Dao().DB().Model(&notify).UpdateColumn("updated_at", time.Now().AddDate(0, 0, -3)).Error)
+
+		digestService.Run(time.Now())
+		assert.Equal(t, int64(2), countEmails("user_a@qwqaq.com"), "the notifies since the last run are summarized")
+		assert.True(t, app.Dao().FindNotifyByID(notify.ID).IsEmailed)
+	})
+
+	t.Run("Site managers", func(t *testing.T) {
+		app.Conf().AdminNotify.Email.Digest = "daily"
+		defer func() { app.Conf().AdminNotify.Email.Digest = "immediate" }()
+		require.NoError(t, app.Dao().SetUserSiteRoles(1002, map[string]entity.SiteRole{"Site A": entity.SiteRoleModerator}))
+		defer app.Dao().SetUserSiteRoles(1002, nil)
+
+		// the new comment is notified to the site moderator
+		notify := app.Dao().FindCreateNotify(1002, 1000)
+		require.NoError(t, app.Dao().NotifySetInitial(&notify))
+
+		digestService.Run(time.Now())
+		require.Equal(t, int64(1), countEmails("user_b@qwqaq.com"))
+
+		var task entity.EmailTask
+		app.Dao().DB().Where("to_addr = ?", "user_b@qwqaq.com").First(&task)
+		assert.Contains(t, task.Subject, "You have 1 new comment notifications")
+		assert.Contains(t, task.Body, "您有 1 条新的评论通知")
+	})
+}
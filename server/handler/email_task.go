@@ -0,0 +1,109 @@
+package handler
+
+import (
+	"github.com/artalkjs/artalk/v2/internal/core"
+	"github.com/artalkjs/artalk/v2/internal/entity"
+	"github.com/artalkjs/artalk/v2/internal/i18n"
+	"github.com/artalkjs/artalk/v2/internal/log"
+	"github.com/artalkjs/artalk/v2/server/common"
+	"github.com/gofiber/fiber/v2"
+)
+
+type ParamsEmailTaskList struct {
+	Status entity.EmailTaskStatus `query:"status" json:"status" enums:"pending,sending,sent,failed" validate:"optional"` // Filter by status
+	Limit  int                    `query:"limit" json:"limit" validate:"optional"`                                       // The limit for pagination
+	Offset int                    `query:"offset" json:"offset" validate:"optional"`                                     // The offset for pagination
+}
+
+type ResponseEmailTaskList struct {
+	Items []entity.CookedEmailTask `json:"items"`
+	Count int64                    `json:"count"`
+}
+
+// @Id           GetEmailTasks
+// @Summary      Get Email Tasks
+// @Description  Get the emails in the sending queue (latest first)
+// @Tags         System
+// @Param        options  query  ParamsEmailTaskList  true  "The options"
+// @Security     ApiKeyAuth
+// @Produce      json
+// @Success      200  {object}  ResponseEmailTaskList
+// @Failure      403  {object}  Map{msg=string}
+// @Router       /email_tasks  [get]
+func EmailTaskList(app *core.App, router fiber.Router) {
+	router.Get("/email_tasks", common.AdminGuard(app, func(c *fiber.Ctx) error {
+		var p ParamsEmailTaskList
+		if isOK, resp := common.ParamsDecode(c, &p); !isOK {
+			return resp
+		}
+
+		q := app.Dao().DB().Model(&entity.EmailTask{}).Order("id DESC")
+		if p.Status != "" {
+			q = q.Where("status = ?", p.Status)
+		}
+
+		var total int64
+		q.Count(&total)
+
+		var tasks []entity.EmailTask
+		q.Scopes(Paginate(p.Offset, p.Limit)).Find(&tasks)
+
+		items := []entity.CookedEmailTask{}
+		for _, t := range tasks {
+			items = append(items, app.Dao().CookEmailTask(&t))
+		}
+
+		return common.RespData(c, ResponseEmailTaskList{
+			Items: items,
+			Count: total,
+		})
+	}))
+}
+
+type ResponseEmailTaskResend struct {
+	entity.CookedEmailTask
+}
+
+// @Id           ResendEmailTask
+// @Summary      Resend Email
+// @Description  Put the email back into the sending queue (the attempts are reset), and return the updated task
+// @Tags         System
+// @Param        id  path  int  true  "The email task ID"
+// @Security     ApiKeyAuth
+// @Produce      json
+// @Success      200  {object}  ResponseEmailTaskResend
+// @Failure      400  {object}  Map{msg=string}
+// @Failure      403  {object}  Map{msg=string}
+// @Failure      404  {object}  Map{msg=string}
+// @Failure      500  {object}  Map{msg=string}
+// @Router       /email_tasks/{id}/resend  [post]
+func EmailTaskResend(app *core.App, router fiber.Router) {
+	router.Post("/email_tasks/:id/resend", common.AdminGuard(app, func(c *fiber.Ctx) error {
+		id, _ := c.ParamsInt("id")
+
+		task := app.Dao().FindEmailTask(uint(id))
+		if task.IsEmpty() {
+			return common.RespError(c, 404, i18n.T("{{name}} not found", Map{"name": i18n.T("Email task")}))
+		}
+		if task.Status == entity.EmailTaskStatusPending || task.Status == entity.EmailTaskStatusSending {
+			return common.RespError(c, 400, i18n.T("The email is being sent"))
+		}
+		if !app.Conf().Email.Enabled {
+			return common.RespError(c, 400, i18n.T("Email notification is disabled"))
+		}
+
+		emailService, err := core.AppService[*core.EmailService](app)
+		if err != nil {
+			log.Error("[EmailService] err: ", err)
+			return common.RespError(c, 500, "EmailService err: "+err.Error())
+		}
+		if err := emailService.Resend(&task); err != nil {
+			log.Error("[EmailTaskResend] err: ", err)
+			return common.RespError(c, 500, err.Error())
+		}
+
+		return common.RespData(c, ResponseEmailTaskResend{
+			CookedEmailTask: app.Dao().CookEmailTask(&task),
+		})
+	}))
+}
@@ -0,0 +1,74 @@
+package handler_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/artalkjs/artalk/v2/internal/entity"
+	"github.com/artalkjs/artalk/v2/server/handler"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEmailTask(t *testing.T) {
+	app, fiber := NewApiTestApp()
+	defer app.Cleanup()
+
+	handler.EmailTaskList(app.App, fiber)
+	handler.EmailTaskResend(app.App, fiber)
+
+	failed := entity.EmailTask{ToAddr: "user_a@example.com", Subject: "Hi", Status: entity.EmailTaskStatusFailed, Attempts: 4, Error: "Failed to send the email"}
+	sending := entity.EmailTask{ToAddr: "user_b@example.com", Subject: "Hi", Status: entity.EmailTaskStatusSending, Attempts: 1}
+	require.NoError(t, app.Dao().SaveEmailTask(&failed))
+	require.NoError(t, app.Dao().SaveEmailTask(&sending))
+
+	request := func(method, target string, userID uint) (int, []byte) {
+		req := httptest.NewRequest(method, target, nil)
+		req.Header.Set("Authorization", "Bearer "+getUserToken(t, app, userID))
+		resp, err := fiber.Test(req)
+		require.NoError(t, err)
+		body, _ := io.ReadAll(resp.Body)
+		return resp.StatusCode, body
+	}
+
+	t.Run("Admin only", func(t *testing.T) {
+		code, _ := request("GET", "/email_tasks", 1001)
+		assert.Equal(t, 403, code)
+	})
+
+	t.Run("List", func(t *testing.T) {
+		code, body := request("GET", "/email_tasks?status=failed", 1000)
+		require.Equal(t, 200, code, string(body))
+
+		var result handler.ResponseEmailTaskList
+		require.NoError(t, json.Unmarshal(body, &result))
+		assert.Equal(t, int64(1), result.Count)
+		assert.Equal(t, failed.ID, result.Items[0].ID)
+		assert.Equal(t, "user_a@example.com", result.Items[0].ToAddr)
+		assert.Equal(t, 4, result.Items[0].Attempts)
+	})
+
+	t.Run("Resend", func(t *testing.T) {
+		code, _ := request("POST", "/email_tasks/99999/resend", 1000)
+		assert.Equal(t, 404, code)
+
+		code, _ = request("POST", fmt.Sprintf("/email_tasks/%d/resend", sending.ID), 1000)
+		assert.Equal(t, 400, code, "the email is being sent")
+
+		code, _ = request("POST", fmt.Sprintf("/email_tasks/%d/resend", failed.ID), 1000)
+		assert.Equal(t, 400, code, "the email notification is disabled")
+
+		app.Conf().Email.Enabled = true
+		code, body := request("POST", fmt.Sprintf("/email_tasks/%d/resend", failed.ID), 1000)
+		require.Equal(t, 200, code, string(body))
+
+		var result handler.ResponseEmailTaskResend
+		require.NoError(t, json.Unmarshal(body, &result))
+		assert.Equal(t, entity.EmailTaskStatusPending, result.Status)
+		assert.Equal(t, 0, result.Attempts)
+		assert.Empty(t, result.Error)
+	})
+}
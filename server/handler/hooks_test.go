@@ -0,0 +1,102 @@
+package handler_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/artalkjs/artalk/v2/internal/core"
+	"github.com/artalkjs/artalk/v2/internal/hook"
+	"github.com/artalkjs/artalk/v2/server/handler"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHooks(t *testing.T) {
+	app, fiber := NewApiTestApp()
+	defer app.Cleanup()
+
+	handler.CommentCreate(app.App, fiber)
+	handler.CommentDelete(app.App, fiber)
+
+	createdComments := make(chan core.CommentCreatedEvent, 1)
+	app.OnCommentCreated().Add(func(e *core.CommentCreatedEvent) error {
+		createdComments <- *e
+		return nil
+	})
+
+	createdPages := []string{}
+	app.OnPageCreated().Add(func(e *core.PageCreatedEvent) error {
+		createdPages = append(createdPages, e.Page.Key)
+		return nil
+	})
+
+	// skip pushing the notifies
+	app.OnNotify().Add(func(e *core.NotifyEvent) error {
+		return hook.ErrStopPropagation
+	})
+
+	t.Run("Comment created", func(t *testing.T) {
+		body, _ := json.Marshal(map[string]any{
+			"name": "userB", "email": "user_b@qwqaq.com", "content": "new comment",
+			"page_key": "/test/new.html", "page_title": "New Page", "site_name": "Site A", "rid": 0,
+		})
+		req := httptest.NewRequest("POST", "/comments", bytes.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		resp, err := fiber.Test(req)
+		require.NoError(t, err)
+		respBody, _ := io.ReadAll(resp.Body)
+		require.Equal(t, 200, resp.StatusCode, string(respBody))
+
+		select {
+		case e := <-createdComments:
+			assert.Equal(t, "new comment", e.Comment.Content)
+			assert.Equal(t, "/test/new.html", e.Page.Key)
+			assert.False(t, e.IsAdmin)
+			assert.True(t, e.ParentComment.IsEmpty())
+		case <-time.After(5 * time.Second):
+			t.Fatal("the comment created hook is not triggered")
+		}
+
+		assert.Equal(t, []string{"/test/new.html"}, createdPages)
+	})
+
+	t.Run("Comment reply is not notified", func(t *testing.T) {
+		body, _ := json.Marshal(map[string]any{
+			"name": "userB", "email": "user_b@qwqaq.com", "content": "reply",
+			"page_key": "/test/1000.html", "site_name": "Site A", "rid": 1001,
+		})
+		req := httptest.NewRequest("POST", "/comments", bytes.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		resp, err := fiber.Test(req)
+		require.NoError(t, err)
+		require.Equal(t, 200, resp.StatusCode)
+
+		select {
+		case e := <-createdComments:
+			assert.Equal(t, uint(1001), e.ParentComment.ID)
+			assert.True(t, app.Dao().FindNotify(1001, e.Comment.ID).IsEmpty(), "notify should be skipped by the hook")
+		case <-time.After(5 * time.Second):
+			t.Fatal("the comment created hook is not triggered")
+		}
+	})
+
+	t.Run("Comment deleted", func(t *testing.T) {
+		deleted := []uint{}
+		app.OnCommentDeleted().Add(func(e *core.CommentDeletedEvent) error {
+			deleted = append(deleted, e.Comment.ID)
+			return nil
+		})
+
+		req := httptest.NewRequest("DELETE", "/comments/1002", nil)
+		req.Header.Set("Authorization", "Bearer "+getUserToken(t, app, 1000))
+		resp, err := fiber.Test(req)
+		require.NoError(t, err)
+		require.Equal(t, 200, resp.StatusCode)
+
+		assert.Equal(t, []uint{1002}, deleted)
+	})
+}
@@ -2,6 +2,7 @@ package handler
 
 import (
 	"github.com/artalkjs/artalk/v2/internal/core"
+	"github.com/artalkjs/artalk/v2/internal/entity"
 	"github.com/artalkjs/artalk/v2/internal/i18n"
 	"github.com/artalkjs/artalk/v2/server/common"
 	"github.com/gofiber/fiber/v2"
@@ -20,13 +21,16 @@ import (
 // @Failure      500  {object}  Map{msg=string}
 // @Router       /pages/{id}  [delete]
 func PageDelete(app *core.App, router fiber.Router) {
-	router.Delete("/pages/:id", common.AdminGuard(app, func(c *fiber.Ctx) error {
+	router.Delete("/pages/:id", common.SiteRoleGuard(app, func(c *fiber.Ctx) error {
 		id, _ := c.ParamsInt("id")
 
 		page := app.Dao().FindPageByID(uint(id))
 		if page.IsEmpty() {
 			return common.RespError(c, 404, i18n.T("{{name}} not found", Map{"name": i18n.T("Page")}))
 		}
+		if !common.CheckSiteRoleReq(app, c, page.SiteName, entity.SiteRoleAdmin) {
+			return common.RespSiteRoleRequired(c)
+		}
 
 		err := app.Dao().DelPage(&page)
 		if err != nil {
@@ -32,7 +32,7 @@ type ResponsePageList struct {
 // @Failure      403  {object}  Map{msg=string}
 // @Router       /pages  [get]
 func PageList(app *core.App, router fiber.Router) {
-	router.Get("/pages", common.AdminGuard(app, func(c *fiber.Ctx) error {
+	router.Get("/pages", common.SiteRoleGuard(app, func(c *fiber.Ctx) error {
 		var p ParamsPageList
 		if isOK, resp := common.ParamsDecode(c, &p); !isOK {
 			return resp
@@ -48,6 +48,11 @@ func PageList(app *core.App, router fiber.Router) {
 			q = q.Where("site_name = ?", p.SiteName)
 		}
 
+		// The site admins can only list the pages of their sites
+		if siteNames, isAll := common.GetRoleSiteNamesReq(app, c, entity.SiteRoleAdmin); !isAll {
+			q = q.Where("site_name IN (?)", siteNames)
+		}
+
 		// Search
 		if p.Search != "" {
 			q = q.Scopes(func(d *gorm.DB) *gorm.DB {
@@ -1,11 +1,14 @@
 package handler
 
 import (
+	"slices"
+
 	"github.com/artalkjs/artalk/v2/internal/core"
 	"github.com/artalkjs/artalk/v2/internal/dao"
 	"github.com/artalkjs/artalk/v2/internal/entity"
 	"github.com/artalkjs/artalk/v2/internal/i18n"
 	"github.com/artalkjs/artalk/v2/server/common"
+	cog "github.com/artalkjs/artalk/v2/server/handler/comments_get"
 	"github.com/gofiber/fiber/v2"
 )
 
@@ -15,6 +18,11 @@ type ParamsPageUpdate struct {
 	Key       string `json:"key" validate:"required"`        // Updated page key
 	Title     string `json:"title" validate:"required"`      // Updated page title
 	AdminOnly bool   `json:"admin_only" validate:"required"` // Updated page admin_only option
+
+	IsLocked       *bool `json:"is_locked" validate:"optional"`        // Updated page is_locked option (close the comments manually)
+	CloseAfterDays *int  `json:"close_after_days" validate:"optional"` // Updated page close_after_days option (close the comments after N days since the page created, 0 means never)
+
+	Settings *entity.PageSettings `json:"settings" validate:"optional"` // Updated page-level settings to override the global config
 }
 
 type ResponsePageUpdate struct {
@@ -37,7 +45,7 @@ type ResponsePageUpdate struct {
 // @Failure      500  {object}  Map{msg=string}
 // @Router       /pages/{id}  [put]
 func PageUpdate(app *core.App, router fiber.Router) {
-	router.Put("/pages/:id", common.AdminGuard(app, func(c *fiber.Ctx) error {
+	router.Put("/pages/:id", common.SiteRoleGuard(app, func(c *fiber.Ctx) error {
 		id, _ := c.ParamsInt("id")
 
 		var p ParamsPageUpdate
@@ -50,11 +58,23 @@ func PageUpdate(app *core.App, router fiber.Router) {
 			return resp
 		}
 
+		if p.CloseAfterDays != nil && *p.CloseAfterDays < 0 {
+			return common.RespError(c, 400, i18n.T("Invalid {{name}}", Map{"name": "close_after_days"}))
+		}
+
+		if p.Settings != nil && p.Settings.SortRule != "" &&
+			!slices.Contains([]cog.SortRule{cog.SortByDateAsc, cog.SortByDateDesc, cog.SortByVote}, cog.SortRule(p.Settings.SortRule)) {
+			return common.RespError(c, 400, i18n.T("Invalid {{name}}", Map{"name": "sort_rule"}))
+		}
+
 		// find page
 		var page = app.Dao().FindPageByID(uint(id))
 		if page.IsEmpty() {
 			return common.RespError(c, 404, i18n.T("{{name}} not found", Map{"name": i18n.T("Page")}))
 		}
+		if !common.CheckSiteRoleReq(app, c, page.SiteName, entity.SiteRoleAdmin) {
+			return common.RespSiteRoleRequired(c)
+		}
 
 		// 重命名合法性检测
 		modifyKey := p.Key != page.Key
@@ -69,6 +89,15 @@ func PageUpdate(app *core.App, router fiber.Router) {
 
 		page.Title = p.Title
 		page.AdminOnly = p.AdminOnly
+		if p.IsLocked != nil {
+			page.IsLocked = *p.IsLocked
+		}
+		if p.CloseAfterDays != nil {
+			page.CloseAfterDays = *p.CloseAfterDays
+		}
+		if p.Settings != nil {
+			page.Settings = *p.Settings
+		}
 		if modifyKey {
 			// 相关性数据修改
 			var comments []entity.Comment
@@ -0,0 +1,178 @@
+package handler_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/artalkjs/artalk/v2/internal/entity"
+	"github.com/artalkjs/artalk/v2/server/handler"
+	"github.com/artalkjs/artalk/v2/server/middleware/limiter"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPageClose(t *testing.T) {
+	app, fiber := NewApiTestApp()
+	defer app.Cleanup()
+
+	handler.PageUpdate(app.App, fiber)
+	handler.CommentCreate(app.App, fiber)
+
+	token := getUserToken(t, app, 1000)
+	request := func(method, target string, data map[string]any, withToken bool) (int, []byte) {
+		body, _ := json.Marshal(data)
+		req := httptest.NewRequest(method, target, bytes.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		if withToken {
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+		resp, err := fiber.Test(req)
+		require.NoError(t, err)
+		respBody, _ := io.ReadAll(resp.Body)
+		return resp.StatusCode, respBody
+	}
+
+	updatePage := func(t *testing.T, data map[string]any) handler.ResponsePageUpdate {
+		data["site_name"] = "Site A"
+		data["key"] = "/test/1000.html"
+		data["title"] = "Test Page"
+		data["admin_only"] = false
+		code, body := request("PUT", "/pages/1000", data, true)
+		require.Equal(t, 200, code, string(body))
+
+		var result handler.ResponsePageUpdate
+		require.NoError(t, json.Unmarshal(body, &result))
+		return result
+	}
+
+	createComment := func() int {
+		code, _ := request("POST", "/comments", map[string]any{
+			"name": "userB", "email": "user_b@qwqaq.com", "content": "new comment",
+			"page_key": "/test/1000.html", "site_name": "Site A", "rid": 1001,
+		}, false)
+		return code
+	}
+
+	t.Run("Invalid close_after_days", func(t *testing.T) {
+		code, _ := request("PUT", "/pages/1000", map[string]any{
+			"site_name": "Site A", "key": "/test/1000.html", "title": "Test Page", "admin_only": false, "close_after_days": -1,
+		}, true)
+		assert.Equal(t, 400, code)
+	})
+
+	t.Run("Lock", func(t *testing.T) {
+		result := updatePage(t, map[string]any{"is_locked": true})
+		assert.True(t, result.IsLocked)
+		assert.True(t, result.IsClosed)
+
+		assert.Equal(t, 403, createComment())
+
+		comment := app.Dao().FindComment(1001)
+		assert.False(t, app.Dao().CookComment(&comment).IsAllowReply)
+	})
+
+	t.Run("Keep the lock when the option is omitted", func(t *testing.T) {
+		result := updatePage(t, map[string]any{})
+		assert.True(t, result.IsLocked)
+	})
+
+	t.Run("Auto close", func(t *testing.T) {
+		// the page was created long ago
+		result := updatePage(t, map[string]any{"is_locked": false, "close_after_days": 30})
+		assert.False(t, result.IsLocked)
+		assert.Equal(t, 30, result.CloseAfterDays)
+		assert.NotEmpty(t, result.CloseAt)
+		assert.True(t, result.IsClosed)
+
+		assert.Equal(t, 403, createComment())
+	})
+
+	t.Run("Reopen", func(t *testing.T) {
+		result := updatePage(t, map[string]any{"close_after_days": 0})
+		assert.Empty(t, result.CloseAt)
+		assert.False(t, result.IsClosed)
+
+		comment := app.Dao().FindComment(1001)
+		assert.True(t, app.Dao().CookComment(&comment).IsAllowReply)
+	})
+}
+
+func TestPageSettings(t *testing.T) {
+	app, fiber := NewApiTestApp()
+	defer app.Cleanup()
+
+	app.Conf().Captcha.Enabled = true
+	app.Conf().Captcha.Always = false
+	app.Conf().Captcha.ActionLimit = 3
+
+	fiber.Use(limiter.ActionLimitMiddleware(app.App, limiter.ActionLimitConf{}))
+	handler.PageUpdate(app.App, fiber)
+	handler.CommentList(app.App, fiber)
+	handler.CaptchaStatus(app.App, fiber)
+
+	token := getUserToken(t, app, 1000)
+	request := func(method, target string, data map[string]any) (int, []byte) {
+		body, _ := json.Marshal(data)
+		req := httptest.NewRequest(method, target, bytes.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("Authorization", "Bearer "+token)
+		resp, err := fiber.Test(req)
+		require.NoError(t, err)
+		respBody, _ := io.ReadAll(resp.Body)
+		return resp.StatusCode, respBody
+	}
+
+	updateSettings := func(settings map[string]any) (int, []byte) {
+		return request("PUT", "/pages/1000", map[string]any{
+			"site_name": "Site A", "key": "/test/1000.html", "title": "Test Page", "admin_only": false,
+			"settings": settings,
+		})
+	}
+
+	listCommentIDs := func(t *testing.T, query string) []uint {
+		code, body := request("GET", "/comments?limit=100&page_key=/test/1000.html&site_name=Site+A"+query, nil)
+		require.Equal(t, 200, code, string(body))
+
+		var result handler.ResponseCommentList
+		require.NoError(t, json.Unmarshal(body, &result))
+		return lo.Map(result.Comments, func(c entity.CookedComment, _ int) uint { return c.ID })
+	}
+
+	t.Run("Invalid sort rule", func(t *testing.T) {
+		code, _ := updateSettings(map[string]any{"sort_rule": "random"})
+		assert.Equal(t, 400, code)
+	})
+
+	t.Run("Update settings", func(t *testing.T) {
+		code, body := updateSettings(map[string]any{"sort_rule": "date_asc", "flat_mode": true, "captcha_always": true})
+		require.Equal(t, 200, code, string(body))
+
+		var result handler.ResponsePageUpdate
+		require.NoError(t, json.Unmarshal(body, &result))
+		assert.Equal(t, "date_asc", result.Settings.SortRule)
+		assert.True(t, *result.Settings.FlatMode)
+		assert.Nil(t, result.Settings.PendingDefault, "the omitted settings follow the global config")
+	})
+
+	t.Run("Comment list defaults", func(t *testing.T) {
+		ids := listCommentIDs(t, "")
+		require.GreaterOrEqual(t, len(ids), 2)
+		assert.Equal(t, []uint{1000, 1001}, ids[:2], "flat and sorted by date asc")
+
+		// the options in the request take precedence
+		ids = listCommentIDs(t, "&sort_by=date_desc&flat_mode=false")
+		assert.Equal(t, uint(1005), ids[0], "nested and sorted by date desc (the latest root comment first)")
+	})
+
+	t.Run("Captcha always", func(t *testing.T) {
+		_, body := request("GET", "/captcha/status?page_key=/test/1000.html&site_name=Site+A", nil)
+		assert.JSONEq(t, `{"is_pass":false}`, string(body))
+
+		_, body = request("GET", "/captcha/status?page_key=/test/1000.html&site_name=Site+B", nil)
+		assert.JSONEq(t, `{"is_pass":true}`, string(body))
+	})
+}
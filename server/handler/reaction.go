@@ -0,0 +1,191 @@
+package handler
+
+import (
+	"net/url"
+	"slices"
+	"strings"
+
+	"github.com/artalkjs/artalk/v2/internal/core"
+	"github.com/artalkjs/artalk/v2/internal/entity"
+	"github.com/artalkjs/artalk/v2/internal/i18n"
+	"github.com/artalkjs/artalk/v2/internal/utils"
+	"github.com/artalkjs/artalk/v2/server/common"
+	"github.com/gofiber/fiber/v2"
+	"github.com/samber/lo"
+)
+
+type ResponseReaction struct {
+	Types       []string       `json:"types"`        // The available emoji reactions
+	Reactions   map[string]int `json:"reactions"`    // The count of each emoji reaction
+	MyReactions []string       `json:"my_reactions"` // The reactions made by the current visitor
+}
+
+// @Id           GetReactions
+// @Summary      Get Reactions
+// @Description  Get the emoji reactions of a specific comment
+// @Tags         Vote
+// @Param        target_name  path  string  true  "The name of reaction target"  Enums(comment)
+// @Param        target_id    path  int     true  "The target comment ID"
+// @Produce      json
+// @Success      200  {object}  ResponseReaction
+// @Failure      404  {object}  Map{msg=string}
+// @Router       /reactions/{target_name}/{target_id}  [get]
+func ReactionGet(app *core.App, router fiber.Router) {
+	router.Get("/reactions/:target_name/:target_id", func(c *fiber.Ctx) error {
+		comment, ok, resp := findReactionTarget(app, c)
+		if !ok {
+			return resp
+		}
+
+		return common.RespData(c, getReactionResponse(app, &comment, c.IP()))
+	})
+}
+
+type ParamsReactionCreate struct {
+	Name  string `json:"name" validate:"optional"`  // The username
+	Email string `json:"email" validate:"optional"` // The user email
+}
+
+// @Id           CreateReaction
+// @Summary      Create Reaction
+// @Description  React to a specific comment with an emoji (react again to cancel)
+// @Tags         Vote
+// @Param        target_name  path  string                true  "The name of reaction target"  Enums(comment)
+// @Param        target_id    path  int                   true  "The target comment ID"
+// @Param        reaction     path  string                true  "The emoji reaction (URL encoded)"
+// @Param        data         body  ParamsReactionCreate  true  "The reaction data"
+// @Accept       json
+// @Produce      json
+// @Success      200  {object}  ResponseReaction
+// @Failure      400  {object}  Map{msg=string}
+// @Failure      403  {object}  Map{msg=string}
+// @Failure      404  {object}  Map{msg=string}
+// @Failure      500  {object}  Map{msg=string}
+// @Router       /reactions/{target_name}/{target_id}/{reaction}  [post]
+func ReactionCreate(app *core.App, router fiber.Router) {
+	router.Post("/reactions/:target_name/:target_id/:reaction", common.LimiterGuard(app, func(c *fiber.Ctx) error {
+		var p ParamsReactionCreate
+		if isOK, resp := common.ParamsDecode(c, &p); !isOK {
+			return resp
+		}
+
+		if ok, resp := common.CheckBlocklistReq(app, c, p.Email); !ok {
+			return resp
+		}
+
+		if !app.Conf().Comment.Reaction.Enabled {
+			return common.RespError(c, 403, i18n.T("Reactions are disabled"))
+		}
+
+		comment, ok, resp := findReactionTarget(app, c)
+		if !ok {
+			return resp
+		}
+
+		reaction, _ := url.PathUnescape(c.Params("reaction"))
+		if !slices.Contains(getReactionTypes(app, comment.SiteName), reaction) {
+			return common.RespError(c, 400, i18n.T("Invalid {{name}}", Map{"name": i18n.T("Reaction")}))
+		}
+
+		// Find user
+		var user entity.User
+		if p.Name != "" && p.Email != "" {
+			var err error
+			user, err = app.Dao().FindCreateUser(p.Name, p.Email, "")
+			if err != nil {
+				return common.RespError(c, 500, "Failed to create user")
+			}
+		}
+
+		ip := c.IP()
+
+		// Toggle the reaction (the same IP can only make each reaction once)
+		var existsReactions []entity.Vote
+		app.Dao().DB().Where("type = ? AND target_id = ? AND ip = ? AND reaction = ?",
+			entity.VoteTypeCommentReaction, comment.ID, ip, reaction).Find(&existsReactions)
+		if len(existsReactions) == 0 {
+			vote, err := app.Dao().NewReaction(comment.ID, reaction, user.ID, string(c.Request().Header.UserAgent()), ip)
+			if err != nil {
+				return common.RespError(c, 500, i18n.T("{{name}} save failed", Map{"name": i18n.T("Reaction")}))
+			}
+			triggerVoteCreated(app, &vote, comment.SiteName)
+		} else {
+			if err := app.Dao().DB().Unscoped().Delete(&existsReactions).Error; err != nil {
+				return common.RespError(c, 500, i18n.T("{{name}} deletion failed", Map{"name": i18n.T("Reaction")}))
+			}
+		}
+
+		// Sync the counts to the comment
+		comment.Reactions = app.Dao().GetReactionNums(comment.ID)
+		app.Dao().UpdateComment(&comment)
+
+		return common.RespData(c, getReactionResponse(app, &comment, ip))
+	}))
+}
+
+// Find the target comment of the reaction (only comment is supported for now)
+func findReactionTarget(app *core.App, c *fiber.Ctx) (entity.Comment, bool, error) {
+	if c.Params("target_name") != "comment" {
+		return entity.Comment{}, false, common.RespError(c, 404, "unknown reaction target name")
+	}
+
+	targetID, _ := c.ParamsInt("target_id")
+	comment := app.Dao().FindComment(uint(targetID))
+	if comment.IsEmpty() {
+		return comment, false, common.RespError(c, 404, i18n.T("{{name}} not found", Map{"name": i18n.T("Comment")}))
+	}
+
+	return comment, true, nil
+}
+
+func getReactionResponse(app *core.App, comment *entity.Comment, ip string) ResponseReaction {
+	myReactions := app.Dao().FindReactionsByIP(ip, []uint{comment.ID})[comment.ID]
+	if myReactions == nil {
+		myReactions = []string{}
+	}
+
+	return ResponseReaction{
+		Types:       getReactionTypes(app, comment.SiteName),
+		Reactions:   lo.If(comment.Reactions != nil, comment.Reactions).Else(map[string]int{}),
+		MyReactions: myReactions,
+	}
+}
+
+// Get the available emoji reactions of the site
+func getReactionTypes(app *core.App, siteName string) []string {
+	if !app.Conf().Comment.Reaction.Enabled {
+		return []string{}
+	}
+
+	if types := utils.SplitAndTrimSpace(app.Dao().FindSite(siteName).ReactionTypes, ","); len(types) > 0 {
+		return types
+	}
+
+	return app.Conf().Comment.Reaction.GetTypes()
+}
+
+// Join the reaction types to be saved in the site
+func joinReactionTypes(types []string) string {
+	return strings.Join(utils.RemoveDuplicates(lo.Map(types, func(t string, _ int) string {
+		return strings.ReplaceAll(strings.TrimSpace(t), ",", "")
+	})), ",")
+}
+
+// Find the reactions made by the IP for each comment
+func findMyReactionsForComments(app *core.App, ip string, comments []entity.CookedComment) []entity.CookedComment {
+	if !app.Conf().Comment.Reaction.Enabled {
+		return comments
+	}
+
+	ids := make([]uint, 0, len(comments))
+	for _, c := range comments {
+		ids = append(ids, c.ID)
+	}
+
+	myReactions := app.Dao().FindReactionsByIP(ip, ids)
+	for i, c := range comments {
+		comments[i].MyReactions = myReactions[c.ID]
+	}
+
+	return comments
+}
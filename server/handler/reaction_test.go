@@ -0,0 +1,100 @@
+package handler_test
+
+import (
+	"bytes"
+	"io"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/artalkjs/artalk/v2/server/handler"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReaction(t *testing.T) {
+	app, fiber := NewApiTestApp()
+	defer app.Cleanup()
+
+	app.Conf().Comment.Reaction.Enabled = true
+	app.Conf().Comment.Reaction.Types = []string{"👍", "🎉"}
+
+	handler.ReactionGet(app.App, fiber)
+	handler.ReactionCreate(app.App, fiber)
+	handler.VoteGet(app.App, fiber)
+
+	request := func(method, target, ip string) (int, string) {
+		req := httptest.NewRequest(method, target, bytes.NewReader([]byte("{}")))
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("X-Forwarded-For", ip) // mock IP
+		resp, err := fiber.Test(req)
+		require.NoError(t, err)
+		body, _ := io.ReadAll(resp.Body)
+		return resp.StatusCode, string(body)
+	}
+
+	thumbsUp := "/reactions/comment/1001/" + url.PathEscape("👍")
+
+	t.Run("Get reactions (original null)", func(t *testing.T) {
+		code, body := request("GET", "/reactions/comment/1001", "127.0.0.1")
+		assert.Equal(t, 200, code)
+		assert.Equal(t, `{"types":["👍","🎉"],"reactions":{},"my_reactions":[]}`, body)
+	})
+
+	t.Run("Unknown target", func(t *testing.T) {
+		code, _ := request("POST", "/reactions/page/1001/"+url.PathEscape("👍"), "127.0.0.1")
+		assert.Equal(t, 404, code)
+	})
+
+	t.Run("Reaction not in the available types", func(t *testing.T) {
+		code, _ := request("POST", "/reactions/comment/1001/"+url.PathEscape("🚀"), "127.0.0.1")
+		assert.Equal(t, 400, code)
+	})
+
+	t.Run("Create reaction", func(t *testing.T) {
+		code, body := request("POST", thumbsUp, "127.0.0.1")
+		assert.Equal(t, 200, code)
+		assert.Equal(t, `{"types":["👍","🎉"],"reactions":{"👍":1},"my_reactions":["👍"]}`, body)
+
+		code, body = request("POST", thumbsUp, "192.168.1.1")
+		assert.Equal(t, 200, code)
+		assert.Equal(t, `{"types":["👍","🎉"],"reactions":{"👍":2},"my_reactions":["👍"]}`, body)
+
+		code, body = request("POST", "/reactions/comment/1001/"+url.PathEscape("🎉"), "127.0.0.1")
+		assert.Equal(t, 200, code)
+		assert.Equal(t, `{"types":["👍","🎉"],"reactions":{"🎉":1,"👍":2},"my_reactions":["👍","🎉"]}`, body)
+
+		comment := app.Dao().FindComment(1001)
+		assert.Equal(t, map[string]int{"👍": 2, "🎉": 1}, comment.Reactions, "counts should be synced to the comment")
+	})
+
+	t.Run("Reactions not affect votes", func(t *testing.T) {
+		code, body := request("GET", "/votes/comment/1001", "127.0.0.1")
+		assert.Equal(t, 200, code)
+		assert.Equal(t, `{"up":0,"down":0,"is_up":false,"is_down":false}`, body)
+	})
+
+	t.Run("Cancel reaction", func(t *testing.T) {
+		code, body := request("POST", thumbsUp, "127.0.0.1")
+		assert.Equal(t, 200, code)
+		assert.Equal(t, `{"types":["👍","🎉"],"reactions":{"🎉":1,"👍":1},"my_reactions":["🎉"]}`, body)
+	})
+
+	t.Run("Site reaction types", func(t *testing.T) {
+		site := app.Dao().FindSite("Site A")
+		site.ReactionTypes = "🚀"
+		require.NoError(t, app.Dao().UpdateSite(&site))
+
+		code, _ := request("POST", "/reactions/comment/1001/"+url.PathEscape("🚀"), "127.0.0.1")
+		assert.Equal(t, 200, code)
+		code, _ = request("POST", thumbsUp, "127.0.0.1")
+		assert.Equal(t, 400, code)
+	})
+
+	t.Run("Disabled", func(t *testing.T) {
+		app.Conf().Comment.Reaction.Enabled = false
+
+		code, _ := request("POST", thumbsUp, "127.0.0.1")
+		assert.Equal(t, 403, code)
+	})
+}
@@ -1,8 +1,10 @@
 package handler
 
 import (
+	"encoding/json"
 	"strings"
 
+	"github.com/artalkjs/artalk/v2/internal/config"
 	"github.com/artalkjs/artalk/v2/internal/core"
 	"github.com/artalkjs/artalk/v2/internal/entity"
 	"github.com/artalkjs/artalk/v2/internal/i18n"
@@ -14,10 +16,14 @@ import (
 type ParamsSiteCreate struct {
 	Name string   `json:"name" validate:"required"` // The site name
 	Urls []string `json:"urls" validate:"required"` // The site urls
+
+	ReactionTypes []string `json:"reaction_types" validate:"optional"` // The available emoji reactions (empty means using the default of config)
+
+	ConfigOverlay map[string]any `json:"config_overlay" validate:"optional"` // The site config overlay merged over the global config (only moderator, captcha, email.mail_tpl, email.mail_subject, email.send_name, admin_notify, img_upload.enabled and img_upload.max_size)
 }
 
 type ResponseSiteCreate struct {
-	entity.CookedSite
+	entity.CookedSiteForAdmin
 }
 
 // @Id           CreateSite
@@ -51,17 +57,44 @@ func SiteCreate(app *core.App, router fiber.Router) {
 		if !app.Dao().FindSite(p.Name).IsEmpty() {
 			return common.RespError(c, 400, i18n.T("{{name}} already exists", Map{"name": i18n.T("Site")}))
 		}
+		if !app.Dao().FindTrashedSiteByName(p.Name).IsEmpty() {
+			return common.RespError(c, 400, i18n.T("The site name is occupied by a site in the trash"))
+		}
+
+		configOverlay, err := encodeSiteConfigOverlay(p.ConfigOverlay)
+		if err != nil {
+			return common.RespError(c, 400, i18n.T("Invalid {{name}}", Map{"name": "config_overlay"}), Map{"detail": err.Error()})
+		}
 
 		site := entity.Site{}
 		site.Name = p.Name
 		site.Urls = strings.Join(p.Urls, ",")
-		err := app.Dao().CreateSite(&site)
-		if err != nil {
+		site.ReactionTypes = joinReactionTypes(p.ReactionTypes)
+		site.ConfigOverlay = configOverlay
+		if err := app.Dao().CreateSite(&site); err != nil {
 			return common.RespError(c, 500, i18n.T("{{name}} creation failed", Map{"name": i18n.T("Site")}))
 		}
 
 		return common.RespData(c, ResponseSiteCreate{
-			CookedSite: app.Dao().CookSite(&site),
+			CookedSiteForAdmin: app.Dao().SiteToCookedForAdmin(&site),
 		})
 	}))
 }
+
+// Encode the site config overlay to be saved (empty means no overlay)
+func encodeSiteConfigOverlay(overlay map[string]any) (string, error) {
+	if len(overlay) == 0 {
+		return "", nil
+	}
+	if err := config.ValidateOverlay(overlay); err != nil {
+		return "", err
+	}
+	raw, err := json.Marshal(overlay)
+	if err != nil {
+		return "", err
+	}
+	if _, err := (&config.Config{}).MergeOverlay(string(raw)); err != nil {
+		return "", err // check the overlay can be decoded into the config
+	}
+	return string(raw), nil
+}
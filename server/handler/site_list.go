@@ -1,15 +1,18 @@
 package handler
 
 import (
+	"slices"
+
 	"github.com/artalkjs/artalk/v2/internal/core"
 	"github.com/artalkjs/artalk/v2/internal/entity"
 	"github.com/artalkjs/artalk/v2/server/common"
 	"github.com/gofiber/fiber/v2"
+	"github.com/samber/lo"
 )
 
 type ResponseSiteList struct {
-	Sites []entity.CookedSite `json:"sites"`
-	Count int                 `json:"count"`
+	Sites []entity.CookedSiteForAdmin `json:"sites"`
+	Count int                         `json:"count"`
 }
 
 // @Id           GetSites
@@ -21,12 +24,29 @@ type ResponseSiteList struct {
 // @Success      200  {object}  ResponseSiteList
 // @Router       /sites  [get]
 func SiteList(app *core.App, router fiber.Router) {
-	router.Get("/sites", common.AdminGuard(app, func(c *fiber.Ctx) error {
-		sites := app.Dao().FindAllSitesCooked()
+	router.Get("/sites", common.SiteRoleGuard(app, func(c *fiber.Ctx) error {
+		sites := app.Dao().FindAllSites()
+
+		// The site admins and moderators can only list their sites
+		// (the config overlay is hidden, which may contain the secrets of the notification services)
+		siteNames, isAll := common.GetRoleSiteNamesReq(app, c, entity.SiteRoleModerator)
+		if !isAll {
+			sites = lo.Filter(sites, func(s entity.Site, _ int) bool {
+				return slices.Contains(siteNames, s.Name)
+			})
+		}
+
+		cookedSites := lo.Map(sites, func(s entity.Site, _ int) entity.CookedSiteForAdmin {
+			cooked := app.Dao().SiteToCookedForAdmin(&s)
+			if !isAll {
+				cooked.ConfigOverlay = nil
+			}
+			return cooked
+		})
 
 		return common.RespData(c, ResponseSiteList{
-			Sites: sites,
-			Count: len(sites),
+			Sites: cookedSites,
+			Count: len(cookedSites),
 		})
 	}))
 }
@@ -15,10 +15,14 @@ import (
 type ParamsSiteUpdate struct {
 	Name string   `json:"name" validate:"required"` // Updated site name
 	Urls []string `json:"urls" validate:"required"` // Updated site urls
+
+	ReactionTypes []string `json:"reaction_types" validate:"optional"` // Updated available emoji reactions (not changed if omitted, empty means using the default of config)
+
+	ConfigOverlay map[string]any `json:"config_overlay" validate:"optional"` // Updated site config overlay (not changed if omitted, empty means no overlay)
 }
 
 type ResponseSiteUpdate struct {
-	entity.CookedSite
+	entity.CookedSiteForAdmin
 }
 
 // @Id           UpdateSite
@@ -31,6 +35,7 @@ type ResponseSiteUpdate struct {
 // @Accept       json
 // @Produce      json
 // @Success      200  {object}  ResponseSiteUpdate
+// @Failure      400  {object}  Map{msg=string}
 // @Router       /sites/{id}  [put]
 func SiteUpdate(app *core.App, router fiber.Router) {
 	router.Put("/sites/:id", common.AdminGuard(app, func(c *fiber.Ctx) error {
@@ -46,11 +51,20 @@ func SiteUpdate(app *core.App, router fiber.Router) {
 			return common.RespError(c, 404, i18n.T("{{name}} not found", Map{"name": i18n.T("Site")}))
 		}
 
+		// config overlay 合法性检测
+		configOverlay, err := encodeSiteConfigOverlay(p.ConfigOverlay)
+		if err != nil {
+			return common.RespError(c, 400, i18n.T("Invalid {{name}}", Map{"name": "config_overlay"}), Map{"detail": err.Error()})
+		}
+
 		// 重命名合法性检测
 		modifyName := p.Name != site.Name
 		if modifyName && !app.Dao().FindSite(p.Name).IsEmpty() {
 			return common.RespError(c, 400, i18n.T("{{name}} already exists", Map{"name": i18n.T("Site")}))
 		}
+		if modifyName && !app.Dao().FindTrashedSiteByName(p.Name).IsEmpty() {
+			return common.RespError(c, 400, i18n.T("The site name is occupied by a site in the trash"))
+		}
 
 		// urls 合法性检测
 		if len(p.Urls) > 0 {
@@ -82,19 +96,34 @@ func SiteUpdate(app *core.App, router fiber.Router) {
 				page.SiteName = p.Name
 				app.Dao().UpdatePage(&page)
 			}
+
+			// the comments and pages in the trash
+			app.Dao().DB().Unscoped().Model(&entity.Comment{}).
+				Where("site_name = ? AND deleted_at IS NOT NULL", site.Name).Update("site_name", p.Name)
+			app.Dao().DB().Unscoped().Model(&entity.Page{}).
+				Where("site_name = ? AND deleted_at IS NOT NULL", site.Name).Update("site_name", p.Name)
+
+			// the roles of the users on the site
+			app.Dao().DB().Model(&entity.UserSiteRole{}).
+				Where("site_name = ?", site.Name).Update("site_name", p.Name)
 		}
 
 		// 修改 site
 		site.Name = p.Name
 		site.Urls = strings.Join(p.Urls, ",")
+		if p.ReactionTypes != nil {
+			site.ReactionTypes = joinReactionTypes(p.ReactionTypes)
+		}
+		if p.ConfigOverlay != nil {
+			site.ConfigOverlay = configOverlay
+		}
 
-		err := app.Dao().UpdateSite(&site)
-		if err != nil {
+		if err := app.Dao().UpdateSite(&site); err != nil {
 			return common.RespError(c, 500, i18n.T("{{name}} save failed", Map{"name": i18n.T("Site")}))
 		}
 
 		return common.RespData(c, ResponseSiteUpdate{
-			CookedSite: app.Dao().CookSite(&site),
+			CookedSiteForAdmin: app.Dao().SiteToCookedForAdmin(&site),
 		})
 	}))
 }
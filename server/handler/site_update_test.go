@@ -0,0 +1,102 @@
+package handler_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/artalkjs/artalk/v2/server/handler"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSiteConfigOverlay(t *testing.T) {
+	app, fiber := NewApiTestApp()
+	defer app.Cleanup()
+
+	app.Conf().ImgUpload.Enabled = false
+
+	handler.SiteUpdate(app.App, fiber)
+	handler.Conf(app.App, fiber)
+
+	token := getUserToken(t, app, 1000)
+	request := func(method, target string, data map[string]any) (int, []byte) {
+		body, _ := json.Marshal(data)
+		req := httptest.NewRequest(method, target, bytes.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("Authorization", "Bearer "+token)
+		resp, err := fiber.Test(req)
+		require.NoError(t, err)
+		respBody, _ := io.ReadAll(resp.Body)
+		return resp.StatusCode, respBody
+	}
+
+	updateSite := func(overlay map[string]any) (int, []byte) {
+		data := map[string]any{"name": "Site A", "urls": []string{"https://example.org"}}
+		if overlay != nil {
+			data["config_overlay"] = overlay
+		}
+		return request("PUT", "/sites/1000", data)
+	}
+
+	t.Run("Not allowed config", func(t *testing.T) {
+		code, _ := updateSite(map[string]any{"app_key": "123"})
+		assert.Equal(t, 400, code)
+
+		code, _ = updateSite(map[string]any{"email": map[string]any{"smtp": map[string]any{"host": "example.org"}}})
+		assert.Equal(t, 400, code)
+
+		code, _ = updateSite(map[string]any{"moderator": map[string]any{"pending_default": "not a bool"}})
+		assert.Equal(t, 400, code)
+	})
+
+	t.Run("Update overlay", func(t *testing.T) {
+		code, body := updateSite(map[string]any{
+			"moderator":  map[string]any{"pending_default": true},
+			"img_upload": map[string]any{"enabled": true},
+		})
+		require.Equal(t, 200, code, string(body))
+
+		var result handler.ResponseSiteUpdate
+		require.NoError(t, json.Unmarshal(body, &result))
+		assert.Equal(t, map[string]any{"pending_default": true}, result.ConfigOverlay["moderator"])
+
+		assert.True(t, app.SiteConf("Site A").Moderator.PendingDefault)
+		assert.False(t, app.SiteConf("Site B").Moderator.PendingDefault, "the other sites are not affected")
+		assert.False(t, app.Conf().Moderator.PendingDefault, "the global config is not modified")
+	})
+
+	t.Run("Overlay applied to request", func(t *testing.T) {
+		getImgUpload := func(siteName string) bool {
+			code, body := request("GET", "/conf?site_name="+siteName, nil)
+			require.Equal(t, 200, code)
+
+			var result struct {
+				FrontendConf struct {
+					ImgUpload bool `json:"imgUpload"`
+				} `json:"frontend_conf"`
+			}
+			require.NoError(t, json.Unmarshal(body, &result))
+			return result.FrontendConf.ImgUpload
+		}
+
+		token = "" // not admin (admin is always allowed to upload)
+		assert.True(t, getImgUpload("Site+A"))
+		assert.False(t, getImgUpload("Site+B"))
+	})
+
+	t.Run("Keep overlay when omitted", func(t *testing.T) {
+		token = getUserToken(t, app, 1000)
+		code, _ := updateSite(nil)
+		require.Equal(t, 200, code)
+		assert.True(t, app.SiteConf("Site A").Moderator.PendingDefault)
+	})
+
+	t.Run("Clear overlay", func(t *testing.T) {
+		code, _ := updateSite(map[string]any{})
+		require.Equal(t, 200, code)
+		assert.False(t, app.SiteConf("Site A").Moderator.PendingDefault)
+	})
+}
@@ -58,9 +58,14 @@ func Stat(app *core.App, router fiber.Router) {
 		QueryPages := func(d *gorm.DB) *gorm.DB {
 			return d.Model(&entity.Page{}).Where(&entity.Page{SiteName: p.SiteName})
 		}
-		// Query Comments by `site_name` and `is_pending=false`
+		// Query Comments by `site_name` and `is_pending=false` (the shadow-banned users are excluded)
+		bannedUserIDs := app.Dao().GetShadowBannedUserIDs()
 		QueryComments := func(d *gorm.DB) *gorm.DB {
-			return d.Model(&entity.Comment{}).Where(&entity.Comment{SiteName: p.SiteName, IsPending: false})
+			d = d.Model(&entity.Comment{}).Where(&entity.Comment{SiteName: p.SiteName, IsPending: false})
+			if len(bannedUserIDs) > 0 {
+				d = d.Where("user_id NOT IN ?", bannedUserIDs)
+			}
+			return d
 		}
 		// Query Order by RAND()
 		QueryOrderRand := func(d *gorm.DB) *gorm.DB {
@@ -121,11 +126,21 @@ func Stat(app *core.App, router fiber.Router) {
 			tbPages := app.Dao().GetTableName(&entity.Page{})
 			tbComments := app.Dao().GetTableName(&entity.Comment{})
 
+			// The comments of the shadow-banned users are not counted
+			bannedCond := ""
+			args := []any{p.SiteName, false}
+			if len(bannedUserIDs) > 0 {
+				bannedCond = " AND c.user_id NOT IN ?"
+				args = append(args, bannedUserIDs)
+			}
+			args = append(args, p.Limit)
+
 			var pages []entity.Page
 			app.Dao().DB().Raw(
-				"SELECT * FROM "+tbPages+" p WHERE p.site_name = ? ORDER BY ("+
-					"SELECT COUNT(*) FROM "+tbComments+" c WHERE c.page_key = p.key AND c.is_pending = ?) DESC LIMIT ?",
-				p.SiteName, false, p.Limit,
+				"SELECT * FROM "+tbPages+" p WHERE p.site_name = ? AND p.deleted_at IS NULL ORDER BY ("+
+					"SELECT COUNT(*) FROM "+tbComments+" c WHERE c.page_key = p.key AND c.site_name = p.site_name"+
+					" AND c.is_pending = ? AND c.deleted_at IS NULL"+bannedCond+") DESC LIMIT ?",
+				args...,
 			).Find(&pages)
 
 			return common.RespData(c, ResponseStat{
@@ -13,6 +13,7 @@ import (
 	"github.com/artalkjs/artalk/v2/internal/entity"
 	"github.com/artalkjs/artalk/v2/server/handler"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestStat(t *testing.T) {
@@ -226,3 +227,50 @@ func TestStat(t *testing.T) {
 		})
 	}
 }
+
+func TestStatCommentMostPages(t *testing.T) {
+	app, fiber := NewApiTestApp()
+	defer app.Cleanup()
+
+	handler.Stat(app.App, fiber)
+
+	pageA := entity.Page{Key: "/most/a.html", SiteName: "Site C"}
+	pageB := entity.Page{Key: "/most/b.html", SiteName: "Site C"}
+	pageDeleted := entity.Page{Key: "/most/deleted.html", SiteName: "Site C"}
+	for _, page := range []*entity.Page{&pageA, &pageB, &pageDeleted} {
+		require.NoError(t, app.Dao().DB().Create(page).Error)
+	}
+	require.NoError(t, app.Dao().DB().Delete(&pageDeleted).Error)
+
+	addComments := func(pageKey, siteName string, n int, deleted bool) {
+		for i := 0; i < n; i++ {
+			comment := entity.Comment{PageKey: pageKey, SiteName: siteName, Content: "test"}
+			require.NoError(t, app.Dao().DB().Create(&comment).Error)
+			if deleted {
+				require.NoError(t, app.Dao().DB().Delete(&comment).Error)
+			}
+		}
+	}
+	addComments(pageA.Key, "Site C", 2, false)
+	addComments(pageB.Key, "Site C", 1, false)
+	addComments(pageB.Key, "Site C", 3, true)  // deleted comments
+	addComments(pageB.Key, "Site D", 3, false) // same page key on another site
+	addComments(pageDeleted.Key, "Site C", 5, false)
+
+	req := httptest.NewRequest("GET", "/stats/comment_most_pages?site_name=Site%20C&limit=10", nil)
+	resp, err := fiber.Test(req)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var result struct {
+		Data []entity.CookedPage `json:"data"`
+	}
+	body, _ := io.ReadAll(resp.Body)
+	require.NoError(t, json.Unmarshal(body, &result))
+
+	keys := []string{}
+	for _, page := range result.Data {
+		keys = append(keys, page.Key)
+	}
+	assert.Equal(t, []string{pageA.Key, pageB.Key}, keys)
+}
@@ -0,0 +1,267 @@
+package handler
+
+import (
+	"errors"
+
+	"github.com/artalkjs/artalk/v2/internal/core"
+	"github.com/artalkjs/artalk/v2/internal/dao"
+	"github.com/artalkjs/artalk/v2/internal/entity"
+	"github.com/artalkjs/artalk/v2/internal/i18n"
+	"github.com/artalkjs/artalk/v2/server/common"
+	"github.com/gofiber/fiber/v2"
+)
+
+type TrashType string
+
+const (
+	TrashTypeComments TrashType = "comments"
+	TrashTypePages    TrashType = "pages"
+	TrashTypeSites    TrashType = "sites"
+	TrashTypeUsers    TrashType = "users"
+)
+
+func (t TrashType) Model() any {
+	switch t {
+	case TrashTypeComments:
+		return &entity.Comment{}
+	case TrashTypePages:
+		return &entity.Page{}
+	case TrashTypeSites:
+		return &entity.Site{}
+	case TrashTypeUsers:
+		return &entity.User{}
+	}
+	return nil
+}
+
+func (t TrashType) Label() string {
+	switch t {
+	case TrashTypeComments:
+		return i18n.T("Comment")
+	case TrashTypePages:
+		return i18n.T("Page")
+	case TrashTypeSites:
+		return i18n.T("Site")
+	case TrashTypeUsers:
+		return i18n.T("User")
+	}
+	return ""
+}
+
+func getTrashType(c *fiber.Ctx) (TrashType, bool, error) {
+	t := TrashType(c.Params("type"))
+	if t.Model() == nil {
+		return t, false, common.RespError(c, 404, i18n.T("Invalid {{name}}", Map{"name": i18n.T("Type")}))
+	}
+	return t, true, nil
+}
+
+type ParamsTrashList struct {
+	SiteName string `query:"site_name" json:"site_name" validate:"optional"` // Filter by site name (for comments and pages)
+	Limit    int    `query:"limit" json:"limit" validate:"optional"`         // The limit for pagination
+	Offset   int    `query:"offset" json:"offset" validate:"optional"`       // The offset for pagination
+}
+
+type ResponseTrashList struct {
+	Count    int64                       `json:"count"`
+	Comments []entity.CookedComment      `json:"comments,omitempty"`
+	Pages    []entity.CookedPage         `json:"pages,omitempty"`
+	Sites    []entity.CookedSite         `json:"sites,omitempty"`
+	Users    []entity.CookedUserForAdmin `json:"users,omitempty"`
+}
+
+// @Id           GetTrash
+// @Summary      Get Trash
+// @Description  Get the deleted items in the trash (latest deleted first)
+// @Tags         Trash
+// @Param        type     path   string           true  "The type of the items"  Enums(comments, pages, sites, users)
+// @Param        options  query  ParamsTrashList  true  "The options"
+// @Security     ApiKeyAuth
+// @Produce      json
+// @Success      200  {object}  ResponseTrashList
+// @Failure      403  {object}  Map{msg=string}
+// @Failure      404  {object}  Map{msg=string}
+// @Router       /trash/{type}  [get]
+func TrashList(app *core.App, router fiber.Router) {
+	router.Get("/trash/:type", common.AdminGuard(app, func(c *fiber.Ctx) error {
+		trashType, ok, resp := getTrashType(c)
+		if !ok {
+			return resp
+		}
+
+		var p ParamsTrashList
+		if isOK, resp := common.ParamsDecode(c, &p); !isOK {
+			return resp
+		}
+
+		q := app.Dao().DB().Unscoped().Model(trashType.Model()).
+			Where("deleted_at IS NOT NULL").
+			Order("deleted_at DESC, id DESC")
+		if p.SiteName != "" && (trashType == TrashTypeComments || trashType == TrashTypePages) {
+			q = q.Where("site_name = ?", p.SiteName)
+		}
+
+		var total int64
+		q.Count(&total)
+
+		q = q.Scopes(Paginate(p.Offset, p.Limit))
+
+		result := ResponseTrashList{Count: total}
+		switch trashType {
+		case TrashTypeComments:
+			var comments []entity.Comment
+			q.Find(&comments)
+			result.Comments = []entity.CookedComment{}
+			for _, c := range comments {
+				result.Comments = append(result.Comments, app.Dao().CookComment(&c))
+			}
+		case TrashTypePages:
+			var pages []entity.Page
+			q.Find(&pages)
+			result.Pages = app.Dao().CookAllPages(pages)
+		case TrashTypeSites:
+			var sites []entity.Site
+			q.Find(&sites)
+			result.Sites = []entity.CookedSite{}
+			for _, s := range sites {
+				result.Sites = append(result.Sites, app.Dao().CookSite(&s))
+			}
+		case TrashTypeUsers:
+			var users []entity.User
+			q.Find(&users)
+			result.Users = []entity.CookedUserForAdmin{}
+			for _, u := range users {
+				result.Users = append(result.Users, app.Dao().UserToCookedForAdmin(&u))
+			}
+		}
+
+		return common.RespData(c, result)
+	}))
+}
+
+// @Id           RestoreTrash
+// @Summary      Restore from Trash
+// @Description  Restore a deleted item, and the related items which are deleted together with it
+// @Tags         Trash
+// @Param        type  path  string  true  "The type of the item"  Enums(comments, pages, sites, users)
+// @Param        id    path  int     true  "The item ID"
+// @Security     ApiKeyAuth
+// @Produce      json
+// @Success      200  {object}  Map{}
+// @Failure      400  {object}  Map{msg=string}
+// @Failure      403  {object}  Map{msg=string}
+// @Failure      404  {object}  Map{msg=string}
+// @Failure      409  {object}  Map{msg=string}
+// @Failure      500  {object}  Map{msg=string}
+// @Router       /trash/{type}/{id}/restore  [post]
+func TrashRestore(app *core.App, router fiber.Router) {
+	router.Post("/trash/:type/:id/restore", common.AdminGuard(app, func(c *fiber.Ctx) error {
+		trashType, ok, resp := getTrashType(c)
+		if !ok {
+			return resp
+		}
+
+		id, _ := c.ParamsInt("id")
+
+		var err error
+		switch trashType {
+		case TrashTypeComments:
+			comment := app.Dao().FindTrashedComment(uint(id))
+			if comment.IsEmpty() {
+				return respTrashItemNotFound(c, trashType)
+			}
+			err = app.Dao().RestoreComment(&comment)
+		case TrashTypePages:
+			page := app.Dao().FindTrashedPage(uint(id))
+			if page.IsEmpty() {
+				return respTrashItemNotFound(c, trashType)
+			}
+			err = app.Dao().RestorePage(&page)
+		case TrashTypeSites:
+			site := app.Dao().FindTrashedSite(uint(id))
+			if site.IsEmpty() {
+				return respTrashItemNotFound(c, trashType)
+			}
+			err = app.Dao().RestoreSite(&site)
+		case TrashTypeUsers:
+			user := app.Dao().FindTrashedUser(uint(id))
+			if user.IsEmpty() {
+				return respTrashItemNotFound(c, trashType)
+			}
+			err = app.Dao().RestoreUser(&user)
+		}
+
+		switch {
+		case errors.Is(err, dao.ErrRestoreParentMissing):
+			return common.RespError(c, 400, i18n.T("The parent item is missing or in the trash, please restore it first"))
+		case errors.Is(err, dao.ErrRestoreConflict):
+			return common.RespError(c, 409, i18n.T("{{name}} already exists", Map{"name": trashType.Label()}))
+		case err != nil:
+			return common.RespError(c, 500, i18n.T("Restore failed"))
+		}
+
+		return common.RespSuccess(c)
+	}))
+}
+
+// @Id           PurgeTrash
+// @Summary      Purge from Trash
+// @Description  Permanently delete an item in the trash, and the related items which are deleted together with it
+// @Tags         Trash
+// @Param        type  path  string  true  "The type of the item"  Enums(comments, pages, sites, users)
+// @Param        id    path  int     true  "The item ID"
+// @Security     ApiKeyAuth
+// @Produce      json
+// @Success      200  {object}  Map{}
+// @Failure      403  {object}  Map{msg=string}
+// @Failure      404  {object}  Map{msg=string}
+// @Failure      500  {object}  Map{msg=string}
+// @Router       /trash/{type}/{id}  [delete]
+func TrashPurge(app *core.App, router fiber.Router) {
+	router.Delete("/trash/:type/:id", common.AdminGuard(app, func(c *fiber.Ctx) error {
+		trashType, ok, resp := getTrashType(c)
+		if !ok {
+			return resp
+		}
+
+		id, _ := c.ParamsInt("id")
+
+		var err error
+		switch trashType {
+		case TrashTypeComments:
+			comment := app.Dao().FindTrashedComment(uint(id))
+			if comment.IsEmpty() {
+				return respTrashItemNotFound(c, trashType)
+			}
+			err = app.Dao().PurgeComment(&comment)
+		case TrashTypePages:
+			page := app.Dao().FindTrashedPage(uint(id))
+			if page.IsEmpty() {
+				return respTrashItemNotFound(c, trashType)
+			}
+			err = app.Dao().PurgePage(&page)
+		case TrashTypeSites:
+			site := app.Dao().FindTrashedSite(uint(id))
+			if site.IsEmpty() {
+				return respTrashItemNotFound(c, trashType)
+			}
+			err = app.Dao().PurgeSite(&site)
+		case TrashTypeUsers:
+			user := app.Dao().FindTrashedUser(uint(id))
+			if user.IsEmpty() {
+				return respTrashItemNotFound(c, trashType)
+			}
+			err = app.Dao().PurgeUser(&user)
+		}
+
+		if err != nil {
+			return common.RespError(c, 500, i18n.T("{{name}} deletion failed", Map{"name": trashType.Label()}))
+		}
+
+		return common.RespSuccess(c)
+	}))
+}
+
+func respTrashItemNotFound(c *fiber.Ctx, trashType TrashType) error {
+	return common.RespError(c, 404, i18n.T("{{name}} not found", Map{"name": trashType.Label()}))
+}
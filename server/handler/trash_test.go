@@ -0,0 +1,83 @@
+package handler_test
+
+import (
+	"encoding/json"
+	"io"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/artalkjs/artalk/v2/server/handler"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTrash(t *testing.T) {
+	app, fiber := NewApiTestApp()
+	defer app.Cleanup()
+
+	handler.TrashList(app.App, fiber)
+	handler.TrashRestore(app.App, fiber)
+	handler.TrashPurge(app.App, fiber)
+
+	token := getUserToken(t, app, 1000)
+	request := func(method, target string) (int, []byte) {
+		req := httptest.NewRequest(method, target, nil)
+		req.Header.Set("Authorization", "Bearer "+token)
+		resp, err := fiber.Test(req)
+		require.NoError(t, err)
+		body, _ := io.ReadAll(resp.Body)
+		return resp.StatusCode, body
+	}
+
+	comment := app.Dao().FindComment(1001)
+	require.NoError(t, app.Dao().DelComment(&comment))
+	page := app.Dao().FindPageByID(1001)
+	require.NoError(t, app.Dao().DelPage(&page))
+
+	t.Run("Invalid type", func(t *testing.T) {
+		code, _ := request("GET", "/trash/votes")
+		assert.Equal(t, 404, code)
+	})
+
+	t.Run("List", func(t *testing.T) {
+		code, body := request("GET", "/trash/comments?site_name=Site+A")
+		require.Equal(t, 200, code)
+
+		var result handler.ResponseTrashList
+		require.NoError(t, json.Unmarshal(body, &result))
+		assert.Equal(t, int64(4), result.Count) // 1001 with the replies 1002, 1003, 1004
+		for _, c := range result.Comments {
+			assert.NotEmpty(t, c.DeletedAt)
+		}
+	})
+
+	t.Run("Restore not trashed item", func(t *testing.T) {
+		code, _ := request("POST", "/trash/comments/1000/restore")
+		assert.Equal(t, 404, code)
+	})
+
+	t.Run("Restore with parent in the trash", func(t *testing.T) {
+		code, _ := request("POST", "/trash/comments/1002/restore")
+		assert.Equal(t, 400, code)
+	})
+
+	t.Run("Restore", func(t *testing.T) {
+		code, _ := request("POST", "/trash/comments/1001/restore")
+		assert.Equal(t, 200, code)
+		assert.False(t, app.Dao().FindComment(1004).IsEmpty())
+	})
+
+	t.Run("Restore conflict", func(t *testing.T) {
+		app.Dao().FindCreatePage(page.Key, page.Title, page.SiteName)
+
+		code, _ := request("POST", "/trash/pages/1001/restore")
+		assert.Equal(t, 409, code)
+	})
+
+	t.Run("Purge", func(t *testing.T) {
+		code, _ := request("DELETE", "/trash/pages/1001")
+		assert.Equal(t, 200, code)
+		assert.True(t, app.Dao().FindTrashedPage(1001).IsEmpty())
+		assert.True(t, app.Dao().FindTrashedComment(1007).IsEmpty())
+	})
+}
@@ -34,6 +34,7 @@ type ResponseUpload struct {
 // @Description  Upload file from this endpoint
 // @Tags         Upload
 // @Param        file           formData  file    true   "Upload file"
+// @Param        site_name      query     string  false  "The site name (to apply the site config overlay)"
 // @Security     ApiKeyAuth
 // @Accept       mpfd
 // @Produce      json
@@ -44,8 +45,15 @@ type ResponseUpload struct {
 // @Router       /upload  [post]
 func Upload(app *core.App, router fiber.Router) {
 	router.Post("/upload", common.LimiterGuard(app, func(c *fiber.Ctx) error {
+		if ok, resp := common.CheckBlocklistReq(app, c, ""); !ok {
+			return resp
+		}
+
+		// 图片上传配置 (可被站点配置覆盖)
+		conf := common.GetSiteConf(app, c).ImgUpload
+
 		// 功能开关 (管理员始终开启)
-		if !app.Conf().ImgUpload.Enabled && !common.CheckIsAdminReq(app, c) {
+		if !conf.Enabled && !common.CheckIsAdminReq(app, c) {
 			return common.RespError(c, 403, i18n.T("Image upload forbidden"), common.Map{
 				"img_upload_enabled": false,
 			})
@@ -63,10 +71,10 @@ func Upload(app *core.App, router fiber.Router) {
 		// ua := c.Request().UserAgent()
 
 		// 图片大小限制 (Based on content length)
-		if app.Conf().ImgUpload.MaxSize != 0 {
-			if int64(c.Request().Header.ContentLength()) > app.Conf().ImgUpload.MaxSize*1024*1024 {
+		if conf.MaxSize != 0 {
+			if int64(c.Request().Header.ContentLength()) > conf.MaxSize*1024*1024 {
 				return common.RespError(c, 400, i18n.T("Image exceeds {{file_size}} limit", Map{
-					"file_size": fmt.Sprintf("%dMB", app.Conf().ImgUpload.MaxSize),
+					"file_size": fmt.Sprintf("%dMB", conf.MaxSize),
 				}))
 			}
 		}
@@ -94,10 +102,10 @@ func Upload(app *core.App, router fiber.Router) {
 		}
 
 		// 大小限制 (Based on content read)
-		if app.Conf().ImgUpload.MaxSize != 0 {
-			if int64(len(buf)) > app.Conf().ImgUpload.MaxSize*1024*1024 {
+		if conf.MaxSize != 0 {
+			if int64(len(buf)) > conf.MaxSize*1024*1024 {
 				return common.RespError(c, 400, i18n.T("Image exceeds {{file_size}} limit", Map{
-					"file_size": fmt.Sprintf("%dMB", app.Conf().ImgUpload.MaxSize),
+					"file_size": fmt.Sprintf("%dMB", conf.MaxSize),
 				}))
 			}
 		}
@@ -130,12 +138,12 @@ func Upload(app *core.App, router fiber.Router) {
 		filename := t.Format("20060102-150405.000") + mineToExts[fileMine]
 
 		// 创建图片目标文件
-		if err := utils.EnsureDir(app.Conf().ImgUpload.Path); err != nil {
+		if err := utils.EnsureDir(conf.Path); err != nil {
 			log.Error(err)
 			return common.RespError(c, 500, "Folder creation failed")
 		}
 
-		fileFullPath := strings.TrimSuffix(app.Conf().ImgUpload.Path, "/") + "/" + filename
+		fileFullPath := strings.TrimSuffix(conf.Path, "/") + "/" + filename
 		dst, err := os.Create(fileFullPath)
 		if err != nil {
 			log.Error(err)
@@ -150,7 +158,7 @@ func Upload(app *core.App, router fiber.Router) {
 		}
 
 		// 生成外部可访问链接
-		baseURL := app.Conf().ImgUpload.PublicPath
+		baseURL := conf.PublicPath
 		if baseURL == "" {
 			baseURL = config.IMG_UPLOAD_PUBLIC_PATH
 		}
@@ -165,8 +173,8 @@ func Upload(app *core.App, router fiber.Router) {
 		}
 
 		// 使用 upgit
-		if app.Conf().ImgUpload.Upgit.Enabled {
-			upgitURL := execUpgitUpload(app.Conf().ImgUpload.Upgit.Exec, fileFullPath)
+		if conf.Upgit.Enabled {
+			upgitURL := execUpgitUpload(conf.Upgit.Exec, fileFullPath)
 			if upgitURL == "" || !utils.ValidateURL(upgitURL) {
 				// 上传失败，删除源图片文件
 				var err = os.Remove(fileFullPath)
@@ -179,7 +187,7 @@ func Upload(app *core.App, router fiber.Router) {
 			}
 
 			// 上传成功，删除本地文件
-			if app.Conf().ImgUpload.Upgit.DelLocal {
+			if conf.Upgit.DelLocal {
 				var err = os.Remove(fileFullPath)
 				if err != nil {
 					log.Error(err)
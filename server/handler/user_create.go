@@ -1,6 +1,8 @@
 package handler
 
 import (
+	"fmt"
+
 	"github.com/artalkjs/artalk/v2/internal/core"
 	"github.com/artalkjs/artalk/v2/internal/entity"
 	"github.com/artalkjs/artalk/v2/internal/i18n"
@@ -19,6 +21,10 @@ type ParamsUserCreate struct {
 	ReceiveEmail bool   `json:"receive_email" validate:"required"` // The user receive email
 	BadgeName    string `json:"badge_name" validate:"optional"`    // The user badge name
 	BadgeColor   string `json:"badge_color" validate:"optional"`   // The user badge color (hex format)
+
+	IsShadowBanned bool `json:"is_shadow_banned" validate:"optional"` // The user is shadow-banned (the comments are only visible to the user and the admins)
+
+	SiteRoles map[string]entity.SiteRole `json:"site_roles" validate:"optional"` // The roles on the sites (site name => `admin` or `moderator`)
 }
 
 type ResponseUserCreate struct {
@@ -55,6 +61,9 @@ func UserCreate(app *core.App, router fiber.Router) {
 		if p.Link != "" && !utils.ValidateURL(p.Link) {
 			return common.RespError(c, 400, i18n.T("Invalid {{name}}", Map{"name": i18n.T("Link")}))
 		}
+		if err := validateUserSiteRoles(app, p.SiteRoles); err != nil {
+			return common.RespError(c, 400, i18n.T("Invalid {{name}}", Map{"name": "site_roles"}), Map{"detail": err.Error()})
+		}
 
 		user := entity.User{}
 		user.Name = p.Name
@@ -64,6 +73,7 @@ func UserCreate(app *core.App, router fiber.Router) {
 		user.ReceiveEmail = p.ReceiveEmail
 		user.BadgeName = p.BadgeName
 		user.BadgeColor = p.BadgeColor
+		user.IsShadowBanned = p.IsShadowBanned
 
 		if p.Password != "" {
 			err := user.SetPasswordEncrypt(p.Password)
@@ -78,8 +88,27 @@ func UserCreate(app *core.App, router fiber.Router) {
 			return common.RespError(c, 500, i18n.T("{{name}} creation failed", Map{"name": i18n.T("User")}))
 		}
 
+		if len(p.SiteRoles) > 0 {
+			if err := app.Dao().SetUserSiteRoles(user.ID, p.SiteRoles); err != nil {
+				return common.RespError(c, 500, i18n.T("{{name}} save failed", Map{"name": i18n.T("User")}))
+			}
+		}
+
 		return common.RespData(c, ResponseUserCreate{
 			CookedUserForAdmin: app.Dao().UserToCookedForAdmin(&user),
 		})
 	}))
 }
+
+// Check the sites exist and the roles are valid
+func validateUserSiteRoles(app *core.App, roles map[string]entity.SiteRole) error {
+	for siteName, role := range roles {
+		if app.Dao().FindSite(siteName).IsEmpty() {
+			return fmt.Errorf("site `%s` not found", siteName)
+		}
+		if !role.IsValid() {
+			return fmt.Errorf("role `%s` is invalid", role)
+		}
+	}
+	return nil
+}
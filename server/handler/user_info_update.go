@@ -16,6 +16,8 @@ type RequestUserInfoUpdate struct {
 	Name  string `json:"name" validate:"required"`
 	Link  string `json:"link" validate:"optional"`
 	Code  string `json:"code" validate:"optional"`
+
+	EmailDigest *entity.EmailDigestFrequency `json:"email_digest" enums:"immediate,daily,weekly" validate:"optional"` // The frequency of the notification emails (the config default is used if empty), unchanged if omitted
 }
 
 type ResponseUserInfoUpdate struct {
@@ -41,6 +43,10 @@ func UserInfoUpdate(app *core.App, router fiber.Router) {
 			return resp
 		}
 
+		if p.EmailDigest != nil && *p.EmailDigest != "" && !p.EmailDigest.IsValid() {
+			return common.RespError(c, 400, i18n.T("Invalid {{name}}", map[string]interface{}{"name": "email_digest"}))
+		}
+
 		// Trim form
 		p.Name = strings.TrimSpace(p.Name)
 		p.Email = strings.TrimSpace(p.Email)
@@ -93,6 +99,11 @@ func UserInfoUpdate(app *core.App, router fiber.Router) {
 			user.Link = p.Link
 		}
 
+		// Modify the frequency of the notification emails
+		if p.EmailDigest != nil {
+			user.EmailDigest = *p.EmailDigest
+		}
+
 		if err := app.Dao().UpdateUser(&user); err != nil {
 			return common.RespError(c, 500, "Failed to update user")
 		}
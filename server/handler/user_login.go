@@ -83,9 +83,18 @@ func UserLogin(app *core.App, router fiber.Router) {
 			return common.RespError(c, 500, i18n.T("Login failed"))
 		}
 
+		triggerUserLogin(app, &user)
+
 		return common.RespData(c, ResponseUserLogin{
 			Token: jwtToken,
 			User:  app.Dao().CookUser(&user),
 		})
 	}))
 }
+
+// Trigger the hooks after the user logs in
+func triggerUserLogin(app *core.App, user *entity.User) {
+	if err := app.OnUserLogin().Trigger(&core.UserLoginEvent{App: app, User: user}); err != nil {
+		log.Error("[UserLogin] hook err: ", err)
+	}
+}
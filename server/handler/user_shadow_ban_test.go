@@ -0,0 +1,124 @@
+package handler_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/artalkjs/artalk/v2/internal/core"
+	"github.com/artalkjs/artalk/v2/internal/entity"
+	"github.com/artalkjs/artalk/v2/server/handler"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUserShadowBan(t *testing.T) {
+	app, fiber := NewApiTestApp()
+	defer app.Cleanup()
+
+	handler.UserUpdate(app.App, fiber)
+	handler.CommentList(app.App, fiber)
+	handler.CommentGet(app.App, fiber)
+
+	setShadowBanned := func(t *testing.T, banned bool) {
+		body, _ := json.Marshal(map[string]any{
+			"name": "userB", "email": "user_b@qwqaq.com", "is_admin": false, "receive_email": true,
+			"is_shadow_banned": banned,
+		})
+		req := httptest.NewRequest("PUT", "/users/1002", bytes.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("Authorization", "Bearer "+getUserToken(t, app, 1000))
+		resp, err := fiber.Test(req)
+		require.NoError(t, err)
+		respBody, _ := io.ReadAll(resp.Body)
+		require.Equal(t, 200, resp.StatusCode, string(respBody))
+
+		var result handler.ResponseUserUpdate
+		require.NoError(t, json.Unmarshal(respBody, &result))
+		assert.Equal(t, banned, result.IsShadowBanned)
+	}
+
+	listComments := func(t *testing.T, userID uint) map[uint]entity.CookedComment {
+		req := httptest.NewRequest("GET", "/comments?limit=100&page_key=/test/1000.html&site_name=Site+A", nil)
+		if userID != 0 {
+			req.Header.Set("Authorization", "Bearer "+getUserToken(t, app, userID))
+		}
+		resp, err := fiber.Test(req)
+		require.NoError(t, err)
+		body, _ := io.ReadAll(resp.Body)
+		require.Equal(t, 200, resp.StatusCode, string(body))
+
+		var result handler.ResponseCommentList
+		require.NoError(t, json.Unmarshal(body, &result))
+		return lo.KeyBy(result.Comments, func(c entity.CookedComment) uint { return c.ID })
+	}
+
+	setShadowBanned(t, true)
+	require.True(t, app.Dao().FindUserByID(1002).IsShadowBanned)
+
+	t.Run("Hidden from others", func(t *testing.T) {
+		assert.NotContains(t, listComments(t, 0), uint(1005), "visitor")
+		assert.NotContains(t, listComments(t, 1001), uint(1005), "another user")
+	})
+
+	t.Run("Hidden from others when get by id", func(t *testing.T) {
+		getComment := func(t *testing.T, userID uint) int {
+			req := httptest.NewRequest("GET", "/comments/1005", nil)
+			if userID != 0 {
+				req.Header.Set("Authorization", "Bearer "+getUserToken(t, app, userID))
+			}
+			resp, err := fiber.Test(req)
+			require.NoError(t, err)
+			return resp.StatusCode
+		}
+
+		assert.Equal(t, 404, getComment(t, 0), "visitor")
+		assert.Equal(t, 404, getComment(t, 1001), "another user")
+		assert.Equal(t, 200, getComment(t, 1002), "the user self")
+		assert.Equal(t, 200, getComment(t, 1000), "admin")
+	})
+
+	t.Run("Visible to the user self", func(t *testing.T) {
+		comments := listComments(t, 1002)
+		require.Contains(t, comments, uint(1005))
+		assert.False(t, comments[1005].IsShadowBanned, "no marker for the user self")
+	})
+
+	t.Run("Visible to admins with the marker", func(t *testing.T) {
+		comments := listComments(t, 1000)
+		require.Contains(t, comments, uint(1005))
+		assert.True(t, comments[1005].IsShadowBanned)
+		assert.False(t, comments[1004].IsShadowBanned)
+	})
+
+	t.Run("Visible to site moderators with the marker", func(t *testing.T) {
+		require.NoError(t, app.Dao().SetUserSiteRoles(1001, map[string]entity.SiteRole{"Site A": entity.SiteRoleModerator}))
+		defer app.Dao().SetUserSiteRoles(1001, map[string]entity.SiteRole{})
+
+		comments := listComments(t, 1001)
+		require.Contains(t, comments, uint(1005))
+		assert.True(t, comments[1005].IsShadowBanned)
+	})
+
+	t.Run("No notifications", func(t *testing.T) {
+		notifyService, err := core.AppService[*core.NotifyService](app.App)
+		require.NoError(t, err)
+
+		comment := app.Dao().FindComment(1005)
+		pComment := app.Dao().FindComment(1004)
+		require.NoError(t, notifyService.Push(&comment, &pComment))
+		assert.True(t, app.Dao().FindNotify(pComment.UserID, comment.ID).IsEmpty())
+
+		setShadowBanned(t, false)
+		require.NoError(t, notifyService.Push(&comment, &pComment))
+		assert.False(t, app.Dao().FindNotify(pComment.UserID, comment.ID).IsEmpty())
+	})
+
+	t.Run("Visible after unbanned", func(t *testing.T) {
+		comments := listComments(t, 0)
+		assert.Contains(t, comments, uint(1005))
+	})
+}
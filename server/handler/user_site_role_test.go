@@ -0,0 +1,159 @@
+package handler_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/artalkjs/artalk/v2/internal/entity"
+	"github.com/artalkjs/artalk/v2/server/handler"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUserSiteRoles(t *testing.T) {
+	app, fiber := NewApiTestApp()
+	defer app.Cleanup()
+
+	handler.UserUpdate(app.App, fiber)
+	handler.CommentUpdate(app.App, fiber)
+	handler.CommentDelete(app.App, fiber)
+	handler.CommentList(app.App, fiber)
+	handler.PageUpdate(app.App, fiber)
+	handler.SiteList(app.App, fiber)
+
+	request := func(userID uint, method, target string, data map[string]any) (int, []byte) {
+		body, _ := json.Marshal(data)
+		req := httptest.NewRequest(method, target, bytes.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("Authorization", "Bearer "+getUserToken(t, app, userID))
+		resp, err := fiber.Test(req)
+		require.NoError(t, err)
+		respBody, _ := io.ReadAll(resp.Body)
+		return resp.StatusCode, respBody
+	}
+
+	updateRoles := func(roles map[string]any) (int, []byte) {
+		return request(1000, "PUT", "/users/1002", map[string]any{
+			"name": "userB", "email": "user_b@qwqaq.com", "is_admin": false, "receive_email": true,
+			"site_roles": roles,
+		})
+	}
+
+	updateComment := func(id uint, modify func(data map[string]any)) int {
+		comment := app.Dao().FindComment(id)
+		data := map[string]any{
+			"site_name": comment.SiteName, "content": comment.Content, "page_key": comment.PageKey, "rid": comment.Rid,
+			"is_collapsed": comment.IsCollapsed, "is_pending": comment.IsPending, "is_pinned": comment.IsPinned,
+		}
+		modify(data)
+		code, _ := request(1002, "PUT", fmt.Sprintf("/comments/%d", id), data)
+		return code
+	}
+
+	t.Run("Invalid roles", func(t *testing.T) {
+		code, _ := updateRoles(map[string]any{"Site A": "owner"})
+		assert.Equal(t, 400, code)
+
+		code, _ = updateRoles(map[string]any{"Site Not Exist": "admin"})
+		assert.Equal(t, 400, code)
+	})
+
+	t.Run("No role", func(t *testing.T) {
+		code, _ := request(1002, "GET", "/sites", nil)
+		assert.Equal(t, 403, code)
+	})
+
+	t.Run("Assign roles", func(t *testing.T) {
+		code, body := updateRoles(map[string]any{"Site A": "moderator"})
+		require.Equal(t, 200, code, string(body))
+
+		var result handler.ResponseUserUpdate
+		require.NoError(t, json.Unmarshal(body, &result))
+		assert.Equal(t, map[string]entity.SiteRole{"Site A": entity.SiteRoleModerator}, result.SiteRoles)
+
+		managerIDs := func(siteName string) []uint {
+			return lo.Map(app.Dao().GetSiteManagers(siteName), func(u entity.User, _ int) uint { return u.ID })
+		}
+		assert.Contains(t, managerIDs("Site A"), uint(1002))
+		assert.NotContains(t, managerIDs("Site B"), uint(1002))
+	})
+
+	t.Run("Moderator", func(t *testing.T) {
+		code, body := request(1002, "GET", "/sites", nil)
+		require.Equal(t, 200, code)
+		var sites handler.ResponseSiteList
+		require.NoError(t, json.Unmarshal(body, &sites))
+		assert.Equal(t, []string{"Site A"}, lo.Map(sites.Sites, func(s entity.CookedSiteForAdmin, _ int) string { return s.Name }))
+
+		// approve and collapse
+		assert.Equal(t, 200, updateComment(1001, func(data map[string]any) { data["is_collapsed"] = true }))
+		assert.True(t, app.Dao().FindComment(1001).IsCollapsed)
+
+		// other changes are not allowed
+		assert.Equal(t, 403, updateComment(1001, func(data map[string]any) { data["content"] = "modified" }))
+		assert.Equal(t, 403, updateComment(1001, func(data map[string]any) { data["is_pinned"] = true }))
+
+		// the comments of other sites
+		assert.Equal(t, 403, updateComment(1006, func(data map[string]any) { data["is_collapsed"] = true }))
+		code, _ = request(1002, "DELETE", "/comments/1006", nil)
+		assert.Equal(t, 403, code)
+
+		code, _ = request(1002, "DELETE", "/comments/1003", nil)
+		assert.Equal(t, 200, code)
+
+		// pages are managed by the site admins
+		code, _ = request(1002, "PUT", "/pages/1000", map[string]any{
+			"site_name": "Site A", "key": "/test/1000.html", "title": "Test Page", "admin_only": false,
+		})
+		assert.Equal(t, 403, code)
+	})
+
+	t.Run("Pending comments visible to the site managers", func(t *testing.T) {
+		pending := entity.Comment{Content: "pending", PageKey: "/test/1000.html", SiteName: "Site A", UserID: 1001, IsPending: true}
+		require.NoError(t, app.Dao().CreateComment(&pending))
+
+		listCommentIDs := func(query string) []uint {
+			code, body := request(1002, "GET", "/comments?limit=100&page_key=/test/1000.html"+query, nil)
+			require.Equal(t, 200, code, string(body))
+
+			var result handler.ResponseCommentList
+			require.NoError(t, json.Unmarshal(body, &result))
+			return lo.Map(result.Comments, func(c entity.CookedComment, _ int) uint { return c.ID })
+		}
+
+		assert.Contains(t, listCommentIDs("&site_name=Site+A"), pending.ID)
+
+		// the site scope only contains the sites of the roles
+		ids := listCommentIDs("&scope=site&type=pending")
+		assert.Contains(t, ids, pending.ID)
+		assert.NotContains(t, ids, uint(1007), "the pending comment of Site B")
+	})
+
+	t.Run("Site admin", func(t *testing.T) {
+		code, _ := updateRoles(map[string]any{"Site A": "admin"})
+		require.Equal(t, 200, code)
+
+		assert.Equal(t, 200, updateComment(1001, func(data map[string]any) { data["is_pinned"] = true }))
+
+		code, _ = request(1002, "PUT", "/pages/1000", map[string]any{
+			"site_name": "Site A", "key": "/test/1000.html", "title": "Test Page", "admin_only": false,
+		})
+		assert.Equal(t, 200, code)
+
+		code, _ = request(1002, "PUT", "/pages/1001", map[string]any{
+			"site_name": "Site B", "key": "/site_b/1001.html", "title": "Test Page", "admin_only": true,
+		})
+		assert.Equal(t, 403, code)
+	})
+
+	t.Run("Remove roles", func(t *testing.T) {
+		code, _ := updateRoles(map[string]any{})
+		require.Equal(t, 200, code)
+		assert.Empty(t, app.Dao().FindUserSiteRoles(1002))
+	})
+}
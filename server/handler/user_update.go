@@ -19,6 +19,12 @@ type ParamsUserUpdate struct {
 	ReceiveEmail bool   `json:"receive_email" validate:"required"` // The user receive email
 	BadgeName    string `json:"badge_name" validate:"optional"`    // The user badge name
 	BadgeColor   string `json:"badge_color" validate:"optional"`   // The user badge color (hex format)
+
+	IsShadowBanned *bool                        `json:"is_shadow_banned" validate:"optional"`                            // The user is shadow-banned (the comments are only visible to the user and the admins), unchanged if omitted
+	TrustLevel     *entity.UserTrustLevel       `json:"trust_level" enums:"auto,trusted,untrusted" validate:"optional"`  // The trust level (the comments of the trusted user skip the default pending), unchanged if omitted
+	EmailDigest    *entity.EmailDigestFrequency `json:"email_digest" enums:"immediate,daily,weekly" validate:"optional"` // The frequency of the notification emails (the config default is used if empty), unchanged if omitted
+
+	SiteRoles map[string]entity.SiteRole `json:"site_roles" validate:"optional"` // The roles on the sites (site name => `admin` or `moderator`), unchanged if omitted
 }
 
 type ResponseUserUpdate struct {
@@ -68,6 +74,15 @@ func UserUpdate(app *core.App, router fiber.Router) {
 		if p.Link != "" && !utils.ValidateURL(p.Link) {
 			return common.RespError(c, 400, i18n.T("Invalid {{name}}", Map{"name": i18n.T("Link")}))
 		}
+		if err := validateUserSiteRoles(app, p.SiteRoles); err != nil {
+			return common.RespError(c, 400, i18n.T("Invalid {{name}}", Map{"name": "site_roles"}), Map{"detail": err.Error()})
+		}
+		if p.TrustLevel != nil && !p.TrustLevel.IsValid() {
+			return common.RespError(c, 400, i18n.T("Invalid {{name}}", Map{"name": "trust_level"}))
+		}
+		if p.EmailDigest != nil && *p.EmailDigest != "" && !p.EmailDigest.IsValid() {
+			return common.RespError(c, 400, i18n.T("Invalid {{name}}", Map{"name": "email_digest"}))
+		}
 
 		// 删除原有缓存
 		app.Dao().CacheAction(func(cache *dao.DaoCache) {
@@ -85,12 +100,27 @@ func UserUpdate(app *core.App, router fiber.Router) {
 		user.ReceiveEmail = p.ReceiveEmail
 		user.BadgeName = p.BadgeName
 		user.BadgeColor = p.BadgeColor
+		if p.IsShadowBanned != nil {
+			user.IsShadowBanned = *p.IsShadowBanned
+		}
+		if p.TrustLevel != nil {
+			user.TrustLevel = *p.TrustLevel
+		}
+		if p.EmailDigest != nil {
+			user.EmailDigest = *p.EmailDigest
+		}
 
 		err := app.Dao().UpdateUser(&user)
 		if err != nil {
 			return common.RespError(c, 500, i18n.T("{{name}} save failed", Map{"name": i18n.T("User")}))
 		}
 
+		if p.SiteRoles != nil {
+			if err := app.Dao().SetUserSiteRoles(user.ID, p.SiteRoles); err != nil {
+				return common.RespError(c, 500, i18n.T("{{name}} save failed", Map{"name": i18n.T("User")}))
+			}
+		}
+
 		return common.RespData(c, ResponseUserUpdate{
 			CookedUserForAdmin: app.Dao().UserToCookedForAdmin(&user),
 		})
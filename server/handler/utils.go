@@ -1,6 +1,14 @@
 package handler
 
-import "gorm.io/gorm"
+import (
+	"time"
+
+	"github.com/artalkjs/artalk/v2/internal/dao"
+	"github.com/artalkjs/artalk/v2/internal/i18n"
+	"github.com/artalkjs/artalk/v2/server/common"
+	"github.com/gofiber/fiber/v2"
+	"gorm.io/gorm"
+)
 
 type Map = map[string]interface{}
 
@@ -20,3 +28,21 @@ func Paginate(offset int, limit int) func(db *gorm.DB) *gorm.DB {
 		return db.Offset(offset).Limit(limit)
 	}
 }
+
+// Parse the date range params (format: 2006-01-02 15:04:05), the zero time is returned for the empty param
+func parseDateRangeParams(c *fiber.Ctx, from string, to string) (time.Time, time.Time, bool, error) {
+	var times [2]time.Time
+	for i, name := range []string{"date_from", "date_to"} {
+		value := []string{from, to}[i]
+		if value == "" {
+			continue
+		}
+		t, err := time.ParseInLocation(dao.CommonDateTimeFormat, value, time.Local)
+		if err != nil {
+			return time.Time{}, time.Time{}, false, common.RespError(c, 400, i18n.T("Invalid {{name}}", Map{"name": name}))
+		}
+		times[i] = t
+	}
+
+	return times[0], times[1], true, nil
+}
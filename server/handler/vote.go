@@ -1,12 +1,14 @@
 package handler
 
 import (
+	"cmp"
 	"strings"
 
 	"github.com/artalkjs/artalk/v2/internal/core"
 	"github.com/artalkjs/artalk/v2/internal/dao"
 	"github.com/artalkjs/artalk/v2/internal/entity"
 	"github.com/artalkjs/artalk/v2/internal/i18n"
+	"github.com/artalkjs/artalk/v2/internal/log"
 	"github.com/artalkjs/artalk/v2/server/common"
 	"github.com/gofiber/fiber/v2"
 )
@@ -81,6 +83,10 @@ func VoteCreate(app *core.App, router fiber.Router) {
 			return resp
 		}
 
+		if ok, resp := common.CheckBlocklistReq(app, c, p.Email); !ok {
+			return resp
+		}
+
 		if choice != "up" && choice != "down" {
 			return common.RespError(c, 404, "unknown vote choice")
 		}
@@ -136,7 +142,7 @@ func VoteCreate(app *core.App, router fiber.Router) {
 
 		// Create new vote record
 		create := func(choice string) error {
-			return createVote(app.Dao(), createNewVoteParams{
+			vote, err := createVote(app.Dao(), createNewVoteParams{
 				ip:         ip,
 				ua:         string(c.Request().Header.UserAgent()),
 				userID:     user.ID,
@@ -144,6 +150,12 @@ func VoteCreate(app *core.App, router fiber.Router) {
 				targetID:   uint(targetID),
 				choice:     choice,
 			})
+			if err != nil {
+				return err
+			}
+
+			triggerVoteCreated(app, &vote, cmp.Or(comment.SiteName, page.SiteName))
+			return nil
 		}
 
 		exitsVotes := getExistsVotesByIP(app.Dao(), ip, targetName, uint(targetID))
@@ -196,7 +208,7 @@ func getVoteTargetName(voteType string) string {
 
 func getExistsVotesByIP(dao *dao.Dao, ip string, targetName string, targetID uint) []entity.Vote {
 	var existsVotes []entity.Vote
-	dao.DB().Where("type LIKE ? AND target_id = ? AND ip = ?", targetName+"%", uint(targetID), ip).Find(&existsVotes)
+	dao.DB().Where("type IN ? AND target_id = ? AND ip = ?", []string{targetName + "_up", targetName + "_down"}, uint(targetID), ip).Find(&existsVotes)
 	return existsVotes
 }
 
@@ -210,7 +222,13 @@ type createNewVoteParams struct {
 }
 
 // Create new vote record
-func createVote(dao *dao.Dao, opts createNewVoteParams) error {
-	_, err := dao.NewVote(opts.targetID, entity.VoteType(opts.targetName+"_"+opts.choice), opts.userID, opts.ua, opts.ip)
-	return err
+func createVote(dao *dao.Dao, opts createNewVoteParams) (entity.Vote, error) {
+	return dao.NewVote(opts.targetID, entity.VoteType(opts.targetName+"_"+opts.choice), opts.userID, opts.ua, opts.ip)
+}
+
+// Trigger the hooks after the vote or the reaction is created
+func triggerVoteCreated(app *core.App, vote *entity.Vote, siteName string) {
+	if err := app.OnVoteCreated().Trigger(&core.VoteCreatedEvent{App: app, Vote: vote, SiteName: siteName}); err != nil {
+		log.Error("[VoteCreated] hook err: ", err)
+	}
 }
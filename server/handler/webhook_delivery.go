@@ -0,0 +1,123 @@
+package handler
+
+import (
+	"github.com/artalkjs/artalk/v2/internal/core"
+	"github.com/artalkjs/artalk/v2/internal/entity"
+	"github.com/artalkjs/artalk/v2/internal/i18n"
+	"github.com/artalkjs/artalk/v2/server/common"
+	"github.com/gofiber/fiber/v2"
+)
+
+type ParamsWebhookDeliveryList struct {
+	Status entity.WebhookDeliveryStatus `query:"status" json:"status" enums:"pending,success,failed" validate:"optional"` // Filter by status
+	Event  string                       `query:"event" json:"event" validate:"optional"`                                  // Filter by event
+	Limit  int                          `query:"limit" json:"limit" validate:"optional"`                                  // The limit for pagination
+	Offset int                          `query:"offset" json:"offset" validate:"optional"`                                // The offset for pagination
+}
+
+type ResponseWebhookDeliveryList struct {
+	Items []entity.CookedWebhookDelivery `json:"items"`
+	Count int64                          `json:"count"`
+}
+
+// @Id           GetWebhookDeliveries
+// @Summary      Get Webhook Deliveries
+// @Description  Get the delivery log of the webhooks (latest first)
+// @Tags         Webhook
+// @Param        options  query  ParamsWebhookDeliveryList  true  "The options"
+// @Security     ApiKeyAuth
+// @Produce      json
+// @Success      200  {object}  ResponseWebhookDeliveryList
+// @Failure      403  {object}  Map{msg=string}
+// @Router       /webhook_deliveries  [get]
+func WebhookDeliveryList(app *core.App, router fiber.Router) {
+	router.Get("/webhook_deliveries", common.AdminGuard(app, func(c *fiber.Ctx) error {
+		var p ParamsWebhookDeliveryList
+		if isOK, resp := common.ParamsDecode(c, &p); !isOK {
+			return resp
+		}
+
+		q := app.Dao().DB().Model(&entity.WebhookDelivery{}).Order("id DESC")
+		if p.Status != "" {
+			q = q.Where("status = ?", p.Status)
+		}
+		if p.Event != "" {
+			q = q.Where("event = ?", p.Event)
+		}
+
+		var total int64
+		q.Count(&total)
+
+		var deliveries []entity.WebhookDelivery
+		q.Scopes(Paginate(p.Offset, p.Limit)).Find(&deliveries)
+
+		items := []entity.CookedWebhookDelivery{}
+		for _, d := range deliveries {
+			items = append(items, app.Dao().CookWebhookDelivery(&d))
+		}
+
+		return common.RespData(c, ResponseWebhookDeliveryList{
+			Items: items,
+			Count: total,
+		})
+	}))
+}
+
+type ResponseWebhookRedeliver struct {
+	entity.CookedWebhookDelivery
+}
+
+// @Id           RedeliverWebhook
+// @Summary      Redeliver Webhook
+// @Description  Send the payload of the delivery again in the background (without retries), and return the delivery marked as pending. The pending delivery is refused unless it is interrupted (e.g. by a restart)
+// @Tags         Webhook
+// @Param        id  path  int  true  "The delivery ID"
+// @Security     ApiKeyAuth
+// @Produce      json
+// @Success      200  {object}  ResponseWebhookRedeliver
+// @Failure      400  {object}  Map{msg=string}
+// @Failure      403  {object}  Map{msg=string}
+// @Failure      404  {object}  Map{msg=string}
+// @Failure      500  {object}  Map{msg=string}
+// @Router       /webhook_deliveries/{id}/redeliver  [post]
+func WebhookRedeliver(app *core.App, router fiber.Router) {
+	router.Post("/webhook_deliveries/:id/redeliver", common.AdminGuard(app, func(c *fiber.Ctx) error {
+		id, _ := c.ParamsInt("id")
+
+		delivery := app.Dao().FindWebhookDelivery(uint(id))
+		if delivery.IsEmpty() {
+			return common.RespError(c, 404, i18n.T("{{name}} not found", Map{"name": i18n.T("Webhook delivery")}))
+		}
+
+		// the events of the subscriptions, or the ones of the WebHook notify
+		//
+		// the pending delivery is refused unless it is interrupted (e.g. by a restart)
+		if entity.WebhookEvent(delivery.Event).IsValid() {
+			webhookService, err := core.AppService[*core.WebhookService](app)
+			if err != nil {
+				return common.RespError(c, 500, "WebhookService err: "+err.Error())
+			}
+			if delivery.Status == entity.WebhookDeliveryStatusPending && !webhookService.IsDeliveryStale(&delivery) {
+				return common.RespError(c, 400, i18n.T("The webhook is being delivered"))
+			}
+			if err := webhookService.Redeliver(&delivery); err != nil {
+				return common.RespError(c, 400, i18n.T("{{name}} not found", Map{"name": i18n.T("Webhook subscription")}), Map{
+					"detail": err.Error(),
+				})
+			}
+		} else {
+			notifyService, err := core.AppService[*core.NotifyService](app)
+			if err != nil {
+				return common.RespError(c, 500, "NotifyService err: "+err.Error())
+			}
+			if delivery.Status == entity.WebhookDeliveryStatusPending && !notifyService.IsWebhookDeliveryStale(&delivery) {
+				return common.RespError(c, 400, i18n.T("The webhook is being delivered"))
+			}
+			notifyService.RedeliverWebhook(&delivery)
+		}
+
+		return common.RespData(c, ResponseWebhookRedeliver{
+			CookedWebhookDelivery: app.Dao().CookWebhookDelivery(&delivery),
+		})
+	}))
+}
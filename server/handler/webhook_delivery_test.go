@@ -0,0 +1,98 @@
+package handler_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/artalkjs/artalk/v2/internal/entity"
+	"github.com/artalkjs/artalk/v2/server/handler"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWebhookDelivery(t *testing.T) {
+	app, fiber := NewApiTestApp()
+	defer app.Cleanup()
+
+	handler.WebhookDeliveryList(app.App, fiber)
+	handler.WebhookRedeliver(app.App, fiber)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("ok"))
+	}))
+	defer server.Close()
+
+	failed := entity.WebhookDelivery{URL: server.URL, Event: "new_comment", Payload: "{}", Status: entity.WebhookDeliveryStatusFailed, Attempts: 4, StatusCode: 502}
+	pending := entity.WebhookDelivery{URL: server.URL, Event: "new_comment", Payload: "{}", Status: entity.WebhookDeliveryStatusPending}
+	require.NoError(t, app.Dao().SaveWebhookDelivery(&failed))
+	require.NoError(t, app.Dao().SaveWebhookDelivery(&pending))
+
+	request := func(method, target string, userID uint) (int, []byte) {
+		req := httptest.NewRequest(method, target, nil)
+		if userID != 0 {
+			req.Header.Set("Authorization", "Bearer "+getUserToken(t, app, userID))
+		}
+		resp, err := fiber.Test(req)
+		require.NoError(t, err)
+		body, _ := io.ReadAll(resp.Body)
+		return resp.StatusCode, body
+	}
+
+	t.Run("Admin only", func(t *testing.T) {
+		code, _ := request("GET", "/webhook_deliveries", 1001)
+		assert.Equal(t, 403, code)
+	})
+
+	t.Run("List", func(t *testing.T) {
+		code, body := request("GET", "/webhook_deliveries?status=failed", 1000)
+		require.Equal(t, 200, code, string(body))
+
+		var result handler.ResponseWebhookDeliveryList
+		require.NoError(t, json.Unmarshal(body, &result))
+		assert.Equal(t, int64(1), result.Count)
+		assert.Equal(t, failed.ID, result.Items[0].ID)
+		assert.Equal(t, 502, result.Items[0].StatusCode)
+	})
+
+	t.Run("Redeliver", func(t *testing.T) {
+		code, _ := request("POST", "/webhook_deliveries/99999/redeliver", 1000)
+		assert.Equal(t, 404, code)
+
+		code, _ = request("POST", fmt.Sprintf("/webhook_deliveries/%d/redeliver", pending.ID), 1000)
+		assert.Equal(t, 400, code, "being delivered")
+
+		code, body := request("POST", fmt.Sprintf("/webhook_deliveries/%d/redeliver", failed.ID), 1000)
+		require.Equal(t, 200, code, string(body))
+
+		var result handler.ResponseWebhookRedeliver
+		require.NoError(t, json.Unmarshal(body, &result))
+		assert.Equal(t, entity.WebhookDeliveryStatusPending, result.Status, "sent in the background")
+
+		code, _ = request("POST", fmt.Sprintf("/webhook_deliveries/%d/redeliver", failed.ID), 1000)
+		assert.Equal(t, 400, code, "being redelivered")
+
+		require.Eventually(t, func() bool {
+			return app.Dao().FindWebhookDelivery(failed.ID).Status == entity.WebhookDeliveryStatusSuccess
+		}, 5*time.Second, 10*time.Millisecond)
+		delivery := app.Dao().FindWebhookDelivery(failed.ID)
+		assert.Equal(t, 5, delivery.Attempts)
+		assert.Equal(t, 200, delivery.StatusCode)
+		assert.Equal(t, "ok", delivery.Response)
+	})
+
+	t.Run("Redeliver the interrupted", func(t *testing.T) {
+		// the pending delivery is not updated within the retry window (e.g. interrupted by a restart)
+		require.NoError(t, app.Dao().DB().Model(&pending).UpdateColumn("updated_at", time.Now().Add(-time.Hour)).Error)
+
+		code, body := request("POST", fmt.Sprintf("/webhook_deliveries/%d/redeliver", pending.ID), 1000)
+		require.Equal(t, 200, code, string(body))
+		assert.Eventually(t, func() bool {
+			return app.Dao().FindWebhookDelivery(pending.ID).Status == entity.WebhookDeliveryStatusSuccess
+		}, 5*time.Second, 10*time.Millisecond)
+	})
+}
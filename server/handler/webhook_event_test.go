@@ -0,0 +1,103 @@
+package handler_test
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/artalkjs/artalk/v2/internal/config"
+	"github.com/artalkjs/artalk/v2/internal/entity"
+	"github.com/artalkjs/artalk/v2/server/handler"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWebhookEvents(t *testing.T) {
+	app, fiber := NewApiTestApp()
+	defer app.Cleanup()
+
+	handler.VoteCreate(app.App, fiber)
+	handler.CommentDelete(app.App, fiber)
+
+	type received struct {
+		Path    string
+		Event   string
+		Payload entity.WebhookPayload
+	}
+	var (
+		mu       sync.Mutex
+		requests []received
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		var payload entity.WebhookPayload
+		json.Unmarshal(body, &payload)
+
+		mu.Lock()
+		requests = append(requests, received{Path: r.URL.Path, Event: r.Header.Get("X-Artalk-Event"), Payload: payload})
+		mu.Unlock()
+	}))
+	defer server.Close()
+
+	app.Conf().Webhooks = config.WebhooksConf{
+		Timeout: 5,
+		Subscriptions: []config.WebhookSubscriptionConf{
+			{URL: server.URL + "/votes", Sites: []string{"Site A"}, Events: []string{"vote.created"}},
+			{URL: server.URL + "/site_b", Sites: []string{"Site B"}},
+		},
+	}
+
+	// wait for the deliveries are finished
+	waitDeliveries := func(t *testing.T, count int64) {
+		require.Eventually(t, func() bool {
+			var finished int64
+			app.Dao().DB().Model(&entity.WebhookDelivery{}).Where("status != ?", entity.WebhookDeliveryStatusPending).Count(&finished)
+			return finished == count
+		}, 5*time.Second, 10*time.Millisecond)
+	}
+
+	t.Run("Vote", func(t *testing.T) {
+		req := httptest.NewRequest("POST", "/votes/comment/1001/up", strings.NewReader("{}"))
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("X-Forwarded-For", "10.0.0.1")
+		resp, err := fiber.Test(req)
+		require.NoError(t, err)
+		require.Equal(t, 200, resp.StatusCode)
+
+		waitDeliveries(t, 1)
+		require.Len(t, requests, 1)
+		assert.Equal(t, "/votes", requests[0].Path)
+		assert.Equal(t, "vote.created", requests[0].Event)
+
+		payload := requests[0].Payload
+		assert.Equal(t, entity.WebhookPayloadVersion, payload.Version)
+		assert.Equal(t, entity.WebhookEventVoteCreated, payload.Event)
+		assert.Equal(t, "Site A", payload.SiteName)
+		require.NotNil(t, payload.Data.Vote)
+		assert.Equal(t, uint(1001), payload.Data.Vote.TargetID)
+		assert.Equal(t, entity.VoteTypeCommentUp, payload.Data.Vote.Type)
+		assert.Nil(t, payload.Data.Comment)
+	})
+
+	t.Run("Filter by site and event", func(t *testing.T) {
+		for _, id := range []string{"1006", "1002"} { // Site B, Site A
+			req := httptest.NewRequest("DELETE", "/comments/"+id, nil)
+			req.Header.Set("Authorization", "Bearer "+getUserToken(t, app, 1000))
+			resp, err := fiber.Test(req)
+			require.NoError(t, err)
+			require.Equal(t, 200, resp.StatusCode)
+		}
+
+		waitDeliveries(t, 2)
+		require.Len(t, requests, 2)
+		assert.Equal(t, "/site_b", requests[1].Path)
+		assert.Equal(t, entity.WebhookEventCommentDeleted, requests[1].Payload.Event)
+		require.NotNil(t, requests[1].Payload.Data.Comment)
+		assert.Equal(t, uint(1006), requests[1].Payload.Data.Comment.ID)
+	})
+}
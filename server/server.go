@@ -71,8 +71,13 @@ func Serve(app *core.App) (*fiber.App, error) {
 		h.CommentCreate(app, api)
 		h.CommentList(app, api)
 		h.CommentGet(app, api)
+		h.CommentEdit(app, api)
+		h.CommentWithdraw(app, api)
 		h.VoteGet(app, api)
 		h.VoteCreate(app, api)
+		h.CommentReport(app, api)
+		h.ReactionGet(app, api)
+		h.ReactionCreate(app, api)
 		h.PagePV(app, api)
 		h.Stat(app, api)
 		h.NotifyList(app, api)
@@ -128,6 +133,9 @@ func Serve(app *core.App) (*fiber.App, error) {
 func admin(app *core.App, api fiber.Router) {
 	h.CommentUpdate(app, api)
 	h.CommentDelete(app, api)
+	h.CommentBatch(app, api)
+	h.CommentRevisionList(app, api)
+	h.CommentRevisionRestore(app, api)
 	h.PageList(app, api)
 	h.PageUpdate(app, api)
 	h.PageDelete(app, api)
@@ -142,9 +150,23 @@ func admin(app *core.App, api fiber.Router) {
 	h.UserCreate(app, api)
 	h.UserUpdate(app, api)
 	h.UserDelete(app, api)
+	h.TrashList(app, api)
+	h.TrashRestore(app, api)
+	h.TrashPurge(app, api)
+	h.BlocklistList(app, api)
+	h.BlocklistCreate(app, api)
+	h.BlocklistUpdate(app, api)
+	h.BlocklistDelete(app, api)
+	h.CommentBan(app, api)
+	h.ReportList(app, api)
+	h.ReportResolve(app, api)
+	h.WebhookDeliveryList(app, api)
+	h.WebhookRedeliver(app, api)
 	h.CacheWarmUp(app, api)
 	h.CacheFlush(app, api)
 	h.EmailSend(app, api)
+	h.EmailTaskList(app, api)
+	h.EmailTaskResend(app, api)
 	h.VoteSync(app, api)
 	h.SettingGet(app, api)
 	h.SettingApply(app, api)
@@ -1,7 +1,7 @@
 /**
  * List of sensitive config paths.
  *
- * (which should be hidden in the UI)
+ * (which should be hidden in the UI, `[]` matches any item of the array)
  */
 export const SensitiveConfigPaths = [
   'app_key',
@@ -11,6 +11,7 @@ export const SensitiveConfigPaths = [
   'admin_notify.lark.webhook_url',
   'admin_notify.slack.oauth_token',
   'admin_notify.telegram.api_token',
+  'admin_notify.webhook.secret',
   'admin_notify.webhook.url',
   'admin.notify.bark.server',
   'auth.apple.client_secret',
@@ -37,8 +38,18 @@ export const SensitiveConfigPaths = [
   'db.password',
   'email.ali_dm.access_key_secret',
   'email.smtp.password',
+  'moderator.http.secret',
+  'webhooks.subscriptions[].secret',
 ]
 
 export function isSensitiveConfigPath(path: string) {
-  return SensitiveConfigPaths.includes(path)
+  // the array indexes are matched by `[]` (e.g. `a.0.b` matches `a[].b`)
+  return SensitiveConfigPaths.includes(path.replace(/\.\d+(?=\.|$)/g, '[]'))
+}
+
+/**
+ * Whether the items of the array contain sensitive config
+ */
+export function hasSensitiveConfigItems(path: string) {
+  return SensitiveConfigPaths.some((p) => p.startsWith(`${path}[]`))
 }